@@ -3,6 +3,7 @@ package main
 import (
 	"fmt"
 	"log"
+	"os"
 
 	"films_library/config"
 	"films_library/internal/app"
@@ -22,5 +23,30 @@ func main() {
 		log.Fatalf("Config error: %s", err)
 	}
 
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "migrate":
+			if err := app.Migrate(cfg, os.Args[2:]); err != nil {
+				log.Fatalf("Migrate error: %s", err)
+			}
+			return
+		case "import":
+			if err := app.Import(cfg, os.Args[2:]); err != nil {
+				log.Fatalf("Import error: %s", err)
+			}
+			return
+		case "imdb":
+			if err := app.IMDb(cfg, os.Args[2:]); err != nil {
+				log.Fatalf("IMDb error: %s", err)
+			}
+			return
+		case "user":
+			if err := app.User(cfg, os.Args[2:]); err != nil {
+				log.Fatalf("User error: %s", err)
+			}
+			return
+		}
+	}
+
 	app.Run(cfg)
 }
@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/ilyakaznacheev/cleanenv"
 )
@@ -9,10 +10,20 @@ import (
 type (
 	// Config -.
 	Config struct {
-		App  `yaml:"app"`
-		HTTP `yaml:"http"`
-		Log  `yaml:"logger"`
-		PG   `yaml:"postgres"`
+		App        `yaml:"app"`
+		HTTP       `yaml:"http"`
+		Log        `yaml:"logger"`
+		PG         `yaml:"postgres"`
+		Migrations `yaml:"migrations"`
+		Auth       `yaml:"auth"`
+		JWT        `yaml:"jwt"`
+		Ratings    `yaml:"ratings"`
+		Loans      `yaml:"loans"`
+		Holds      `yaml:"holds"`
+		Fees       `yaml:"fees"`
+		Members    `yaml:"members"`
+		Import     `yaml:"import"`
+		Media      `yaml:"media"`
 	}
 
 	// App -.
@@ -40,6 +51,94 @@ type (
 		Host     string `env:"DB_HOST"`
 		PoolMax  int    `yaml:"pool_max"`
 	}
+
+	// Migrations -.
+	Migrations struct {
+		AutoUp bool `yaml:"auto_up" env:"MIGRATIONS_AUTO_UP"`
+		Check  bool `yaml:"check"   env:"MIGRATIONS_CHECK"`
+	}
+
+	// Auth -.
+	Auth struct {
+		SessionTTL   time.Duration `yaml:"session_ttl"   env:"AUTH_SESSION_TTL"   env-default:"24h"`
+		RotateAfter  time.Duration `yaml:"rotate_after"  env:"AUTH_ROTATE_AFTER"  env-default:"1h"`
+		CookieSecure bool          `yaml:"cookie_secure" env:"AUTH_COOKIE_SECURE"`
+	}
+
+	// JWT -.
+	JWT struct {
+		Issuer    string        `yaml:"issuer"     env:"JWT_ISSUER"     env-default:"films_library"`
+		TTL       time.Duration `yaml:"ttl"        env:"JWT_TTL"        env-default:"15m"`
+		ActiveKey string        `yaml:"active_key" env:"JWT_ACTIVE_KEY"`
+		Keys      []JWTKey      `yaml:"keys"`
+	}
+
+	// Ratings -.
+	// The community score of a film averages its user ratings with
+	// PriorWeight virtual ratings of PriorMean.
+	Ratings struct {
+		PriorMean   float64 `yaml:"prior_mean"   env:"RATINGS_PRIOR_MEAN"   env-default:"6"`
+		PriorWeight float64 `yaml:"prior_weight" env:"RATINGS_PRIOR_WEIGHT" env-default:"10"`
+	}
+
+	// Loans -.
+	// Loans are due PeriodDays after checkout and may be renewed RenewLimit
+	// times. Overdue loans are flagged every OverdueInterval.
+	Loans struct {
+		PeriodDays      int           `yaml:"period_days"      env:"LOANS_PERIOD_DAYS"      env-default:"14"`
+		RenewLimit      int           `yaml:"renew_limit"      env:"LOANS_RENEW_LIMIT"      env-default:"2"`
+		OverdueInterval time.Duration `yaml:"overdue_interval" env:"LOANS_OVERDUE_INTERVAL" env-default:"1h"`
+	}
+
+	// Holds -.
+	// A copy set aside for a hold waits PickupWindow to be collected. Holds
+	// past it are expired every ExpiryInterval.
+	Holds struct {
+		PickupWindow   time.Duration `yaml:"pickup_window"   env:"HOLDS_PICKUP_WINDOW"   env-default:"72h"`
+		ExpiryInterval time.Duration `yaml:"expiry_interval" env:"HOLDS_EXPIRY_INTERVAL" env-default:"15m"`
+	}
+
+	// Fees -.
+	// Rates charged to members, in minor currency units. Overdue fines accrue
+	// OverduePerDay for every day late, up to OverdueMax per loan.
+	Fees struct {
+		Membership    int64 `yaml:"membership"      env:"FEES_MEMBERSHIP"      env-default:"2000"`
+		Replacement   int64 `yaml:"replacement"     env:"FEES_REPLACEMENT"     env-default:"2500"`
+		OverduePerDay int64 `yaml:"overdue_per_day" env:"FEES_OVERDUE_PER_DAY" env-default:"25"`
+		OverdueMax    int64 `yaml:"overdue_max"     env:"FEES_OVERDUE_MAX"     env-default:"1000"`
+	}
+
+	// Members -.
+	// Members owing more than SuspendAbove, in minor currency units, are
+	// suspended until they pay it down.
+	Members struct {
+		SuspendAbove int64 `yaml:"suspend_above" env:"MEMBERS_SUSPEND_ABOVE" env-default:"1000"`
+	}
+
+	// Import -.
+	// Bulk imports insert BatchSize rows per transaction.
+	Import struct {
+		BatchSize int `yaml:"batch_size" env:"IMPORT_BATCH_SIZE" env-default:"500"`
+	}
+
+	// Media -.
+	// Posters and headshots are kept under Dir. Uploads over MaxSize bytes
+	// are rejected.
+	Media struct {
+		Dir     string `yaml:"dir"      env:"MEDIA_DIR"      env-default:"./media"`
+		MaxSize int64  `yaml:"max_size" env:"MEDIA_MAX_SIZE" env-default:"10485760"`
+	}
+
+	// JWTKey is a signing key. HS256 keys take a secret; EdDSA keys take a
+	// base64 Ed25519 public key and, to sign, a base64 private key or seed.
+	// Keep retired keys without a private key until their tokens expire.
+	JWTKey struct {
+		ID         string `yaml:"id"`
+		Algorithm  string `yaml:"alg"`
+		Secret     string `yaml:"secret"`
+		PublicKey  string `yaml:"public_key"`
+		PrivateKey string `yaml:"private_key"`
+	}
 )
 
 func NewConfig() (*Config, error) {
@@ -3,6 +3,7 @@ package actor
 import (
 	"context"
 	"films_library/internal/model"
+	"films_library/pkg/pagination"
 )
 
 type (
@@ -10,7 +11,8 @@ type (
 		AddActor(ctx context.Context, actor *model.Actor) (uint, error)
 		UpdateActor(ctx context.Context, actor *model.Actor) (*model.Actor, error)
 		DeleteActor(ctx context.Context, actorID uint) (uint, error)
-		GetActors(ctx context.Context) ([]model.ResponseActor, error)
+		GetActors(ctx context.Context, filter model.ActorFilter) ([]model.ResponseActor, pagination.Page, error)
+		ExportActors(ctx context.Context, fn func(model.Actor) error) error
 
 		CheckActors(ctx context.Context, actors []uint) (bool, error)
 	}
@@ -20,8 +22,10 @@ type (
 		UpdateActor(ctx context.Context, actor *model.Actor) (*model.Actor, error)
 		DeleteActor(ctx context.Context, actorID uint) (uint, error)
 		GetActor(ctx context.Context, actorID uint) (model.Actor, error)
-		GetActors(ctx context.Context) ([]model.ResponseActor, error)
+		GetActors(ctx context.Context, filter model.ActorFilter) ([]model.ResponseActor, error)
+		CountActors(ctx context.Context) (int64, error)
+		ExportActors(ctx context.Context, fn func(model.Actor) error) error
 
-		CheckActor(ctx context.Context, actor uint) (bool, error)
+		CheckActors(ctx context.Context, actors []uint) (bool, error)
 	}
 )
@@ -6,8 +6,12 @@ import (
 	"strconv"
 
 	"films_library/internal/actor"
+	"films_library/internal/auth"
 	"films_library/internal/model"
+	"films_library/pkg/export"
+	"films_library/pkg/httpserver"
 	"films_library/pkg/logger"
+	"films_library/pkg/pagination"
 	"films_library/pkg/response"
 
 	"github.com/go-playground/validator"
@@ -20,33 +24,54 @@ type ActorHandler struct {
 	logger       logger.Interface
 }
 
+// ActorPermissions is the permission each actor route requires.
+var ActorPermissions = auth.Permissions{
+	"GET /actors":           auth.ActorRead,
+	"POST /actors/add":      auth.ActorWrite,
+	"PUT /actors/update":    auth.ActorWrite,
+	"DELETE /actors/delete": auth.ActorDelete,
+	"GET /export/actors":    auth.ActorRead,
+}
+
 func NewActorHandler(mux *http.ServeMux, au actor.Usecase, l logger.Interface) {
 	r := &ActorHandler{au, l}
 
-	mux.HandleFunc("/actors", r.GetActor)
-	mux.HandleFunc("/actors/add", r.AddActor)
-	mux.HandleFunc("/actors/update", r.UpdateActor)
-	mux.HandleFunc("/actors/delete", r.DeleteActor)
+	mux.HandleFunc("GET /actors", r.GetActor)
+	mux.HandleFunc("POST /actors/add", r.AddActor)
+	mux.HandleFunc("PUT /actors/update", r.UpdateActor)
+	mux.HandleFunc("DELETE /actors/delete", r.DeleteActor)
+	mux.HandleFunc("GET /export/actors", r.ExportActors)
 }
 
 // GetActor handles the HTTP GET request to retrieve a list of actors.
 // @Summary Get actors
-// @Description Retrieves a list of actors.
+// @Description Retrieves a page of actors with their films.
 // @Tags actors
 // @Produce json
-// @Success 200 {array} model.Actor "List of actors"
+// @Param limit query integer false "Page size (default 20, max 100)"
+// @Param offset query integer false "Number of actors to skip"
+// @Param cursor query string false "Cursor of the page to fetch"
+// @Param total query boolean false "Include the total number of actors"
+// @Success 200 {array} model.ResponseActor "List of actors"
 // @Failure 400 {object} string "Bad Request"
 // @Failure 500 {object} string "Internal Server Error"
 // @Router /actors [get]
 func (h *ActorHandler) GetActor(w http.ResponseWriter, r *http.Request) {
-	actors, err := h.actorUsecase.GetActors(r.Context())
+	params, err := pagination.ParseQuery(r.URL.Query())
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Bad query param", h.logger)
+		return
+	}
+
+	actors, page, err := h.actorUsecase.GetActors(r.Context(), model.ActorFilter{Params: params})
 	if err != nil {
 		h.logger.Error(err)
 		response.ErrorResponse(w, http.StatusInternalServerError, "Internal server error", h.logger)
 		return
 	}
 
-	response.SuccessResponse(w, http.StatusOK, actors)
+	response.PageResponse(w, http.StatusOK, actors, page)
 }
 
 // AddActor handles the HTTP POST request to add a new actor.
@@ -166,3 +191,57 @@ func (h *ActorHandler) DeleteActor(w http.ResponseWriter, r *http.Request) {
 
 	response.SuccessResponse(w, http.StatusOK, id)
 }
+
+// actorHeader is the CSV header of an actor export.
+var actorHeader = []string{"id", "name", "sex", "birth_date"}
+
+// actorRow is an actor export row.
+type actorRow struct {
+	model.Actor
+}
+
+func (a actorRow) Record() []string {
+	return []string{strconv.Itoa(a.ID), a.Name, a.Sex, a.BirthDate}
+}
+
+// ExportActors handles the HTTP GET request to export every actor.
+// @Summary Export actors
+// @Description Streams every actor as a download, in actor ID order.
+// @Tags export
+// @Produce text/csv
+// @Produce application/x-ndjson
+// @Produce json
+// @Param format query string false "csv (default), ndjson or json"
+// @Success 200 {array} model.Actor "Actors"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /export/actors [get]
+func (h *ActorHandler) ExportActors(w http.ResponseWriter, r *http.Request) {
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = export.CSV
+	}
+	ew, err := export.NewWriter(w, format, actorHeader)
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Bad query param", h.logger)
+		return
+	}
+
+	// Exports stream the whole catalogue, for longer than the server write
+	// timeout allows.
+	if err := httpserver.LiftDeadlines(w); err != nil {
+		h.logger.Error(err)
+	}
+
+	export.Attach(w, "actors", format)
+	err = h.actorUsecase.ExportActors(r.Context(), func(actor model.Actor) error {
+		return ew.Write(actorRow{actor})
+	})
+	if err == nil {
+		err = ew.Close()
+	}
+	if err != nil {
+		export.Fail(w, ew, err, h.logger)
+	}
+}
@@ -1 +1,41 @@
 package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mock_actor "films_library/internal/actor/mocks"
+	"films_library/pkg/logger"
+
+	"github.com/golang/mock/gomock"
+	"gopkg.in/go-playground/assert.v1"
+)
+
+// deadlineRecorder records the write deadline handlers set.
+type deadlineRecorder struct {
+	*httptest.ResponseRecorder
+	writeDeadline *time.Time
+}
+
+func (d *deadlineRecorder) SetWriteDeadline(deadline time.Time) error {
+	d.writeDeadline = &deadline
+	return nil
+}
+
+func TestActorHandler_ExportActorsLiftsWriteDeadline(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mock_actor.NewMockUsecase(ctrl)
+	mockUsecase.EXPECT().ExportActors(gomock.Any(), gomock.Any()).Return(nil)
+	handler := ActorHandler{actorUsecase: mockUsecase, logger: logger.NewMockInterface(ctrl)}
+
+	recorder := &deadlineRecorder{ResponseRecorder: httptest.NewRecorder()}
+	handler.ExportActors(recorder, httptest.NewRequest("GET", "/export/actors", nil))
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.NotEqual(t, nil, recorder.writeDeadline)
+	assert.Equal(t, true, recorder.writeDeadline.IsZero())
+}
@@ -7,6 +7,7 @@ package mock_actor
 import (
 	context "context"
 	model "films_library/internal/model"
+	pagination "films_library/pkg/pagination"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -80,19 +81,34 @@ func (mr *MockUsecaseMockRecorder) DeleteActor(ctx, actorID interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteActor", reflect.TypeOf((*MockUsecase)(nil).DeleteActor), ctx, actorID)
 }
 
+// ExportActors mocks base method.
+func (m *MockUsecase) ExportActors(ctx context.Context, fn func(model.Actor) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportActors", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportActors indicates an expected call of ExportActors.
+func (mr *MockUsecaseMockRecorder) ExportActors(ctx, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportActors", reflect.TypeOf((*MockUsecase)(nil).ExportActors), ctx, fn)
+}
+
 // GetActors mocks base method.
-func (m *MockUsecase) GetActors(ctx context.Context) ([]model.ResponseActor, error) {
+func (m *MockUsecase) GetActors(ctx context.Context, filter model.ActorFilter) ([]model.ResponseActor, pagination.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetActors", ctx)
+	ret := m.ctrl.Call(m, "GetActors", ctx, filter)
 	ret0, _ := ret[0].([]model.ResponseActor)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(pagination.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // GetActors indicates an expected call of GetActors.
-func (mr *MockUsecaseMockRecorder) GetActors(ctx interface{}) *gomock.Call {
+func (mr *MockUsecaseMockRecorder) GetActors(ctx, filter interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActors", reflect.TypeOf((*MockUsecase)(nil).GetActors), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActors", reflect.TypeOf((*MockUsecase)(nil).GetActors), ctx, filter)
 }
 
 // UpdateActor mocks base method.
@@ -148,19 +164,34 @@ func (mr *MockRepositoryMockRecorder) AddActor(ctx, actor interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddActor", reflect.TypeOf((*MockRepository)(nil).AddActor), ctx, actor)
 }
 
-// CheckActor mocks base method.
-func (m *MockRepository) CheckActor(ctx context.Context, actor uint) (bool, error) {
+// CheckActors mocks base method.
+func (m *MockRepository) CheckActors(ctx context.Context, actors []uint) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CheckActor", ctx, actor)
+	ret := m.ctrl.Call(m, "CheckActors", ctx, actors)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CheckActor indicates an expected call of CheckActor.
-func (mr *MockRepositoryMockRecorder) CheckActor(ctx, actor interface{}) *gomock.Call {
+// CheckActors indicates an expected call of CheckActors.
+func (mr *MockRepositoryMockRecorder) CheckActors(ctx, actors interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckActor", reflect.TypeOf((*MockRepository)(nil).CheckActor), ctx, actor)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckActors", reflect.TypeOf((*MockRepository)(nil).CheckActors), ctx, actors)
+}
+
+// CountActors mocks base method.
+func (m *MockRepository) CountActors(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountActors", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountActors indicates an expected call of CountActors.
+func (mr *MockRepositoryMockRecorder) CountActors(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountActors", reflect.TypeOf((*MockRepository)(nil).CountActors), ctx)
 }
 
 // DeleteActor mocks base method.
@@ -178,6 +209,20 @@ func (mr *MockRepositoryMockRecorder) DeleteActor(ctx, actorID interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteActor", reflect.TypeOf((*MockRepository)(nil).DeleteActor), ctx, actorID)
 }
 
+// ExportActors mocks base method.
+func (m *MockRepository) ExportActors(ctx context.Context, fn func(model.Actor) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportActors", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportActors indicates an expected call of ExportActors.
+func (mr *MockRepositoryMockRecorder) ExportActors(ctx, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportActors", reflect.TypeOf((*MockRepository)(nil).ExportActors), ctx, fn)
+}
+
 // GetActor mocks base method.
 func (m *MockRepository) GetActor(ctx context.Context, actorID uint) (model.Actor, error) {
 	m.ctrl.T.Helper()
@@ -194,18 +239,18 @@ func (mr *MockRepositoryMockRecorder) GetActor(ctx, actorID interface{}) *gomock
 }
 
 // GetActors mocks base method.
-func (m *MockRepository) GetActors(ctx context.Context) ([]model.ResponseActor, error) {
+func (m *MockRepository) GetActors(ctx context.Context, filter model.ActorFilter) ([]model.ResponseActor, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetActors", ctx)
+	ret := m.ctrl.Call(m, "GetActors", ctx, filter)
 	ret0, _ := ret[0].([]model.ResponseActor)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetActors indicates an expected call of GetActors.
-func (mr *MockRepositoryMockRecorder) GetActors(ctx interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) GetActors(ctx, filter interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActors", reflect.TypeOf((*MockRepository)(nil).GetActors), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActors", reflect.TypeOf((*MockRepository)(nil).GetActors), ctx, filter)
 }
 
 // UpdateActor mocks base method.
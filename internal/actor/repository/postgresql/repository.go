@@ -3,7 +3,11 @@ package postgres
 import (
 	"context"
 	"films_library/internal/model"
+	"films_library/pkg/pagination"
 	"films_library/pkg/postgres"
+	"fmt"
+	"slices"
+	"strings"
 )
 
 type Repository struct {
@@ -55,27 +59,54 @@ func (ar *Repository) DeleteActor(ctx context.Context, actorID uint) (uint, erro
 }
 
 func (ar *Repository) GetActor(ctx context.Context, actorID uint) (model.Actor, error) {
-	sqlQuery := `SELECT id, name, sex, birth_date FROM actor WHERE id = $1`
+	sqlQuery := `
+        SELECT a.actor_id, a.name, COALESCE(a.sex, ''), COALESCE(to_char(a.birth_date, 'YYYY-MM-DD'), ''),
+            (SELECT h.etag FROM actor_headshot h WHERE h.actor_id = a.actor_id)
+        FROM actor a
+        WHERE a.actor_id = $1`
 	row := ar.db.QueryRow(ctx, sqlQuery, actorID)
 	var actor model.Actor
+	var headshotETag *string
 	if err := row.Scan(
 		&actor.ID,
 		&actor.Name,
 		&actor.Sex,
 		&actor.BirthDate,
+		&headshotETag,
 	); err != nil {
 		return model.Actor{}, err
 	}
+	actor.Headshot = headshot(uint64(actor.ID), headshotETag)
 	return actor, nil
 }
 
-func (ar *Repository) GetActors(ctx context.Context) ([]model.ResponseActor, error) {
-	sqlQuery := `SELECT id, name, sex, birth_date FROM actor;`
-	subQuery := ` SELECT fa.movie_id, f.title
-					FROM film_actor AS fa
-					JOIN film f ON f.movie_id = fa.movie_id WHERE fa.actor_id = $1;`
+func (ar *Repository) GetActors(ctx context.Context, filter model.ActorFilter) ([]model.ResponseActor, error) {
+	sqlQuery := `
+        SELECT a.actor_id, a.name, COALESCE(a.sex, ''), COALESCE(a.birth_date, '0001-01-01'),
+            COALESCE((SELECT jsonb_object_agg(x.provider, x.external_id) FROM actor_external_id x WHERE x.actor_id = a.actor_id), '{}'),
+            (SELECT h.etag FROM actor_headshot h WHERE h.actor_id = a.actor_id)
+        FROM actor a`
+
+	backward := filter.Backward()
+
+	var (
+		args       []interface{}
+		conditions []string
+	)
+	if len(filter.IDs) > 0 {
+		args = append(args, filter.IDs)
+		conditions = append(conditions, fmt.Sprintf("a.actor_id = ANY($%d::bigint[])", len(args)))
+	}
+	condition, clauses := filter.Keyset(pagination.Order{Key: "a.actor_id"}, &args)
+	if condition != "" {
+		conditions = append(conditions, condition)
+	}
+	if len(conditions) > 0 {
+		sqlQuery += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	sqlQuery += clauses
 
-	rows, err := ar.db.Query(ctx, sqlQuery)
+	rows, err := ar.db.Query(ctx, sqlQuery, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -84,44 +115,139 @@ func (ar *Repository) GetActors(ctx context.Context) ([]model.ResponseActor, err
 	var actors []model.ResponseActor
 	for rows.Next() {
 		var actor model.ResponseActor
+		var headshotETag *string
 		if err := rows.Scan(
 			&actor.ActorID,
 			&actor.Name,
 			&actor.Sex,
 			&actor.BirthDate,
+			&actor.ExternalIDs,
+			&headshotETag,
 		); err != nil {
 			return nil, err
 		}
+		actor.Headshot = headshot(uint64(actor.ActorID), headshotETag)
+		actors = append(actors, actor)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
 
-		rowsFilms, err := ar.db.Query(ctx, subQuery, actor.ActorID)
-		if err != nil {
-			return nil, err
+	if backward {
+		slices.Reverse(actors)
+	}
+
+	if err := ar.attachFilms(ctx, actors); err != nil {
+		return nil, err
+	}
+	return actors, nil
+}
+
+// attachFilms loads the filmography of every actor with a single query.
+func (ar *Repository) attachFilms(ctx context.Context, actors []model.ResponseActor) error {
+	if len(actors) == 0 {
+		return nil
+	}
+
+	ids := make([]uint, 0, len(actors))
+	index := make(map[uint]int, len(actors))
+	for i, actor := range actors {
+		ids = append(ids, actor.ActorID)
+		index[actor.ActorID] = i
+	}
+
+	sqlQuery := `
+        SELECT fa.actor_id, f.film_id, f.title, fa.characters, fa.billing, fa.uncredited, fa.voice, fa.cameo
+        FROM film_actor AS fa
+        JOIN film f ON f.film_id = fa.film_id
+        WHERE fa.actor_id = ANY($1)
+        ORDER BY fa.actor_id, f.release_date`
+
+	rows, err := ar.db.Query(ctx, sqlQuery, ids)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var actorID uint
+		var film model.FilmObj
+		if err := rows.Scan(
+			&actorID,
+			&film.Id,
+			&film.Title,
+			&film.Characters,
+			&film.Billing,
+			&film.Uncredited,
+			&film.Voice,
+			&film.Cameo,
+		); err != nil {
+			return err
 		}
+		i := index[actorID]
+		actors[i].Films = append(actors[i].Films, film)
+	}
+	return rows.Err()
+}
+
+// ExportActors streams every actor to fn in ID order. It stops at the first
+// error fn returns.
+func (ar *Repository) ExportActors(ctx context.Context, fn func(model.Actor) error) error {
+	sqlQuery := `
+        SELECT a.actor_id, a.name, COALESCE(a.sex, ''), COALESCE(to_char(a.birth_date, 'YYYY-MM-DD'), ''),
+            (SELECT h.etag FROM actor_headshot h WHERE h.actor_id = a.actor_id)
+        FROM actor a
+        ORDER BY a.actor_id`
 
-		for rowsFilms.Next() {
-			var film model.FilmObj
-			if err := rowsFilms.Scan(
-				&film.Id,
-				&film.Title,
-			); err != nil {
-				return nil, err
-			}
-			actor.Films = append(actor.Films, film)
+	rows, err := ar.db.Query(ctx, sqlQuery)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var actor model.Actor
+		var headshotETag *string
+		if err := rows.Scan(&actor.ID, &actor.Name, &actor.Sex, &actor.BirthDate, &headshotETag); err != nil {
+			return err
 		}
-		if err := rowsFilms.Err(); err != nil {
-			return nil, err
+		actor.Headshot = headshot(uint64(actor.ID), headshotETag)
+		if err := fn(actor); err != nil {
+			return err
 		}
-
-		actors = append(actors, actor)
 	}
+	return rows.Err()
+}
 
-	if err := rows.Err(); err != nil {
-		return nil, err
+func (ar *Repository) CountActors(ctx context.Context) (int64, error) {
+	sqlQuery := `SELECT count(*) FROM actor`
+
+	var total int64
+	if err := ar.db.QueryRow(ctx, sqlQuery).Scan(&total); err != nil {
+		return 0, err
 	}
+	return total, nil
+}
 
-	return actors, nil
+func (ar *Repository) CheckActors(ctx context.Context, actors []uint) (bool, error) {
+	sqlQuery := `
+        SELECT COALESCE(bool_and(a.actor_id IS NOT NULL), true)
+        FROM unnest($1::bigint[]) AS ids(actor_id)
+        LEFT JOIN actor a ON a.actor_id = ids.actor_id
+    `
+
+	var exist bool
+	if err := ar.db.QueryRow(ctx, sqlQuery, actors).Scan(&exist); err != nil {
+		return false, err
+	}
+	return exist, nil
 }
 
-func (ar *Repository) CheckActor(ctx context.Context, actor uint) (bool, error) {
-	return false, nil
+// headshot returns the URLs of the headshot of an actor, if they have one.
+func headshot(id uint64, etag *string) *model.ImageURLs {
+	if etag == nil {
+		return nil
+	}
+	urls := model.NewImageURLs(model.EntityActor, id, *etag)
+	return &urls
 }
@@ -1 +1,40 @@
 package postgres
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"films_library/internal/model"
+	"films_library/pkg/pagination"
+
+	"github.com/pashagolub/pgxmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetActorsWithoutSexOrBirthDate(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mock.Close()
+
+	repo := NewRepository(mock)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT a.actor_id, a.name, COALESCE(a.sex, ''), COALESCE(a.birth_date, '0001-01-01'),`)).
+		WithArgs(2).
+		WillReturnRows(pgxmock.NewRows([]string{"actor_id", "name", "sex", "birth_date", "external_ids", "headshot"}).
+			AddRow(uint(4), "Keanu Reeves", nil, nil, model.ExternalIDs{}, (*string)(nil)))
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM film_actor AS fa`)).
+		WithArgs([]uint{4}).
+		WillReturnRows(pgxmock.NewRows([]string{"actor_id", "film_id", "title", "characters", "billing", "uncredited", "voice", "cameo"}))
+
+	actors, err := repo.GetActors(context.Background(), model.ActorFilter{Params: pagination.Params{Limit: 1}})
+	assert.NoError(t, err)
+	assert.Equal(t, []model.ResponseActor{{ActorID: 4, Name: "Keanu Reeves", BirthDate: time.Time{}, ExternalIDs: model.ExternalIDs{}}}, actors)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
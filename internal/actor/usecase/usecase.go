@@ -5,6 +5,7 @@ import (
 	"films_library/internal/actor"
 	"films_library/internal/model"
 	"films_library/pkg/logger"
+	"films_library/pkg/pagination"
 )
 
 type Usecase struct {
@@ -40,18 +41,34 @@ func (au *Usecase) DeleteActor(ctx context.Context, actorID uint) (uint, error)
 	return id, nil
 }
 
-func (au *Usecase) GetActors(ctx context.Context) ([]model.ResponseActor, error) {
-	actors, err := au.actorRepo.GetActors(ctx)
+func (au *Usecase) GetActors(ctx context.Context, filter model.ActorFilter) ([]model.ResponseActor, pagination.Page, error) {
+	actors, err := au.actorRepo.GetActors(ctx, filter)
 	if err != nil {
-		return []model.ResponseActor{}, err
+		return []model.ResponseActor{}, pagination.Page{}, err
 	}
-	return actors, nil
+
+	actors, page := pagination.Paginate(actors, filter.Params, func(actor model.ResponseActor) pagination.Cursor {
+		return pagination.Cursor{ID: uint64(actor.ActorID)}
+	})
+
+	if filter.WithTotal {
+		total, err := au.actorRepo.CountActors(ctx)
+		if err != nil {
+			return []model.ResponseActor{}, pagination.Page{}, err
+		}
+		page.Total = &total
+	}
+	return actors, page, nil
+}
+
+func (au *Usecase) ExportActors(ctx context.Context, fn func(model.Actor) error) error {
+	return au.actorRepo.ExportActors(ctx, fn)
 }
 
 func (au *Usecase) CheckActors(ctx context.Context, actors []uint) (bool, error) {
-	// exist, err := au.actorRepo.CheckActors(ctx, actors)
-	// if err != nil {
-	// 	return false, err
-	// }
-	return false, nil
+	exist, err := au.actorRepo.CheckActors(ctx, actors)
+	if err != nil {
+		return false, err
+	}
+	return exist, nil
 }
@@ -185,9 +185,9 @@ func TestUsecase_GetActors(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			actorRepo.EXPECT().GetActors(ctx).Return(tc.actors, tc.expectedError)
+			actorRepo.EXPECT().GetActors(ctx, model.ActorFilter{}).Return(tc.actors, tc.expectedError)
 
-			actors, err := usecase.GetActors(ctx)
+			actors, _, err := usecase.GetActors(ctx, model.ActorFilter{})
 			if err != tc.expectedError {
 				t.Errorf("Unexpected error: expected %v, got %v", tc.expectedError, err)
 			}
@@ -198,3 +198,52 @@ func TestUsecase_GetActors(t *testing.T) {
 		})
 	}
 }
+
+func TestUsecase_CheckActors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	loggerMock := logger.NewMockInterface(ctrl)
+	actorRepo := mock_actor.NewMockRepository(ctrl)
+	usecase := NewActorUsecase(actorRepo, loggerMock)
+
+	ctx := context.Background()
+
+	testCases := []struct {
+		name          string
+		actors        []uint
+		exist         bool
+		expectedError error
+	}{
+		{
+			name:   "All actors exist",
+			actors: []uint{1, 2},
+			exist:  true,
+		},
+		{
+			name:   "Missing actor",
+			actors: []uint{1, 42},
+			exist:  false,
+		},
+		{
+			name:          "Error from repository",
+			actors:        []uint{1},
+			expectedError: errors.New("repository error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actorRepo.EXPECT().CheckActors(ctx, tc.actors).Return(tc.exist, tc.expectedError)
+
+			exist, err := usecase.CheckActors(ctx, tc.actors)
+			if err != tc.expectedError {
+				t.Errorf("Unexpected error: expected %v, got %v", tc.expectedError, err)
+			}
+
+			if exist != tc.exist {
+				t.Errorf("Expected %v, got %v", tc.exist, exist)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"os"
@@ -11,13 +12,62 @@ import (
 	actorDelivery "films_library/internal/actor/delivery/http"
 	actorRep "films_library/internal/actor/repository/postgresql"
 	actorUsecase "films_library/internal/actor/usecase"
+	"films_library/internal/auth"
+	authDelivery "films_library/internal/auth/delivery/http"
+	authRep "films_library/internal/auth/repository/postgresql"
+	authUsecase "films_library/internal/auth/usecase"
+	collectionDelivery "films_library/internal/collection/delivery/http"
+	collectionRep "films_library/internal/collection/repository/postgresql"
+	collectionUsecase "films_library/internal/collection/usecase"
+	crewDelivery "films_library/internal/crew/delivery/http"
+	crewRep "films_library/internal/crew/repository/postgresql"
+	crewUsecase "films_library/internal/crew/usecase"
+	externalDelivery "films_library/internal/externalid/delivery/http"
+	externalRep "films_library/internal/externalid/repository/postgresql"
+	externalUsecase "films_library/internal/externalid/usecase"
 	filmDelivery "films_library/internal/film/delivery/http"
 	filmRep "films_library/internal/film/repository/postgresql"
 	filmUsecase "films_library/internal/film/usecase"
+	holdDelivery "films_library/internal/hold/delivery/http"
+	holdRep "films_library/internal/hold/repository/postgresql"
+	holdUsecase "films_library/internal/hold/usecase"
+	importDelivery "films_library/internal/importer/delivery/http"
+	importRep "films_library/internal/importer/repository/postgresql"
+	importUsecase "films_library/internal/importer/usecase"
+	inventoryDelivery "films_library/internal/inventory/delivery/http"
+	inventoryRep "films_library/internal/inventory/repository/postgresql"
+	inventoryUsecase "films_library/internal/inventory/usecase"
+	loanDelivery "films_library/internal/loan/delivery/http"
+	loanRep "films_library/internal/loan/repository/postgresql"
+	loanUsecase "films_library/internal/loan/usecase"
+	lookupDelivery "films_library/internal/lookup/delivery/http"
+	lookupRep "films_library/internal/lookup/repository/postgresql"
+	lookupUsecase "films_library/internal/lookup/usecase"
+	mediaDelivery "films_library/internal/media/delivery/http"
+	mediaRep "films_library/internal/media/repository/postgresql"
+	mediaUsecase "films_library/internal/media/usecase"
+	memberDelivery "films_library/internal/member/delivery/http"
+	memberRep "films_library/internal/member/repository/postgresql"
+	memberUsecase "films_library/internal/member/usecase"
 	"films_library/internal/middlware"
+	"films_library/internal/model"
+	reviewDelivery "films_library/internal/review/delivery/http"
+	reviewRep "films_library/internal/review/repository/postgresql"
+	reviewUsecase "films_library/internal/review/usecase"
+	searchDelivery "films_library/internal/search/delivery/http"
+	searchRep "films_library/internal/search/repository/postgresql"
+	searchUsecase "films_library/internal/search/usecase"
+	taxonomyDelivery "films_library/internal/taxonomy/delivery/http"
+	taxonomyRep "films_library/internal/taxonomy/repository/postgresql"
+	taxonomyUsecase "films_library/internal/taxonomy/usecase"
+	watchlistDelivery "films_library/internal/watchlist/delivery/http"
+	watchlistRep "films_library/internal/watchlist/repository/postgresql"
+	watchlistUsecase "films_library/internal/watchlist/usecase"
+	"films_library/pkg/blob"
 	"films_library/pkg/httpserver"
 	"films_library/pkg/logger"
 	"films_library/pkg/postgres"
+	"films_library/pkg/worker"
 
 	_ "films_library/docs"
 
@@ -48,17 +98,79 @@ func Run(cfg *config.Config) {
 	}
 	defer pg.Close()
 
+	if err := prepareSchema(context.Background(), cfg, pg); err != nil {
+		l.Fatal(fmt.Errorf("app - Run - prepareSchema: %w", err))
+	}
+
 	// Usecase
 	actorRepo := actorRep.NewRepository(pg.Pool)
 	actorUsecase := actorUsecase.NewActorUsecase(actorRepo, l)
 
 	filmRepo := filmRep.NewRepository(pg.Pool)
-	filmUsecase := filmUsecase.NewFilmUsecase(filmRepo, l)
+	prior := model.ScorePrior{Mean: cfg.Ratings.PriorMean, Weight: cfg.Ratings.PriorWeight}
+	filmUsecase := filmUsecase.NewFilmUsecase(filmRepo, actorUsecase, prior, l)
 
-	// Middleware
+	crewRepo := crewRep.NewRepository(pg.Pool)
+	crewUsecase := crewUsecase.NewCrewUsecase(crewRepo, l)
 
-	recoveryMW := middlware.NewRecoveryMiddleware(l)
-	logMW := middlware.NewLoggingMiddleware(l)
+	taxonomyRepo := taxonomyRep.NewRepository(pg.Pool)
+	taxonomyUsecase := taxonomyUsecase.NewTaxonomyUsecase(taxonomyRepo, l)
+
+	reviewRepo := reviewRep.NewRepository(pg.Pool)
+	reviewUsecase := reviewUsecase.NewReviewUsecase(reviewRepo, l)
+
+	watchlistRepo := watchlistRep.NewRepository(pg.Pool)
+	watchlistUsecase := watchlistUsecase.NewWatchlistUsecase(watchlistRepo, prior, l)
+
+	collectionRepo := collectionRep.NewRepository(pg.Pool)
+	collectionUsecase := collectionUsecase.NewCollectionUsecase(collectionRepo, prior, l)
+
+	inventoryRepo := inventoryRep.NewRepository(pg.Pool)
+	inventoryUsecase := inventoryUsecase.NewInventoryUsecase(inventoryRepo, l)
+
+	holdRepo := holdRep.NewRepository(pg.Pool)
+	holdUsecase := holdUsecase.NewHoldUsecase(holdRepo, cfg.Holds.PickupWindow, l)
+
+	loanRepo := loanRep.NewRepository(pg.Pool)
+	loanPolicy := model.LoanPolicy{PeriodDays: cfg.Loans.PeriodDays, RenewLimit: cfg.Loans.RenewLimit}
+	loanUsecase := loanUsecase.NewLoanUsecase(loanRepo, holdUsecase, loanPolicy, l)
+
+	lookupRepo := lookupRep.NewRepository(pg.Pool)
+	lookupUsecase := lookupUsecase.NewLookupUsecase(lookupRepo, filmUsecase, l)
+
+	externalRepo := externalRep.NewRepository(pg.Pool)
+	externalUsecase := externalUsecase.NewExternalIDUsecase(externalRepo, filmUsecase, actorUsecase, l)
+
+	mediaStore, err := blob.NewLocal(cfg.Media.Dir)
+	if err != nil {
+		l.Fatal(fmt.Errorf("app - Run - blob.NewLocal: %w", err))
+	}
+	mediaRepo := mediaRep.NewRepository(pg.Pool)
+	mediaUsecase := mediaUsecase.NewMediaUsecase(mediaRepo, mediaStore, l)
+
+	memberRepo := memberRep.NewRepository(pg.Pool)
+	fees := model.FeeSchedule{
+		Membership:    cfg.Fees.Membership,
+		Replacement:   cfg.Fees.Replacement,
+		OverduePerDay: cfg.Fees.OverduePerDay,
+		OverdueMax:    cfg.Fees.OverdueMax,
+		SuspendAbove:  cfg.Members.SuspendAbove,
+	}
+	memberUsecase := memberUsecase.NewMemberUsecase(memberRepo, fees, l)
+
+	importRepo := importRep.NewRepository(pg.Pool)
+	importUsecase := importUsecase.NewImportUsecase(importRepo, cfg.Import.BatchSize, l)
+
+	searchRepo := searchRep.NewRepository(pg.Pool)
+	searchUsecase := searchUsecase.NewSearchUsecase(searchRepo, l)
+
+	tokens, err := tokenKeys(cfg.JWT)
+	if err != nil {
+		l.Fatal(fmt.Errorf("app - Run - tokenKeys: %w", err))
+	}
+
+	authRepo := authRep.NewRepository(pg.Pool)
+	authUsecase := authUsecase.NewAuthUsecase(authRepo, cfg.Auth.SessionTTL, cfg.Auth.RotateAfter, tokens, l)
 
 	// HTTP Server
 	mux := http.NewServeMux()
@@ -71,15 +183,62 @@ func Run(cfg *config.Config) {
 
 	filmDelivery.NewFilmHandler(mux, filmUsecase, l)
 	actorDelivery.NewActorHandler(mux, actorUsecase, l)
+	crewDelivery.NewCrewHandler(mux, crewUsecase, l)
+	taxonomyDelivery.NewTaxonomyHandler(mux, taxonomyUsecase, l)
+	reviewDelivery.NewReviewHandler(mux, reviewUsecase, l)
+	watchlistDelivery.NewWatchlistHandler(mux, watchlistUsecase, l)
+	collectionDelivery.NewCollectionHandler(mux, collectionUsecase, l)
+	inventoryDelivery.NewInventoryHandler(mux, inventoryUsecase, l)
+	loanDelivery.NewLoanHandler(mux, loanUsecase, l)
+	holdDelivery.NewHoldHandler(mux, holdUsecase, l)
+	memberDelivery.NewMemberHandler(mux, memberUsecase, l)
+	lookupDelivery.NewLookupHandler(mux, lookupUsecase, l)
+	externalDelivery.NewExternalIDHandler(mux, externalUsecase, l)
+	mediaDelivery.NewMediaHandler(mux, mediaUsecase, cfg.Media.MaxSize, l)
+	importDelivery.NewImportHandler(mux, importUsecase, l)
+	searchDelivery.NewSearchHandler(mux, searchUsecase, l)
+	authDelivery.NewAuthHandler(mux, authUsecase, cfg.Auth.CookieSecure, l)
 
-	r := recoveryMW.Recoverer(mux)
+	// Middleware
+	policy := auth.NewPolicy(mux,
+		auth.Permissions{"/swagger/": auth.Public},
+		filmDelivery.FilmPermissions,
+		actorDelivery.ActorPermissions,
+		crewDelivery.CrewPermissions,
+		taxonomyDelivery.TaxonomyPermissions,
+		reviewDelivery.ReviewPermissions,
+		watchlistDelivery.WatchlistPermissions,
+		collectionDelivery.CollectionPermissions,
+		inventoryDelivery.InventoryPermissions,
+		loanDelivery.LoanPermissions,
+		holdDelivery.HoldPermissions,
+		memberDelivery.MemberPermissions,
+		lookupDelivery.LookupPermissions,
+		externalDelivery.ExternalIDPermissions,
+		mediaDelivery.MediaPermissions,
+		importDelivery.ImportPermissions,
+		searchDelivery.SearchPermissions,
+		authDelivery.AuthPermissions,
+	)
+
+	recoveryMW := middlware.NewRecoveryMiddleware(l)
+	logMW := middlware.NewLoggingMiddleware(l)
+	authMW := middlware.NewAuthMiddleware(authUsecase, policy, cfg.Auth.CookieSecure, l)
+
+	r := middlware.AllowedMethod(mux)
+	r = recoveryMW.Recoverer(r)
 	r = logMW.LoggingMiddleware(r)
-	r = middlware.AllowedMethod(r)
-	r = middlware.Authentication(r)
+	r = authMW.Authentication(r)
 
 	httpServer := httpserver.New(r, httpserver.Port(cfg.HTTP.Port))
 	l.Info("server running on " + cfg.HTTP.Port)
 
+	// Background jobs
+	overdueWorker := worker.New(loanUsecase.MarkOverdue, l,
+		worker.Name("overdue loans"), worker.Interval(cfg.Loans.OverdueInterval))
+	holdWorker := worker.New(holdUsecase.ExpireHolds, l,
+		worker.Name("expired holds"), worker.Interval(cfg.Holds.ExpiryInterval))
+
 	// Waiting signal
 	interrupt := make(chan os.Signal, 1)
 	signal.Notify(interrupt, os.Interrupt, syscall.SIGTERM)
@@ -96,4 +255,7 @@ func Run(cfg *config.Config) {
 	if err != nil {
 		l.Error(fmt.Errorf("app - Run - httpServer.Shutdown: %w", err))
 	}
+
+	overdueWorker.Shutdown()
+	holdWorker.Shutdown()
 }
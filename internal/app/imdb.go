@@ -0,0 +1,84 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"films_library/config"
+	imdbRep "films_library/internal/imdb/repository/postgresql"
+	imdbUsecase "films_library/internal/imdb/usecase"
+	"films_library/internal/model"
+	"films_library/pkg/logger"
+	"films_library/pkg/postgres"
+)
+
+var errIMDbUsage = errors.New("usage: imdb [-type movie,...] [-min-year YEAR] [-min-votes N] [-adult] [-restart] DIR")
+
+// IMDb runs the imdb subcommand, which imports films, actors and cast from
+// the IMDb datasets in a directory. An interrupted import resumes on the
+// next run.
+func IMDb(cfg *config.Config, args []string) error {
+	flags := flag.NewFlagSet("imdb", flag.ContinueOnError)
+	types := flags.String("type", "movie", "comma-separated title types to import, empty for all")
+	minYear := flags.Int("min-year", 0, "import titles released in or after this year")
+	minVotes := flags.Int("min-votes", 0, "import titles with at least this many votes, needs title.ratings")
+	adult := flags.Bool("adult", false, "import adult titles")
+	restart := flags.Bool("restart", false, "import every dataset from the start")
+	if err := flags.Parse(args); err != nil {
+		return errIMDbUsage
+	}
+	if flags.NArg() != 1 || *minYear < 0 || *minVotes < 0 {
+		return errIMDbUsage
+	}
+
+	dir := flags.Arg(0)
+	if info, err := os.Stat(dir); err != nil {
+		return fmt.Errorf("app - IMDb - %w", err)
+	} else if !info.IsDir() {
+		return fmt.Errorf("app - IMDb - %s is not a directory", dir)
+	}
+
+	filter := model.IMDbFilter{MinYear: *minYear, MinVotes: *minVotes, Adult: *adult}
+	for _, t := range strings.Split(*types, ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			filter.TitleTypes = append(filter.TitleTypes, t)
+		}
+	}
+
+	pg, err := postgres.New(
+		cfg.PG.Host,
+		cfg.PG.User,
+		cfg.PG.Password,
+		cfg.PG.Name,
+		cfg.PG.Port,
+		postgres.MaxPoolSize(cfg.PG.PoolMax),
+	)
+	if err != nil {
+		return fmt.Errorf("app - IMDb - postgres.New: %w", err)
+	}
+	defer pg.Close()
+
+	l := logger.New(cfg.Log.Level)
+	usecase := imdbUsecase.NewIMDbUsecase(imdbRep.NewRepository(pg.Pool), cfg.Import.BatchSize, l)
+
+	report, err := usecase.Import(context.Background(), os.DirFS(dir), filter, *restart)
+	for _, progress := range report {
+		if progress.Skipped {
+			fmt.Printf("%s: already imported\n", progress.Dataset)
+			continue
+		}
+		fmt.Printf("%s: read %d line(s), imported %d", progress.Dataset, progress.Lines, progress.Imported)
+		if progress.Resumed > 0 {
+			fmt.Printf(", resumed after %d", progress.Resumed)
+		}
+		fmt.Println()
+	}
+	if err != nil {
+		return fmt.Errorf("app - IMDb - %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"films_library/config"
+	importRep "films_library/internal/importer/repository/postgresql"
+	importUsecase "films_library/internal/importer/usecase"
+	"films_library/internal/model"
+	"films_library/pkg/logger"
+	"films_library/pkg/postgres"
+)
+
+var errImportUsage = errors.New("usage: import [-dry-run] [-format csv|ndjson] films|actors FILE (- reads stdin)")
+
+// Import runs the import subcommand, which loads films or actors from a CSV
+// or NDJSON file. The format is taken from the file extension unless given.
+func Import(cfg *config.Config, args []string) error {
+	flags := flag.NewFlagSet("import", flag.ContinueOnError)
+	dryRun := flags.Bool("dry-run", false, "validate the rows without inserting them")
+	format := flags.String("format", "", "csv or ndjson")
+	if err := flags.Parse(args); err != nil {
+		return errImportUsage
+	}
+	if flags.NArg() != 2 {
+		return errImportUsage
+	}
+	kind, path := flags.Arg(0), flags.Arg(1)
+	if kind != "films" && kind != "actors" {
+		return errImportUsage
+	}
+
+	if *format == "" {
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".csv":
+			*format = model.ImportCSV
+		case ".ndjson", ".jsonl":
+			*format = model.ImportNDJSON
+		default:
+			return fmt.Errorf("app - Import - cannot tell the format of %q, use -format", path)
+		}
+	}
+
+	var in io.Reader = os.Stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return fmt.Errorf("app - Import - open: %w", err)
+		}
+		defer f.Close()
+		in = f
+	}
+
+	pg, err := postgres.New(
+		cfg.PG.Host,
+		cfg.PG.User,
+		cfg.PG.Password,
+		cfg.PG.Name,
+		cfg.PG.Port,
+		postgres.MaxPoolSize(cfg.PG.PoolMax),
+	)
+	if err != nil {
+		return fmt.Errorf("app - Import - postgres.New: %w", err)
+	}
+	defer pg.Close()
+
+	l := logger.New(cfg.Log.Level)
+	usecase := importUsecase.NewImportUsecase(importRep.NewRepository(pg.Pool), cfg.Import.BatchSize, l)
+
+	run := usecase.ImportFilms
+	if kind == "actors" {
+		run = usecase.ImportActors
+	}
+	report, err := run(context.Background(), *format, in, *dryRun)
+	if err != nil {
+		return fmt.Errorf("app - Import - %w", err)
+	}
+
+	for _, e := range report.Errors {
+		if e.Field != "" {
+			fmt.Printf("row %d: %s: %s\n", e.Row, e.Field, e.Message)
+		} else {
+			fmt.Printf("row %d: %s\n", e.Row, e.Message)
+		}
+	}
+	if report.Failed > len(report.Errors) {
+		fmt.Printf("... and %d more failed row(s)\n", report.Failed-len(report.Errors))
+	}
+
+	verb := "imported"
+	if report.DryRun {
+		verb = "would import"
+	}
+	fmt.Printf("read %d row(s): %s %d, %d failed\n", report.Rows, verb, report.Imported, report.Failed)
+	return nil
+}
@@ -0,0 +1,93 @@
+package app
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"fmt"
+
+	"films_library/config"
+	authUsecase "films_library/internal/auth/usecase"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// minSecretLen is the shortest HS256 secret accepted, matching the hash size.
+const minSecretLen = 32
+
+// tokenKeys decodes the JWT keys of cfg.
+func tokenKeys(cfg config.JWT) (authUsecase.TokenKeys, error) {
+	keys := authUsecase.TokenKeys{
+		Issuer: cfg.Issuer,
+		TTL:    cfg.TTL,
+		Active: cfg.ActiveKey,
+		Keys:   make(map[string]authUsecase.TokenKey, len(cfg.Keys)),
+	}
+
+	for _, k := range cfg.Keys {
+		if k.ID == "" {
+			return authUsecase.TokenKeys{}, fmt.Errorf("jwt key without id")
+		}
+		if _, ok := keys.Keys[k.ID]; ok {
+			return authUsecase.TokenKeys{}, fmt.Errorf("jwt key %q: duplicate id", k.ID)
+		}
+
+		key, err := tokenKey(k)
+		if err != nil {
+			return authUsecase.TokenKeys{}, fmt.Errorf("jwt key %q: %w", k.ID, err)
+		}
+		keys.Keys[k.ID] = key
+	}
+
+	if active, ok := keys.Keys[keys.Active]; keys.Active != "" && (!ok || active.SignKey == nil) {
+		return authUsecase.TokenKeys{}, fmt.Errorf("jwt active key %q cannot sign", keys.Active)
+	}
+	return keys, nil
+}
+
+func tokenKey(k config.JWTKey) (authUsecase.TokenKey, error) {
+	switch k.Algorithm {
+	case jwt.SigningMethodHS256.Alg():
+		if len(k.Secret) < minSecretLen {
+			return authUsecase.TokenKey{}, fmt.Errorf("secret shorter than %d bytes", minSecretLen)
+		}
+		secret := []byte(k.Secret)
+		return authUsecase.TokenKey{Method: jwt.SigningMethodHS256, SignKey: secret, VerifyKey: secret}, nil
+
+	case jwt.SigningMethodEdDSA.Alg():
+		key := authUsecase.TokenKey{Method: jwt.SigningMethodEdDSA}
+
+		if k.PrivateKey != "" {
+			raw, err := base64.StdEncoding.DecodeString(k.PrivateKey)
+			if err != nil {
+				return authUsecase.TokenKey{}, fmt.Errorf("private key: %w", err)
+			}
+
+			var private ed25519.PrivateKey
+			switch len(raw) {
+			case ed25519.SeedSize:
+				private = ed25519.NewKeyFromSeed(raw)
+			case ed25519.PrivateKeySize:
+				private = ed25519.PrivateKey(raw)
+			default:
+				return authUsecase.TokenKey{}, fmt.Errorf("private key has %d bytes", len(raw))
+			}
+			key.SignKey = private
+			key.VerifyKey = private.Public()
+		}
+
+		if k.PublicKey != "" {
+			raw, err := base64.StdEncoding.DecodeString(k.PublicKey)
+			if err != nil || len(raw) != ed25519.PublicKeySize {
+				return authUsecase.TokenKey{}, fmt.Errorf("public key is not a base64 Ed25519 key")
+			}
+			key.VerifyKey = ed25519.PublicKey(raw)
+		}
+
+		if key.VerifyKey == nil {
+			return authUsecase.TokenKey{}, fmt.Errorf("EdDSA key needs a public or private key")
+		}
+		return key, nil
+	}
+
+	return authUsecase.TokenKey{}, fmt.Errorf("unsupported alg %q", k.Algorithm)
+}
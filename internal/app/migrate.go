@@ -0,0 +1,112 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"films_library/config"
+	"films_library/migrations"
+	"films_library/pkg/migrate"
+	"films_library/pkg/postgres"
+)
+
+var errMigrateUsage = errors.New("usage: migrate up | down [N] | status | force VERSION")
+
+// Migrate runs the migrate subcommand against the configured database.
+func Migrate(cfg *config.Config, args []string) error {
+	if len(args) == 0 {
+		return errMigrateUsage
+	}
+
+	pg, err := postgres.New(
+		cfg.PG.Host,
+		cfg.PG.User,
+		cfg.PG.Password,
+		cfg.PG.Name,
+		cfg.PG.Port,
+		postgres.MaxPoolSize(cfg.PG.PoolMax),
+	)
+	if err != nil {
+		return fmt.Errorf("app - Migrate - postgres.New: %w", err)
+	}
+	defer pg.Close()
+
+	m, err := migrate.New(pg.Pool, migrations.FS)
+	if err != nil {
+		return fmt.Errorf("app - Migrate - migrate.New: %w", err)
+	}
+
+	ctx := context.Background()
+
+	switch args[0] {
+	case "up":
+		applied, err := m.Up(ctx)
+		fmt.Printf("applied %d migration(s)\n", applied)
+		return err
+
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			if steps, err = strconv.Atoi(args[1]); err != nil || steps < 1 {
+				return errMigrateUsage
+			}
+		}
+		reverted, err := m.Down(ctx, steps)
+		fmt.Printf("reverted %d migration(s)\n", reverted)
+		return err
+
+	case "status":
+		status, err := m.Status(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("version: %d (latest %d)\n", status.Version, status.Latest)
+		if status.Dirty {
+			fmt.Println("dirty: the last migration failed, fix the schema and run migrate force")
+		}
+		for _, pending := range status.Pending {
+			fmt.Printf("pending: %d_%s\n", pending.Version, pending.Name)
+		}
+		return nil
+
+	case "force":
+		if len(args) < 2 {
+			return errMigrateUsage
+		}
+		version, err := strconv.ParseInt(args[1], 10, 64)
+		if err != nil {
+			return errMigrateUsage
+		}
+		return m.Force(ctx, version)
+	}
+
+	return errMigrateUsage
+}
+
+// prepareSchema migrates the database on start when configured to, and
+// refuses to continue against a schema older than the binary.
+func prepareSchema(ctx context.Context, cfg *config.Config, pg *postgres.Postgres) error {
+	if !cfg.Migrations.AutoUp && !cfg.Migrations.Check {
+		return nil
+	}
+
+	m, err := migrate.New(pg.Pool, migrations.FS)
+	if err != nil {
+		return fmt.Errorf("migrate.New: %w", err)
+	}
+
+	if cfg.Migrations.AutoUp {
+		if _, err := m.Up(ctx); err != nil {
+			return fmt.Errorf("migrate.Up: %w", err)
+		}
+	}
+
+	if cfg.Migrations.Check {
+		if err := m.Check(ctx); err != nil {
+			return fmt.Errorf("migrate.Check: %w", err)
+		}
+	}
+	return nil
+}
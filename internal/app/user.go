@@ -0,0 +1,67 @@
+package app
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"films_library/config"
+	authRep "films_library/internal/auth/repository/postgresql"
+	authUsecase "films_library/internal/auth/usecase"
+	"films_library/internal/model"
+	"films_library/pkg/logger"
+	"films_library/pkg/postgres"
+
+	"github.com/go-playground/validator/v10"
+)
+
+var errUserUsage = errors.New("usage: user add USERNAME viewer|editor|admin (password is read from stdin)")
+
+// User runs the user subcommand, which creates accounts before anyone can
+// log in through the API.
+func User(cfg *config.Config, args []string) error {
+	if len(args) != 3 || args[0] != "add" {
+		return errUserUsage
+	}
+
+	password, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && password == "" {
+		return fmt.Errorf("app - User - read password: %w", err)
+	}
+
+	request := model.AddUserRequest{
+		Username: args[1],
+		Password: strings.TrimRight(password, "\r\n"),
+		Role:     args[2],
+	}
+	if err := validator.New().Struct(request); err != nil {
+		return fmt.Errorf("app - User - %w", err)
+	}
+
+	pg, err := postgres.New(
+		cfg.PG.Host,
+		cfg.PG.User,
+		cfg.PG.Password,
+		cfg.PG.Name,
+		cfg.PG.Port,
+		postgres.MaxPoolSize(cfg.PG.PoolMax),
+	)
+	if err != nil {
+		return fmt.Errorf("app - User - postgres.New: %w", err)
+	}
+	defer pg.Close()
+
+	l := logger.New(cfg.Log.Level)
+	usecase := authUsecase.NewAuthUsecase(authRep.NewRepository(pg.Pool), cfg.Auth.SessionTTL, cfg.Auth.RotateAfter, authUsecase.TokenKeys{}, l)
+
+	user, err := usecase.AddUser(context.Background(), request)
+	if err != nil {
+		return fmt.Errorf("app - User - AddUser: %w", err)
+	}
+
+	fmt.Printf("created user %d: %s (%s)\n", user.ID, user.Username, user.Role)
+	return nil
+}
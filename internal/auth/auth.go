@@ -0,0 +1,41 @@
+package auth
+
+import (
+	"context"
+
+	"films_library/internal/model"
+	"films_library/pkg/pagination"
+)
+
+type Usecase interface {
+	Login(ctx context.Context, request model.LoginRequest) (model.User, model.Session, error)
+	Authenticate(ctx context.Context, token string) (model.User, model.Session, error)
+	Logout(ctx context.Context, token string) error
+	LogoutAll(ctx context.Context, userID uint64) error
+	AddUser(ctx context.Context, request model.AddUserRequest) (model.User, error)
+	GetUsers(ctx context.Context, filter model.UserFilter) ([]model.User, pagination.Page, error)
+	SetRole(ctx context.Context, id uint64, role string) (model.User, error)
+	AuthenticateBearer(ctx context.Context, token string) (model.User, Credential, error)
+	IssueToken(ctx context.Context, user model.User) (model.Token, error)
+	AddAPIKey(ctx context.Context, user model.User, request model.AddAPIKeyRequest) (model.APIKey, error)
+	GetAPIKeys(ctx context.Context, userID uint64) ([]model.APIKey, error)
+	RevokeAPIKey(ctx context.Context, userID, keyID uint64) error
+}
+
+type Repository interface {
+	GetUserByName(ctx context.Context, username string) (model.User, error)
+	AddUser(ctx context.Context, user model.User) (uint64, error)
+	AddSession(ctx context.Context, session model.Session) error
+	GetSession(ctx context.Context, id string) (model.Session, model.User, error)
+	RotateSession(ctx context.Context, oldID string, session model.Session) error
+	RevokeSession(ctx context.Context, id string) error
+	RevokeUserSessions(ctx context.Context, userID uint64) error
+	GetUsers(ctx context.Context, filter model.UserFilter) ([]model.User, error)
+	SetUserRole(ctx context.Context, id uint64, role string) (model.User, error)
+	GetUser(ctx context.Context, id uint64) (model.User, error)
+	AddAPIKey(ctx context.Context, key model.APIKey) (model.APIKey, error)
+	GetAPIKeyByHash(ctx context.Context, hash string) (model.APIKey, model.User, error)
+	GetAPIKeys(ctx context.Context, userID uint64) ([]model.APIKey, error)
+	RevokeAPIKey(ctx context.Context, userID, keyID uint64) error
+	TouchAPIKey(ctx context.Context, keyID uint64) error
+}
@@ -0,0 +1,93 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"films_library/internal/model"
+)
+
+// SessionCookie is the name of the cookie carrying the session token.
+const SessionCookie = "session_id"
+
+// Kinds of credential a request can be authenticated with.
+const (
+	CredentialSession = "session"
+	CredentialJWT     = "jwt"
+	CredentialAPIKey  = "api_key"
+)
+
+// Credential describes how a request was authenticated. Scopes is nil unless
+// the credential is limited to a subset of the permissions of its user.
+type Credential struct {
+	Kind   string
+	Scopes []string
+}
+
+// Allows reports whether the credential's scopes cover perm.
+func (c Credential) Allows(perm Permission) bool {
+	if c.Scopes == nil || perm == Public || perm == Authenticated {
+		return true
+	}
+	for _, scope := range c.Scopes {
+		if Permission(scope) == perm {
+			return true
+		}
+	}
+	return false
+}
+
+type userKey struct{}
+
+type credentialKey struct{}
+
+// WithUser returns a copy of ctx carrying the authenticated user.
+func WithUser(ctx context.Context, user model.User) context.Context {
+	return context.WithValue(ctx, userKey{}, user)
+}
+
+// UserFromContext returns the user put into ctx by the authentication middleware.
+func UserFromContext(ctx context.Context) (model.User, bool) {
+	user, ok := ctx.Value(userKey{}).(model.User)
+	return user, ok
+}
+
+// WithCredential returns a copy of ctx carrying the credential of the request.
+func WithCredential(ctx context.Context, credential Credential) context.Context {
+	return context.WithValue(ctx, credentialKey{}, credential)
+}
+
+// CredentialFromContext returns the credential put into ctx by the
+// authentication middleware.
+func CredentialFromContext(ctx context.Context) (Credential, bool) {
+	credential, ok := ctx.Value(credentialKey{}).(Credential)
+	return credential, ok
+}
+
+// SetCookie hands a freshly issued session token to the client.
+func SetCookie(w http.ResponseWriter, session model.Session, secure bool) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     SessionCookie,
+		Value:    session.Token,
+		Path:     "/",
+		Expires:  session.ExpiresAt,
+		HttpOnly: true,
+		Secure:   secure,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+// ClearCookie tells the client to drop its session token.
+func ClearCookie(w http.ResponseWriter, secure bool) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     SessionCookie,
+		Value:    "",
+		Path:     "/",
+		Expires:  time.Unix(0, 0),
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   secure,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
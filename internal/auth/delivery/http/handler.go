@@ -0,0 +1,372 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"films_library/internal/auth"
+	"films_library/internal/model"
+	"films_library/pkg/logger"
+	"films_library/pkg/pagination"
+	"films_library/pkg/response"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mailru/easyjson"
+)
+
+type AuthHandler struct {
+	authUsecase  auth.Usecase
+	secureCookie bool
+	logger       logger.Interface
+}
+
+// AuthPermissions is the permission each auth route requires.
+var AuthPermissions = auth.Permissions{
+	"POST /auth/login":           auth.Public,
+	"POST /auth/logout":          auth.Authenticated,
+	"GET /auth/me":               auth.Authenticated,
+	"GET /admin/users":           auth.UserManage,
+	"PUT /admin/users/{id}/role": auth.UserManage,
+	"POST /auth/token":           auth.Authenticated,
+	"POST /auth/keys":            auth.Authenticated,
+	"GET /auth/keys":             auth.Authenticated,
+	"DELETE /auth/keys/{id}":     auth.Authenticated,
+}
+
+func NewAuthHandler(mux *http.ServeMux, au auth.Usecase, secureCookie bool, l logger.Interface) {
+	r := &AuthHandler{au, secureCookie, l}
+
+	mux.HandleFunc("POST /auth/login", r.Login)
+	mux.HandleFunc("POST /auth/logout", r.Logout)
+	mux.HandleFunc("GET /auth/me", r.Me)
+	mux.HandleFunc("GET /admin/users", r.GetUsers)
+	mux.HandleFunc("PUT /admin/users/{id}/role", r.SetRole)
+	mux.HandleFunc("POST /auth/token", r.IssueToken)
+	mux.HandleFunc("POST /auth/keys", r.AddAPIKey)
+	mux.HandleFunc("GET /auth/keys", r.GetAPIKeys)
+	mux.HandleFunc("DELETE /auth/keys/{id}", r.RevokeAPIKey)
+}
+
+// Login handles the HTTP POST request to open a session.
+// @Summary Log in
+// @Description Checks the username and password and sets the session_id cookie.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param credentials body model.LoginRequest true "Username and password"
+// @Success 200 {object} model.User "Logged in user"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /auth/login [post]
+func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
+	var request model.LoginRequest
+	if err := easyjson.UnmarshalFromReader(r.Body, &request); err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Corrupted request body", h.logger)
+		return
+	}
+
+	v := validator.New()
+	if err := v.Struct(request); err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Invalid request", h.logger)
+		return
+	}
+
+	user, session, err := h.authUsecase.Login(r.Context(), request)
+	if err != nil {
+		var unauthorized *model.ErrUnauthorized
+		if errors.As(err, &unauthorized) {
+			response.ErrorResponse(w, http.StatusUnauthorized, unauthorized.Message, h.logger)
+			return
+		}
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusInternalServerError, "Internal server error", h.logger)
+		return
+	}
+
+	auth.SetCookie(w, session, h.secureCookie)
+	response.SuccessResponse(w, http.StatusOK, user)
+}
+
+// Logout handles the HTTP POST request to close the current session.
+// @Summary Log out
+// @Description Revokes the current session, or every session of the user when all=true.
+// @Tags auth
+// @Produce json
+// @Param all query boolean false "Revoke every session of the user"
+// @Success 200 {string} string "Logged out"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /auth/logout [post]
+func (h *AuthHandler) Logout(w http.ResponseWriter, r *http.Request) {
+	var err error
+	if user, ok := auth.UserFromContext(r.Context()); ok && r.URL.Query().Get("all") == "true" {
+		err = h.authUsecase.LogoutAll(r.Context(), user.ID)
+	} else if cookie, cookieErr := r.Cookie(auth.SessionCookie); cookieErr == nil {
+		err = h.authUsecase.Logout(r.Context(), cookie.Value)
+	}
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusInternalServerError, "Internal server error", h.logger)
+		return
+	}
+
+	auth.ClearCookie(w, h.secureCookie)
+	response.SuccessResponse(w, http.StatusOK, response.NIL())
+}
+
+// Me handles the HTTP GET request for the logged in user.
+// @Summary Current user
+// @Description Returns the user the session belongs to.
+// @Tags auth
+// @Produce json
+// @Success 200 {object} model.User "Logged in user"
+// @Failure 401 {string} string "Unauthorized"
+// @Router /auth/me [get]
+func (h *AuthHandler) Me(w http.ResponseWriter, r *http.Request) {
+	user, ok := auth.UserFromContext(r.Context())
+	if !ok {
+		response.ErrorResponse(w, http.StatusUnauthorized, "unauthorized", h.logger)
+		return
+	}
+	response.SuccessResponse(w, http.StatusOK, user)
+}
+
+// GetUsers handles the HTTP GET request to list user accounts.
+// @Summary List users
+// @Description Retrieves a page of users with their roles. Requires user:manage.
+// @Tags auth
+// @Produce json
+// @Param limit query integer false "Page size (default 20, max 100)"
+// @Param offset query integer false "Number of users to skip"
+// @Param cursor query string false "Cursor of the page to fetch"
+// @Success 200 {array} model.User "List of users"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /admin/users [get]
+func (h *AuthHandler) GetUsers(w http.ResponseWriter, r *http.Request) {
+	params, err := pagination.ParseQuery(r.URL.Query())
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Bad query param", h.logger)
+		return
+	}
+
+	users, page, err := h.authUsecase.GetUsers(r.Context(), model.UserFilter{Params: params})
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusInternalServerError, "Internal server error", h.logger)
+		return
+	}
+
+	response.PageResponse(w, http.StatusOK, users, page)
+}
+
+// SetRole handles the HTTP PUT request to assign a role to a user.
+// @Summary Assign a role
+// @Description Replaces the role of a user. Requires user:manage.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param id path integer true "User ID"
+// @Param role body model.SetRoleRequest true "New role"
+// @Success 200 {object} model.User "Updated user"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Not Found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /admin/users/{id}/role [put]
+func (h *AuthHandler) SetRole(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+	if err != nil || id == 0 {
+		response.ErrorResponse(w, http.StatusBadRequest, response.InvalidURLParameter, h.logger)
+		return
+	}
+
+	var request model.SetRoleRequest
+	if err := easyjson.UnmarshalFromReader(r.Body, &request); err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Corrupted request body", h.logger)
+		return
+	}
+
+	v := validator.New()
+	if err := v.Struct(request); err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Invalid request", h.logger)
+		return
+	}
+
+	user, err := h.authUsecase.SetRole(r.Context(), id, request.Role)
+	if err != nil {
+		var notFound *model.ErrNotFound
+		if errors.As(err, &notFound) {
+			response.ErrorResponse(w, http.StatusNotFound, notFound.Message, h.logger)
+			return
+		}
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusInternalServerError, "Internal server error", h.logger)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusOK, user)
+}
+
+// IssueToken handles the HTTP POST request to sign a bearer token.
+// @Summary Issue a JWT
+// @Description Signs a short-lived bearer token for the logged in user. Requires a session cookie.
+// @Tags auth
+// @Produce json
+// @Success 200 {object} model.Token "Signed token"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /auth/token [post]
+func (h *AuthHandler) IssueToken(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.sessionUser(w, r)
+	if !ok {
+		return
+	}
+
+	token, err := h.authUsecase.IssueToken(r.Context(), user)
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusInternalServerError, "Internal server error", h.logger)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusOK, token)
+}
+
+// AddAPIKey handles the HTTP POST request to mint an API key.
+// @Summary Create an API key
+// @Description Mints a scoped API key for the logged in user. The key is only returned once.
+// @Description Scopes are permissions such as film:read and must be granted by the role of the user.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param key body model.AddAPIKeyRequest true "Name, scopes and optional expiry"
+// @Success 201 {object} model.APIKey "Created key"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /auth/keys [post]
+func (h *AuthHandler) AddAPIKey(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.sessionUser(w, r)
+	if !ok {
+		return
+	}
+
+	var request model.AddAPIKeyRequest
+	if err := easyjson.UnmarshalFromReader(r.Body, &request); err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Corrupted request body", h.logger)
+		return
+	}
+
+	v := validator.New()
+	if err := v.Struct(request); err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Invalid request", h.logger)
+		return
+	}
+
+	key, err := h.authUsecase.AddAPIKey(r.Context(), user, request)
+	if err != nil {
+		var forbidden *model.ErrForbidden
+		if errors.As(err, &forbidden) {
+			response.ErrorResponse(w, http.StatusForbidden, forbidden.Message, h.logger)
+			return
+		}
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusInternalServerError, "Internal server error", h.logger)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusCreated, key)
+}
+
+// GetAPIKeys handles the HTTP GET request to list the API keys of the user.
+// @Summary List API keys
+// @Description Lists the API keys of the logged in user, including revoked ones, with when each was last used.
+// @Tags auth
+// @Produce json
+// @Success 200 {array} model.APIKey "Keys"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /auth/keys [get]
+func (h *AuthHandler) GetAPIKeys(w http.ResponseWriter, r *http.Request) {
+	user, ok := auth.UserFromContext(r.Context())
+	if !ok {
+		response.ErrorResponse(w, http.StatusUnauthorized, "unauthorized", h.logger)
+		return
+	}
+
+	keys, err := h.authUsecase.GetAPIKeys(r.Context(), user.ID)
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusInternalServerError, "Internal server error", h.logger)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusOK, keys)
+}
+
+// RevokeAPIKey handles the HTTP DELETE request to revoke an API key.
+// @Summary Revoke an API key
+// @Description Revokes one of the API keys of the logged in user. Requires a session cookie.
+// @Tags auth
+// @Produce json
+// @Param id path integer true "Key ID"
+// @Success 200 {string} string "Revoked"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 404 {string} string "Not Found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /auth/keys/{id} [delete]
+func (h *AuthHandler) RevokeAPIKey(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.sessionUser(w, r)
+	if !ok {
+		return
+	}
+
+	id, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+	if err != nil || id == 0 {
+		response.ErrorResponse(w, http.StatusBadRequest, response.InvalidURLParameter, h.logger)
+		return
+	}
+
+	if err := h.authUsecase.RevokeAPIKey(r.Context(), user.ID, id); err != nil {
+		var notFound *model.ErrNotFound
+		if errors.As(err, &notFound) {
+			response.ErrorResponse(w, http.StatusNotFound, notFound.Message, h.logger)
+			return
+		}
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusInternalServerError, "Internal server error", h.logger)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusOK, response.NIL())
+}
+
+// sessionUser returns the user of a request authenticated by a session
+// cookie. Bearer credentials may not mint or revoke other credentials, which
+// would let a scoped key escape its scopes.
+func (h *AuthHandler) sessionUser(w http.ResponseWriter, r *http.Request) (model.User, bool) {
+	user, ok := auth.UserFromContext(r.Context())
+	if !ok {
+		response.ErrorResponse(w, http.StatusUnauthorized, "unauthorized", h.logger)
+		return model.User{}, false
+	}
+
+	if credential, _ := auth.CredentialFromContext(r.Context()); credential.Kind != auth.CredentialSession {
+		response.ErrorResponse(w, http.StatusForbidden, response.ForbiddenUser, h.logger)
+		return model.User{}, false
+	}
+	return user, true
+}
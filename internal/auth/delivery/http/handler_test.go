@@ -0,0 +1,172 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"films_library/internal/auth"
+	mock_auth "films_library/internal/auth/mocks"
+	"films_library/internal/model"
+	"films_library/pkg/logger"
+
+	"github.com/golang/mock/gomock"
+	"gopkg.in/go-playground/assert.v1"
+)
+
+func TestAuthHandler_Login(t *testing.T) {
+	user := model.User{ID: 7, Username: "neo", Role: model.RoleViewer}
+	session := model.Session{Token: "token", UserID: 7, ExpiresAt: time.Now().Add(time.Hour)}
+
+	tests := []struct {
+		name          string
+		body          string
+		expectedCode  int
+		expectedBody  string
+		expectCookie  bool
+		mockUsecaseFn func(*mock_auth.MockUsecase)
+		mockLoggerFn  func(*logger.MockInterface)
+	}{
+		{
+			name:         "Successful login",
+			body:         `{"username":"neo","password":"secret-password"}`,
+			expectedCode: http.StatusOK,
+			expectedBody: `{"status":200,"body":{"user_id":7,"username":"neo","role":"viewer"}}`,
+			expectCookie: true,
+			mockUsecaseFn: func(mockUsecase *mock_auth.MockUsecase) {
+				mockUsecase.EXPECT().Login(gomock.Any(), model.LoginRequest{Username: "neo", Password: "secret-password"}).Return(user, session, nil)
+			},
+		},
+		{
+			name:         "Wrong password",
+			body:         `{"username":"neo","password":"guess"}`,
+			expectedCode: http.StatusUnauthorized,
+			expectedBody: `{"status":401,"message":"invalid username or password"}`,
+			mockUsecaseFn: func(mockUsecase *mock_auth.MockUsecase) {
+				mockUsecase.EXPECT().Login(gomock.Any(), gomock.Any()).Return(model.User{}, model.Session{}, &model.ErrUnauthorized{Message: "invalid username or password"})
+			},
+		},
+		{
+			name:         "Missing password",
+			body:         `{"username":"neo"}`,
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"status":400,"message":"Invalid request"}`,
+			mockLoggerFn: func(mockLogger *logger.MockInterface) {
+				mockLogger.EXPECT().Error(gomock.Any())
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUsecase := mock_auth.NewMockUsecase(ctrl)
+			mockLogger := logger.NewMockInterface(ctrl)
+			if test.mockUsecaseFn != nil {
+				test.mockUsecaseFn(mockUsecase)
+			}
+			if test.mockLoggerFn != nil {
+				test.mockLoggerFn(mockLogger)
+			}
+
+			handler := &AuthHandler{mockUsecase, false, mockLogger}
+
+			req := httptest.NewRequest(http.MethodPost, "/auth/login", strings.NewReader(test.body))
+			recorder := httptest.NewRecorder()
+
+			handler.Login(recorder, req)
+
+			assert.Equal(t, test.expectedCode, recorder.Code)
+			assert.Equal(t, test.expectedBody, strings.TrimSpace(recorder.Body.String()))
+
+			var cookie *http.Cookie
+			for _, c := range recorder.Result().Cookies() {
+				if c.Name == auth.SessionCookie {
+					cookie = c
+				}
+			}
+			assert.Equal(t, test.expectCookie, cookie != nil && cookie.Value == session.Token && cookie.HttpOnly)
+		})
+	}
+}
+
+func TestAuthHandler_SetRole(t *testing.T) {
+	tests := []struct {
+		name          string
+		id            string
+		body          string
+		expectedCode  int
+		expectedBody  string
+		mockUsecaseFn func(*mock_auth.MockUsecase)
+		mockLoggerFn  func(*logger.MockInterface)
+	}{
+		{
+			name:         "Successful assignment",
+			id:           "7",
+			body:         `{"role":"editor"}`,
+			expectedCode: http.StatusOK,
+			expectedBody: `{"status":200,"body":{"user_id":7,"username":"neo","role":"editor"}}`,
+			mockUsecaseFn: func(mockUsecase *mock_auth.MockUsecase) {
+				mockUsecase.EXPECT().SetRole(gomock.Any(), uint64(7), model.RoleEditor).Return(model.User{ID: 7, Username: "neo", Role: model.RoleEditor}, nil)
+			},
+		},
+		{
+			name:         "Unknown user",
+			id:           "8",
+			body:         `{"role":"editor"}`,
+			expectedCode: http.StatusNotFound,
+			expectedBody: `{"status":404,"message":"user not found"}`,
+			mockUsecaseFn: func(mockUsecase *mock_auth.MockUsecase) {
+				mockUsecase.EXPECT().SetRole(gomock.Any(), uint64(8), model.RoleEditor).Return(model.User{}, &model.ErrNotFound{Message: "user not found"})
+			},
+		},
+		{
+			name:         "Unknown role",
+			id:           "7",
+			body:         `{"role":"owner"}`,
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"status":400,"message":"Invalid request"}`,
+			mockLoggerFn: func(mockLogger *logger.MockInterface) {
+				mockLogger.EXPECT().Error(gomock.Any())
+			},
+		},
+		{
+			name:         "Bad id",
+			id:           "abc",
+			body:         `{"role":"editor"}`,
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"status":400,"message":"invalid url parameter"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUsecase := mock_auth.NewMockUsecase(ctrl)
+			mockLogger := logger.NewMockInterface(ctrl)
+			if test.mockUsecaseFn != nil {
+				test.mockUsecaseFn(mockUsecase)
+			}
+			if test.mockLoggerFn != nil {
+				test.mockLoggerFn(mockLogger)
+			}
+
+			handler := &AuthHandler{mockUsecase, false, mockLogger}
+
+			req := httptest.NewRequest(http.MethodPut, "/admin/users/"+test.id+"/role", strings.NewReader(test.body))
+			req.SetPathValue("id", test.id)
+			recorder := httptest.NewRecorder()
+
+			handler.SetRole(recorder, req)
+
+			assert.Equal(t, test.expectedCode, recorder.Code)
+			assert.Equal(t, test.expectedBody, strings.TrimSpace(recorder.Body.String()))
+		})
+	}
+}
@@ -0,0 +1,463 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/auth/auth.go
+
+// Package mock_auth is a generated GoMock package.
+package mock_auth
+
+import (
+	context "context"
+	auth "films_library/internal/auth"
+	model "films_library/internal/model"
+	pagination "films_library/pkg/pagination"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUsecase is a mock of Usecase interface.
+type MockUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseMockRecorder
+}
+
+// MockUsecaseMockRecorder is the mock recorder for MockUsecase.
+type MockUsecaseMockRecorder struct {
+	mock *MockUsecase
+}
+
+// NewMockUsecase creates a new mock instance.
+func NewMockUsecase(ctrl *gomock.Controller) *MockUsecase {
+	mock := &MockUsecase{ctrl: ctrl}
+	mock.recorder = &MockUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
+	return m.recorder
+}
+
+// AddAPIKey mocks base method.
+func (m *MockUsecase) AddAPIKey(ctx context.Context, user model.User, request model.AddAPIKeyRequest) (model.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAPIKey", ctx, user, request)
+	ret0, _ := ret[0].(model.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddAPIKey indicates an expected call of AddAPIKey.
+func (mr *MockUsecaseMockRecorder) AddAPIKey(ctx, user, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAPIKey", reflect.TypeOf((*MockUsecase)(nil).AddAPIKey), ctx, user, request)
+}
+
+// AddUser mocks base method.
+func (m *MockUsecase) AddUser(ctx context.Context, request model.AddUserRequest) (model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddUser", ctx, request)
+	ret0, _ := ret[0].(model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddUser indicates an expected call of AddUser.
+func (mr *MockUsecaseMockRecorder) AddUser(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUser", reflect.TypeOf((*MockUsecase)(nil).AddUser), ctx, request)
+}
+
+// Authenticate mocks base method.
+func (m *MockUsecase) Authenticate(ctx context.Context, token string) (model.User, model.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", ctx, token)
+	ret0, _ := ret[0].(model.User)
+	ret1, _ := ret[1].(model.Session)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockUsecaseMockRecorder) Authenticate(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockUsecase)(nil).Authenticate), ctx, token)
+}
+
+// AuthenticateBearer mocks base method.
+func (m *MockUsecase) AuthenticateBearer(ctx context.Context, token string) (model.User, auth.Credential, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthenticateBearer", ctx, token)
+	ret0, _ := ret[0].(model.User)
+	ret1, _ := ret[1].(auth.Credential)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AuthenticateBearer indicates an expected call of AuthenticateBearer.
+func (mr *MockUsecaseMockRecorder) AuthenticateBearer(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticateBearer", reflect.TypeOf((*MockUsecase)(nil).AuthenticateBearer), ctx, token)
+}
+
+// GetAPIKeys mocks base method.
+func (m *MockUsecase) GetAPIKeys(ctx context.Context, userID uint64) ([]model.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAPIKeys", ctx, userID)
+	ret0, _ := ret[0].([]model.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAPIKeys indicates an expected call of GetAPIKeys.
+func (mr *MockUsecaseMockRecorder) GetAPIKeys(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAPIKeys", reflect.TypeOf((*MockUsecase)(nil).GetAPIKeys), ctx, userID)
+}
+
+// GetUsers mocks base method.
+func (m *MockUsecase) GetUsers(ctx context.Context, filter model.UserFilter) ([]model.User, pagination.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsers", ctx, filter)
+	ret0, _ := ret[0].([]model.User)
+	ret1, _ := ret[1].(pagination.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUsers indicates an expected call of GetUsers.
+func (mr *MockUsecaseMockRecorder) GetUsers(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsers", reflect.TypeOf((*MockUsecase)(nil).GetUsers), ctx, filter)
+}
+
+// IssueToken mocks base method.
+func (m *MockUsecase) IssueToken(ctx context.Context, user model.User) (model.Token, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueToken", ctx, user)
+	ret0, _ := ret[0].(model.Token)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IssueToken indicates an expected call of IssueToken.
+func (mr *MockUsecaseMockRecorder) IssueToken(ctx, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueToken", reflect.TypeOf((*MockUsecase)(nil).IssueToken), ctx, user)
+}
+
+// Login mocks base method.
+func (m *MockUsecase) Login(ctx context.Context, request model.LoginRequest) (model.User, model.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Login", ctx, request)
+	ret0, _ := ret[0].(model.User)
+	ret1, _ := ret[1].(model.Session)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Login indicates an expected call of Login.
+func (mr *MockUsecaseMockRecorder) Login(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockUsecase)(nil).Login), ctx, request)
+}
+
+// Logout mocks base method.
+func (m *MockUsecase) Logout(ctx context.Context, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logout", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Logout indicates an expected call of Logout.
+func (mr *MockUsecaseMockRecorder) Logout(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockUsecase)(nil).Logout), ctx, token)
+}
+
+// LogoutAll mocks base method.
+func (m *MockUsecase) LogoutAll(ctx context.Context, userID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogoutAll", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LogoutAll indicates an expected call of LogoutAll.
+func (mr *MockUsecaseMockRecorder) LogoutAll(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogoutAll", reflect.TypeOf((*MockUsecase)(nil).LogoutAll), ctx, userID)
+}
+
+// RevokeAPIKey mocks base method.
+func (m *MockUsecase) RevokeAPIKey(ctx context.Context, userID, keyID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAPIKey", ctx, userID, keyID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAPIKey indicates an expected call of RevokeAPIKey.
+func (mr *MockUsecaseMockRecorder) RevokeAPIKey(ctx, userID, keyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAPIKey", reflect.TypeOf((*MockUsecase)(nil).RevokeAPIKey), ctx, userID, keyID)
+}
+
+// SetRole mocks base method.
+func (m *MockUsecase) SetRole(ctx context.Context, id uint64, role string) (model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRole", ctx, id, role)
+	ret0, _ := ret[0].(model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetRole indicates an expected call of SetRole.
+func (mr *MockUsecaseMockRecorder) SetRole(ctx, id, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRole", reflect.TypeOf((*MockUsecase)(nil).SetRole), ctx, id, role)
+}
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddAPIKey mocks base method.
+func (m *MockRepository) AddAPIKey(ctx context.Context, key model.APIKey) (model.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAPIKey", ctx, key)
+	ret0, _ := ret[0].(model.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddAPIKey indicates an expected call of AddAPIKey.
+func (mr *MockRepositoryMockRecorder) AddAPIKey(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAPIKey", reflect.TypeOf((*MockRepository)(nil).AddAPIKey), ctx, key)
+}
+
+// AddSession mocks base method.
+func (m *MockRepository) AddSession(ctx context.Context, session model.Session) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSession", ctx, session)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddSession indicates an expected call of AddSession.
+func (mr *MockRepositoryMockRecorder) AddSession(ctx, session interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSession", reflect.TypeOf((*MockRepository)(nil).AddSession), ctx, session)
+}
+
+// AddUser mocks base method.
+func (m *MockRepository) AddUser(ctx context.Context, user model.User) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddUser", ctx, user)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddUser indicates an expected call of AddUser.
+func (mr *MockRepositoryMockRecorder) AddUser(ctx, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUser", reflect.TypeOf((*MockRepository)(nil).AddUser), ctx, user)
+}
+
+// GetAPIKeyByHash mocks base method.
+func (m *MockRepository) GetAPIKeyByHash(ctx context.Context, hash string) (model.APIKey, model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAPIKeyByHash", ctx, hash)
+	ret0, _ := ret[0].(model.APIKey)
+	ret1, _ := ret[1].(model.User)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAPIKeyByHash indicates an expected call of GetAPIKeyByHash.
+func (mr *MockRepositoryMockRecorder) GetAPIKeyByHash(ctx, hash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAPIKeyByHash", reflect.TypeOf((*MockRepository)(nil).GetAPIKeyByHash), ctx, hash)
+}
+
+// GetAPIKeys mocks base method.
+func (m *MockRepository) GetAPIKeys(ctx context.Context, userID uint64) ([]model.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAPIKeys", ctx, userID)
+	ret0, _ := ret[0].([]model.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAPIKeys indicates an expected call of GetAPIKeys.
+func (mr *MockRepositoryMockRecorder) GetAPIKeys(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAPIKeys", reflect.TypeOf((*MockRepository)(nil).GetAPIKeys), ctx, userID)
+}
+
+// GetSession mocks base method.
+func (m *MockRepository) GetSession(ctx context.Context, id string) (model.Session, model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSession", ctx, id)
+	ret0, _ := ret[0].(model.Session)
+	ret1, _ := ret[1].(model.User)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSession indicates an expected call of GetSession.
+func (mr *MockRepositoryMockRecorder) GetSession(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockRepository)(nil).GetSession), ctx, id)
+}
+
+// GetUser mocks base method.
+func (m *MockRepository) GetUser(ctx context.Context, id uint64) (model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", ctx, id)
+	ret0, _ := ret[0].(model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockRepositoryMockRecorder) GetUser(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockRepository)(nil).GetUser), ctx, id)
+}
+
+// GetUserByName mocks base method.
+func (m *MockRepository) GetUserByName(ctx context.Context, username string) (model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByName", ctx, username)
+	ret0, _ := ret[0].(model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByName indicates an expected call of GetUserByName.
+func (mr *MockRepositoryMockRecorder) GetUserByName(ctx, username interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByName", reflect.TypeOf((*MockRepository)(nil).GetUserByName), ctx, username)
+}
+
+// GetUsers mocks base method.
+func (m *MockRepository) GetUsers(ctx context.Context, filter model.UserFilter) ([]model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsers", ctx, filter)
+	ret0, _ := ret[0].([]model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsers indicates an expected call of GetUsers.
+func (mr *MockRepositoryMockRecorder) GetUsers(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsers", reflect.TypeOf((*MockRepository)(nil).GetUsers), ctx, filter)
+}
+
+// RevokeAPIKey mocks base method.
+func (m *MockRepository) RevokeAPIKey(ctx context.Context, userID, keyID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAPIKey", ctx, userID, keyID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAPIKey indicates an expected call of RevokeAPIKey.
+func (mr *MockRepositoryMockRecorder) RevokeAPIKey(ctx, userID, keyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAPIKey", reflect.TypeOf((*MockRepository)(nil).RevokeAPIKey), ctx, userID, keyID)
+}
+
+// RevokeSession mocks base method.
+func (m *MockRepository) RevokeSession(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSession", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockRepositoryMockRecorder) RevokeSession(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockRepository)(nil).RevokeSession), ctx, id)
+}
+
+// RevokeUserSessions mocks base method.
+func (m *MockRepository) RevokeUserSessions(ctx context.Context, userID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeUserSessions", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeUserSessions indicates an expected call of RevokeUserSessions.
+func (mr *MockRepositoryMockRecorder) RevokeUserSessions(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeUserSessions", reflect.TypeOf((*MockRepository)(nil).RevokeUserSessions), ctx, userID)
+}
+
+// RotateSession mocks base method.
+func (m *MockRepository) RotateSession(ctx context.Context, oldID string, session model.Session) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateSession", ctx, oldID, session)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RotateSession indicates an expected call of RotateSession.
+func (mr *MockRepositoryMockRecorder) RotateSession(ctx, oldID, session interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateSession", reflect.TypeOf((*MockRepository)(nil).RotateSession), ctx, oldID, session)
+}
+
+// SetUserRole mocks base method.
+func (m *MockRepository) SetUserRole(ctx context.Context, id uint64, role string) (model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserRole", ctx, id, role)
+	ret0, _ := ret[0].(model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetUserRole indicates an expected call of SetUserRole.
+func (mr *MockRepositoryMockRecorder) SetUserRole(ctx, id, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserRole", reflect.TypeOf((*MockRepository)(nil).SetUserRole), ctx, id, role)
+}
+
+// TouchAPIKey mocks base method.
+func (m *MockRepository) TouchAPIKey(ctx context.Context, keyID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchAPIKey", ctx, keyID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchAPIKey indicates an expected call of TouchAPIKey.
+func (mr *MockRepositoryMockRecorder) TouchAPIKey(ctx, keyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchAPIKey", reflect.TypeOf((*MockRepository)(nil).TouchAPIKey), ctx, keyID)
+}
@@ -0,0 +1,144 @@
+package auth
+
+import (
+	"net/http"
+
+	"films_library/internal/model"
+)
+
+// Permission is an action a role may be granted, written as object:verb.
+type Permission string
+
+const (
+	// Public routes are served without a session.
+	Public Permission = "public"
+	// Authenticated routes are open to any logged in user.
+	Authenticated Permission = "authenticated"
+
+	FilmRead    Permission = "film:read"
+	FilmWrite   Permission = "film:write"
+	FilmDelete  Permission = "film:delete"
+	ActorRead   Permission = "actor:read"
+	ActorWrite  Permission = "actor:write"
+	ActorDelete Permission = "actor:delete"
+	CrewRead    Permission = "crew:read"
+	CrewWrite   Permission = "crew:write"
+	CrewDelete  Permission = "crew:delete"
+	GenreRead   Permission = "genre:read"
+	GenreWrite  Permission = "genre:write"
+	GenreDelete Permission = "genre:delete"
+	TagRead     Permission = "tag:read"
+	TagWrite    Permission = "tag:write"
+	TagDelete   Permission = "tag:delete"
+	CopyRead    Permission = "copy:read"
+	CopyWrite   Permission = "copy:write"
+	CopyDelete  Permission = "copy:delete"
+	UserManage  Permission = "user:manage"
+
+	ReviewRead     Permission = "review:read"
+	ReviewWrite    Permission = "review:write"
+	ReviewModerate Permission = "review:moderate"
+	WatchlistRead  Permission = "watchlist:read"
+	WatchlistWrite Permission = "watchlist:write"
+
+	CollectionRead   Permission = "collection:read"
+	CollectionWrite  Permission = "collection:write"
+	CollectionManage Permission = "collection:manage"
+
+	LoanRead  Permission = "loan:read"
+	LoanWrite Permission = "loan:write"
+
+	HoldRead   Permission = "hold:read"
+	HoldWrite  Permission = "hold:write"
+	HoldManage Permission = "hold:manage"
+
+	MemberRead   Permission = "member:read"
+	MemberManage Permission = "member:manage"
+	LedgerWrite  Permission = "ledger:write"
+	LedgerManage Permission = "ledger:manage"
+)
+
+var rolePermissions = map[string][]Permission{
+	model.RoleViewer: {
+		FilmRead, ActorRead, CrewRead, GenreRead, TagRead, CopyRead,
+		ReviewRead, ReviewWrite, WatchlistRead, WatchlistWrite,
+		CollectionRead, CollectionWrite,
+		HoldRead, HoldWrite,
+		MemberRead,
+	},
+	model.RoleEditor: {
+		FilmRead, ActorRead, CrewRead, GenreRead, TagRead, CopyRead,
+		FilmWrite, ActorWrite, CrewWrite, GenreWrite, TagWrite, CopyWrite,
+		ReviewRead, ReviewWrite, ReviewModerate, WatchlistRead, WatchlistWrite,
+		CollectionRead, CollectionWrite,
+		LoanRead, LoanWrite,
+		HoldRead, HoldWrite, HoldManage,
+		MemberRead, MemberManage, LedgerWrite,
+	},
+	model.RoleAdmin: {
+		FilmRead, ActorRead, CrewRead, GenreRead, TagRead, CopyRead,
+		FilmWrite, ActorWrite, CrewWrite, GenreWrite, TagWrite, CopyWrite,
+		FilmDelete, ActorDelete, CrewDelete, GenreDelete, TagDelete, CopyDelete, UserManage,
+		ReviewRead, ReviewWrite, ReviewModerate, WatchlistRead, WatchlistWrite,
+		CollectionRead, CollectionWrite, CollectionManage,
+		LoanRead, LoanWrite,
+		HoldRead, HoldWrite, HoldManage,
+		MemberRead, MemberManage, LedgerWrite, LedgerManage,
+	},
+}
+
+// Allowed reports whether role grants perm.
+func Allowed(role string, perm Permission) bool {
+	granted, ok := rolePermissions[role]
+	if !ok {
+		return false
+	}
+	if perm == Public || perm == Authenticated {
+		return true
+	}
+	for _, p := range granted {
+		if p == perm {
+			return true
+		}
+	}
+	return false
+}
+
+// IsPermission reports whether perm is one that roles can be granted.
+func IsPermission(perm Permission) bool {
+	for _, p := range rolePermissions[model.RoleAdmin] {
+		if p == perm {
+			return true
+		}
+	}
+	return false
+}
+
+// Permissions maps mux patterns to the permission they require. Each
+// delivery package declares one next to the constructor registering its routes.
+type Permissions map[string]Permission
+
+// Policy resolves requests to the permission of the route serving them.
+type Policy struct {
+	mux    *http.ServeMux
+	routes Permissions
+}
+
+func NewPolicy(mux *http.ServeMux, registries ...Permissions) *Policy {
+	routes := make(Permissions)
+	for _, registry := range registries {
+		for pattern, perm := range registry {
+			routes[pattern] = perm
+		}
+	}
+
+	return &Policy{mux, routes}
+}
+
+// Lookup returns the pattern serving r and the permission it requires.
+// Routes missing from every registry report ok=false and must be denied.
+func (p *Policy) Lookup(r *http.Request) (pattern string, perm Permission, ok bool) {
+	_, pattern = p.mux.Handler(r)
+	perm, ok = p.routes[pattern]
+	return pattern, perm, ok
+}
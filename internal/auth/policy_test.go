@@ -0,0 +1,67 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"films_library/internal/model"
+)
+
+func TestAllowed(t *testing.T) {
+	testCases := []struct {
+		role     string
+		perm     Permission
+		expected bool
+	}{
+		{model.RoleViewer, FilmRead, true},
+		{model.RoleViewer, FilmWrite, false},
+		{model.RoleViewer, Authenticated, true},
+		{model.RoleEditor, ActorWrite, true},
+		{model.RoleEditor, ActorDelete, false},
+		{model.RoleEditor, UserManage, false},
+		{model.RoleAdmin, FilmDelete, true},
+		{model.RoleAdmin, UserManage, true},
+		{"user", FilmRead, false},
+		{"", Authenticated, false},
+	}
+
+	for _, tc := range testCases {
+		if got := Allowed(tc.role, tc.perm); got != tc.expected {
+			t.Errorf("Allowed(%q, %q) = %v, expected %v", tc.role, tc.perm, got, tc.expected)
+		}
+	}
+}
+
+func TestPolicy_Lookup(t *testing.T) {
+	mux := http.NewServeMux()
+	handler := func(http.ResponseWriter, *http.Request) {}
+	mux.HandleFunc("GET /films/{id}", handler)
+	mux.HandleFunc("DELETE /films/{id}", handler)
+	mux.HandleFunc("GET /unlisted", handler)
+
+	policy := NewPolicy(mux,
+		Permissions{"GET /films/{id}": FilmRead},
+		Permissions{"DELETE /films/{id}": FilmDelete},
+	)
+
+	testCases := []struct {
+		method, path string
+		pattern      string
+		perm         Permission
+		ok           bool
+	}{
+		{http.MethodGet, "/films/1", "GET /films/{id}", FilmRead, true},
+		{http.MethodDelete, "/films/1", "DELETE /films/{id}", FilmDelete, true},
+		{http.MethodGet, "/unlisted", "GET /unlisted", "", false},
+		{http.MethodGet, "/missing", "", "", false},
+	}
+
+	for _, tc := range testCases {
+		pattern, perm, ok := policy.Lookup(httptest.NewRequest(tc.method, tc.path, nil))
+		if pattern != tc.pattern || perm != tc.perm || ok != tc.ok {
+			t.Errorf("%s %s: got (%q, %q, %v), expected (%q, %q, %v)",
+				tc.method, tc.path, pattern, perm, ok, tc.pattern, tc.perm, tc.ok)
+		}
+	}
+}
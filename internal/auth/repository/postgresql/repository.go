@@ -0,0 +1,270 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"films_library/internal/model"
+	"films_library/pkg/pagination"
+	"films_library/pkg/postgres"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+)
+
+const uniqueViolation = "23505"
+
+type Repository struct {
+	db postgres.DBConn
+}
+
+func NewRepository(db postgres.DBConn) *Repository {
+	return &Repository{db}
+}
+
+func (r *Repository) GetUserByName(ctx context.Context, username string) (model.User, error) {
+	sqlQuery := `SELECT user_id, username, "role", password_hash FROM users WHERE username=$1`
+
+	var user model.User
+	err := r.db.QueryRow(ctx, sqlQuery, username).Scan(&user.ID, &user.Username, &user.Role, &user.PasswordHash)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.User{}, &model.ErrNotFound{Message: "user not found"}
+		}
+		return model.User{}, err
+	}
+	return user, nil
+}
+
+func (r *Repository) AddUser(ctx context.Context, user model.User) (uint64, error) {
+	sqlQuery := `INSERT INTO users (username, password_hash, "role") VALUES ($1, $2, $3) RETURNING user_id`
+
+	var id uint64
+	err := r.db.QueryRow(ctx, sqlQuery, user.Username, user.PasswordHash, user.Role).Scan(&id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return 0, &model.ErrConflict{Message: "username is taken"}
+		}
+		return 0, err
+	}
+	return id, nil
+}
+
+func (r *Repository) AddSession(ctx context.Context, session model.Session) error {
+	sqlQuery := `INSERT INTO session (session_id, user_id, created_at, expires_at) VALUES ($1, $2, $3, $4)`
+
+	_, err := r.db.Exec(ctx, sqlQuery, session.ID, session.UserID, session.CreatedAt, session.ExpiresAt)
+	return err
+}
+
+// GetSession returns a live session and its user. Expired and revoked
+// sessions are reported as not found.
+func (r *Repository) GetSession(ctx context.Context, id string) (model.Session, model.User, error) {
+	sqlQuery := `SELECT s.session_id, s.created_at, s.expires_at, u.user_id, u.username, u."role"
+	FROM session s JOIN users u ON u.user_id = s.user_id
+	WHERE s.session_id=$1 AND s.revoked_at IS NULL AND s.expires_at > now()`
+
+	var session model.Session
+	var user model.User
+	err := r.db.QueryRow(ctx, sqlQuery, id).Scan(
+		&session.ID, &session.CreatedAt, &session.ExpiresAt,
+		&user.ID, &user.Username, &user.Role,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Session{}, model.User{}, &model.ErrNotFound{Message: "session not found"}
+		}
+		return model.Session{}, model.User{}, err
+	}
+	session.UserID = user.ID
+	return session, user, nil
+}
+
+// RotateSession revokes oldID and stores session in its place. It reports
+// not found when oldID was already revoked, e.g. by a concurrent rotation.
+func (r *Repository) RotateSession(ctx context.Context, oldID string, session model.Session) error {
+	revokeQuery := `UPDATE session SET revoked_at=now() WHERE session_id=$1 AND revoked_at IS NULL`
+	insertQuery := `INSERT INTO session (session_id, user_id, created_at, expires_at) VALUES ($1, $2, $3, $4)`
+
+	return r.db.BeginTxFunc(ctx, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		res, err := tx.Exec(ctx, revokeQuery, oldID)
+		if err != nil {
+			return err
+		}
+		if res.RowsAffected() == 0 {
+			return &model.ErrNotFound{Message: "session not found"}
+		}
+
+		_, err = tx.Exec(ctx, insertQuery, session.ID, session.UserID, session.CreatedAt, session.ExpiresAt)
+		return err
+	})
+}
+
+func (r *Repository) RevokeSession(ctx context.Context, id string) error {
+	sqlQuery := `UPDATE session SET revoked_at=now() WHERE session_id=$1 AND revoked_at IS NULL`
+
+	_, err := r.db.Exec(ctx, sqlQuery, id)
+	return err
+}
+
+func (r *Repository) RevokeUserSessions(ctx context.Context, userID uint64) error {
+	sqlQuery := `UPDATE session SET revoked_at=now() WHERE user_id=$1 AND revoked_at IS NULL`
+
+	_, err := r.db.Exec(ctx, sqlQuery, userID)
+	return err
+}
+
+func (r *Repository) GetUsers(ctx context.Context, filter model.UserFilter) ([]model.User, error) {
+	sqlQuery := `SELECT user_id, username, "role" FROM users`
+
+	backward := filter.Backward()
+
+	var args []interface{}
+	condition, clauses := filter.Keyset(pagination.Order{Key: "user_id"}, &args)
+	if condition != "" {
+		sqlQuery += " WHERE " + condition
+	}
+	sqlQuery += clauses
+
+	rows, err := r.db.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var users []model.User
+	for rows.Next() {
+		var user model.User
+		if err := rows.Scan(&user.ID, &user.Username, &user.Role); err != nil {
+			return nil, err
+		}
+		users = append(users, user)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if backward {
+		slices.Reverse(users)
+	}
+	return users, nil
+}
+
+func (r *Repository) SetUserRole(ctx context.Context, id uint64, role string) (model.User, error) {
+	sqlQuery := `UPDATE users SET "role"=$2 WHERE user_id=$1 RETURNING user_id, username, "role"`
+
+	var user model.User
+	err := r.db.QueryRow(ctx, sqlQuery, id, role).Scan(&user.ID, &user.Username, &user.Role)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.User{}, &model.ErrNotFound{Message: "user not found"}
+		}
+		return model.User{}, err
+	}
+	return user, nil
+}
+
+func (r *Repository) GetUser(ctx context.Context, id uint64) (model.User, error) {
+	sqlQuery := `SELECT user_id, username, "role" FROM users WHERE user_id=$1`
+
+	var user model.User
+	err := r.db.QueryRow(ctx, sqlQuery, id).Scan(&user.ID, &user.Username, &user.Role)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.User{}, &model.ErrNotFound{Message: "user not found"}
+		}
+		return model.User{}, err
+	}
+	return user, nil
+}
+
+func (r *Repository) AddAPIKey(ctx context.Context, key model.APIKey) (model.APIKey, error) {
+	sqlQuery := `INSERT INTO api_key (user_id, "name", prefix, key_hash, scopes, expires_at)
+	VALUES ($1, $2, $3, $4, $5, $6) RETURNING key_id, created_at`
+
+	err := r.db.QueryRow(ctx, sqlQuery, key.UserID, key.Name, key.Prefix, key.Hash, key.Scopes, key.ExpiresAt).
+		Scan(&key.ID, &key.CreatedAt)
+	if err != nil {
+		return model.APIKey{}, err
+	}
+	return key, nil
+}
+
+// GetAPIKeyByHash returns a live key and its owner. Expired and revoked keys
+// are reported as not found.
+func (r *Repository) GetAPIKeyByHash(ctx context.Context, hash string) (model.APIKey, model.User, error) {
+	sqlQuery := `SELECT k.key_id, k.scopes, k.last_used_at, u.user_id, u.username, u."role"
+	FROM api_key k JOIN users u ON u.user_id = k.user_id
+	WHERE k.key_hash=$1 AND k.revoked_at IS NULL AND (k.expires_at IS NULL OR k.expires_at > now())`
+
+	var key model.APIKey
+	var user model.User
+	err := r.db.QueryRow(ctx, sqlQuery, hash).Scan(
+		&key.ID, &key.Scopes, &key.LastUsedAt,
+		&user.ID, &user.Username, &user.Role,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.APIKey{}, model.User{}, &model.ErrNotFound{Message: "api key not found"}
+		}
+		return model.APIKey{}, model.User{}, err
+	}
+	key.UserID = user.ID
+	return key, user, nil
+}
+
+func (r *Repository) GetAPIKeys(ctx context.Context, userID uint64) ([]model.APIKey, error) {
+	sqlQuery := `SELECT key_id, user_id, "name", prefix, scopes, created_at, expires_at, last_used_at, revoked_at
+	FROM api_key WHERE user_id=$1 ORDER BY key_id`
+
+	rows, err := r.db.Query(ctx, sqlQuery, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	keys := []model.APIKey{}
+	for rows.Next() {
+		var key model.APIKey
+		if err := rows.Scan(
+			&key.ID,
+			&key.UserID,
+			&key.Name,
+			&key.Prefix,
+			&key.Scopes,
+			&key.CreatedAt,
+			&key.ExpiresAt,
+			&key.LastUsedAt,
+			&key.RevokedAt,
+		); err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, rows.Err()
+}
+
+func (r *Repository) RevokeAPIKey(ctx context.Context, userID, keyID uint64) error {
+	sqlQuery := `UPDATE api_key SET revoked_at=now() WHERE key_id=$1 AND user_id=$2 AND revoked_at IS NULL`
+
+	res, err := r.db.Exec(ctx, sqlQuery, keyID, userID)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return &model.ErrNotFound{Message: "api key not found"}
+	}
+	return nil
+}
+
+// TouchAPIKey records that a key was used. Writes are coalesced to one a
+// minute so that busy clients do not update the row on every request.
+func (r *Repository) TouchAPIKey(ctx context.Context, keyID uint64) error {
+	sqlQuery := `UPDATE api_key SET last_used_at=now()
+	WHERE key_id=$1 AND (last_used_at IS NULL OR last_used_at < now() - interval '1 minute')`
+
+	_, err := r.db.Exec(ctx, sqlQuery, keyID)
+	return err
+}
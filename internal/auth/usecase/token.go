@@ -0,0 +1,171 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"films_library/internal/auth"
+	"films_library/internal/model"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// apiKeyPrefix marks API keys, so bearer values need not be tried as JWTs.
+const apiKeyPrefix = "fl_"
+
+// TokenKey is a key JWTs are signed or verified with. SignKey is nil for
+// retired keys, which still verify tokens issued before a rotation.
+type TokenKey struct {
+	Method    jwt.SigningMethod
+	SignKey   interface{}
+	VerifyKey interface{}
+}
+
+// TokenKeys holds the JWT keys by key ID. New tokens are signed with Active
+// and carry its ID in the kid header; an empty Active disables issuing.
+type TokenKeys struct {
+	Issuer string
+	TTL    time.Duration
+	Active string
+	Keys   map[string]TokenKey
+}
+
+// AuthenticateBearer resolves an Authorization: Bearer value, which is either
+// an API key or a JWT, to its user.
+func (au *AuthUsecase) AuthenticateBearer(ctx context.Context, token string) (model.User, auth.Credential, error) {
+	if strings.HasPrefix(token, apiKeyPrefix) {
+		return au.authenticateAPIKey(ctx, token)
+	}
+
+	claims, err := au.parseToken(token)
+	if err != nil {
+		return model.User{}, auth.Credential{}, &model.ErrUnauthorized{Message: "invalid token"}
+	}
+
+	id, err := strconv.ParseUint(claims.Subject, 10, 64)
+	if err != nil {
+		return model.User{}, auth.Credential{}, &model.ErrUnauthorized{Message: "invalid token"}
+	}
+
+	// The role is read on every request, like for sessions, so role changes
+	// and deleted accounts apply before the token expires.
+	user, err := au.authRepo.GetUser(ctx, id)
+	if err != nil {
+		var notFound *model.ErrNotFound
+		if errors.As(err, &notFound) {
+			return model.User{}, auth.Credential{}, &model.ErrUnauthorized{Message: "invalid token"}
+		}
+		return model.User{}, auth.Credential{}, err
+	}
+	return user, auth.Credential{Kind: auth.CredentialJWT}, nil
+}
+
+// IssueToken signs a short-lived JWT for user with the active key.
+func (au *AuthUsecase) IssueToken(_ context.Context, user model.User) (model.Token, error) {
+	key, ok := au.tokens.Keys[au.tokens.Active]
+	if !ok || key.SignKey == nil {
+		return model.Token{}, errors.New("no active token signing key")
+	}
+
+	now := time.Now()
+	expires := now.Add(au.tokens.TTL)
+	token := jwt.NewWithClaims(key.Method, jwt.RegisteredClaims{
+		Issuer:    au.tokens.Issuer,
+		Subject:   strconv.FormatUint(user.ID, 10),
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(expires),
+	})
+	token.Header["kid"] = au.tokens.Active
+
+	signed, err := token.SignedString(key.SignKey)
+	if err != nil {
+		return model.Token{}, fmt.Errorf("sign token: %w", err)
+	}
+	return model.Token{Token: signed, ExpiresAt: expires}, nil
+}
+
+func (au *AuthUsecase) parseToken(token string) (*jwt.RegisteredClaims, error) {
+	claims := &jwt.RegisteredClaims{}
+	_, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		key, ok := au.tokens.Keys[kid]
+		if !ok {
+			return nil, fmt.Errorf("unknown key %q", kid)
+		}
+		if t.Method.Alg() != key.Method.Alg() {
+			return nil, fmt.Errorf("key %q does not sign %s", kid, t.Method.Alg())
+		}
+		return key.VerifyKey, nil
+	},
+		jwt.WithIssuer(au.tokens.Issuer),
+		jwt.WithExpirationRequired(),
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg(), jwt.SigningMethodEdDSA.Alg()}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// AddAPIKey mints a key for user. Every scope must be a permission the role
+// of the user grants, so a key can never do more than its owner.
+func (au *AuthUsecase) AddAPIKey(ctx context.Context, user model.User, request model.AddAPIKeyRequest) (model.APIKey, error) {
+	for _, scope := range request.Scopes {
+		perm := auth.Permission(scope)
+		if !auth.IsPermission(perm) || !auth.Allowed(user.Role, perm) {
+			return model.APIKey{}, &model.ErrForbidden{Message: "scope not granted: " + scope}
+		}
+	}
+	if request.ExpiresAt != nil && !request.ExpiresAt.After(time.Now()) {
+		return model.APIKey{}, &model.ErrForbidden{Message: "expiry is in the past"}
+	}
+
+	secret, err := randomToken()
+	if err != nil {
+		return model.APIKey{}, err
+	}
+	raw := apiKeyPrefix + secret
+
+	key, err := au.authRepo.AddAPIKey(ctx, model.APIKey{
+		UserID:    user.ID,
+		Name:      request.Name,
+		Prefix:    raw[:len(apiKeyPrefix)+6],
+		Hash:      hashToken(raw),
+		Scopes:    request.Scopes,
+		ExpiresAt: request.ExpiresAt,
+	})
+	if err != nil {
+		return model.APIKey{}, err
+	}
+
+	key.Key = raw
+	return key, nil
+}
+
+func (au *AuthUsecase) GetAPIKeys(ctx context.Context, userID uint64) ([]model.APIKey, error) {
+	return au.authRepo.GetAPIKeys(ctx, userID)
+}
+
+func (au *AuthUsecase) RevokeAPIKey(ctx context.Context, userID, keyID uint64) error {
+	return au.authRepo.RevokeAPIKey(ctx, userID, keyID)
+}
+
+func (au *AuthUsecase) authenticateAPIKey(ctx context.Context, raw string) (model.User, auth.Credential, error) {
+	key, user, err := au.authRepo.GetAPIKeyByHash(ctx, hashToken(raw))
+	if err != nil {
+		var notFound *model.ErrNotFound
+		if errors.As(err, &notFound) {
+			return model.User{}, auth.Credential{}, &model.ErrUnauthorized{Message: "invalid api key"}
+		}
+		return model.User{}, auth.Credential{}, err
+	}
+
+	if err := au.authRepo.TouchAPIKey(ctx, key.ID); err != nil {
+		au.logger.Error(fmt.Errorf("touch api key %d: %w", key.ID, err))
+	}
+	return user, auth.Credential{Kind: auth.CredentialAPIKey, Scopes: key.Scopes}, nil
+}
@@ -0,0 +1,170 @@
+package usecase
+
+import (
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"films_library/internal/auth"
+	mock_auth "films_library/internal/auth/mocks"
+	"films_library/internal/model"
+	"films_library/pkg/logger"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/golang/mock/gomock"
+)
+
+func TestAuthUsecase_Tokens(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	loggerMock := logger.NewMockInterface(ctrl)
+	authRepo := mock_auth.NewMockRepository(ctrl)
+
+	ctx := context.Background()
+	user := model.User{ID: 7, Username: "ci", Role: model.RoleEditor}
+
+	secret := []byte("0123456789abcdef0123456789abcdef")
+	public, private, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keys := map[string]TokenKey{
+		"hs-old": {Method: jwt.SigningMethodHS256, SignKey: secret, VerifyKey: secret},
+		"ed-new": {Method: jwt.SigningMethodEdDSA, SignKey: private, VerifyKey: public},
+	}
+	issue := func(active string, ttl time.Duration) string {
+		t.Helper()
+		uc := NewAuthUsecase(authRepo, time.Hour, time.Minute, TokenKeys{Issuer: "films_library", TTL: ttl, Active: active, Keys: keys}, loggerMock)
+		token, err := uc.IssueToken(ctx, user)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return token.Token
+	}
+
+	// Tokens signed before the rotation to ed-new still verify.
+	usecase := NewAuthUsecase(authRepo, time.Hour, time.Minute, TokenKeys{Issuer: "films_library", TTL: time.Minute, Active: "ed-new", Keys: keys}, loggerMock)
+	otherIssuer := NewAuthUsecase(authRepo, time.Hour, time.Minute, TokenKeys{Issuer: "other", TTL: time.Minute, Active: "ed-new", Keys: keys}, loggerMock)
+	foreign, err := otherIssuer.IssueToken(ctx, user)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name          string
+		token         string
+		expectLookup  bool
+		expectedError bool
+	}{
+		{name: "EdDSA token", token: issue("ed-new", time.Minute), expectLookup: true},
+		{name: "HS256 token from a retired key", token: issue("hs-old", time.Minute), expectLookup: true},
+		{name: "Expired token", token: issue("ed-new", -time.Minute), expectedError: true},
+		{name: "Other issuer", token: foreign.Token, expectedError: true},
+		{name: "Garbage", token: "not.a.token", expectedError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.expectLookup {
+				authRepo.EXPECT().GetUser(ctx, user.ID).Return(user, nil)
+			}
+
+			got, credential, err := usecase.AuthenticateBearer(ctx, tc.token)
+			if (err != nil) != tc.expectedError {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if err != nil {
+				var unauthorized *model.ErrUnauthorized
+				if !errors.As(err, &unauthorized) {
+					t.Errorf("Expected unauthorized, got %v", err)
+				}
+				return
+			}
+
+			if got != user || credential.Kind != auth.CredentialJWT || credential.Scopes != nil {
+				t.Errorf("Unexpected result %v %+v", got, credential)
+			}
+		})
+	}
+
+	t.Run("No active key", func(t *testing.T) {
+		uc := NewAuthUsecase(authRepo, time.Hour, time.Minute, TokenKeys{Keys: keys}, loggerMock)
+		if _, err := uc.IssueToken(ctx, user); err == nil {
+			t.Error("Expected an error without an active key")
+		}
+	})
+}
+
+func TestAuthUsecase_APIKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	loggerMock := logger.NewMockInterface(ctrl)
+	authRepo := mock_auth.NewMockRepository(ctrl)
+	usecase := NewAuthUsecase(authRepo, time.Hour, time.Minute, TokenKeys{}, loggerMock)
+
+	ctx := context.Background()
+	user := model.User{ID: 7, Username: "ci", Role: model.RoleEditor}
+
+	t.Run("Scope beyond the role", func(t *testing.T) {
+		_, err := usecase.AddAPIKey(ctx, user, model.AddAPIKeyRequest{Name: "ci", Scopes: []string{"film:write", "film:delete"}})
+		var forbidden *model.ErrForbidden
+		if !errors.As(err, &forbidden) {
+			t.Errorf("Expected forbidden, got %v", err)
+		}
+	})
+
+	t.Run("Unknown scope", func(t *testing.T) {
+		_, err := usecase.AddAPIKey(ctx, user, model.AddAPIKeyRequest{Name: "ci", Scopes: []string{"authenticated"}})
+		var forbidden *model.ErrForbidden
+		if !errors.As(err, &forbidden) {
+			t.Errorf("Expected forbidden, got %v", err)
+		}
+	})
+
+	t.Run("Minted key authenticates with its scopes", func(t *testing.T) {
+		var stored model.APIKey
+		authRepo.EXPECT().AddAPIKey(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, key model.APIKey) (model.APIKey, error) {
+			key.ID = 3
+			stored = key
+			return key, nil
+		})
+
+		key, err := usecase.AddAPIKey(ctx, user, model.AddAPIKeyRequest{Name: "ci", Scopes: []string{"film:write"}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if key.Key == "" || stored.Hash != hashToken(key.Key) || key.Prefix != key.Key[:len(key.Prefix)] {
+			t.Fatalf("Unexpected key %+v", key)
+		}
+
+		authRepo.EXPECT().GetAPIKeyByHash(ctx, stored.Hash).Return(stored, user, nil)
+		authRepo.EXPECT().TouchAPIKey(ctx, uint64(3)).Return(nil)
+
+		got, credential, err := usecase.AuthenticateBearer(ctx, key.Key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != user || credential.Kind != auth.CredentialAPIKey || !reflect.DeepEqual(credential.Scopes, []string{"film:write"}) {
+			t.Errorf("Unexpected result %v %+v", got, credential)
+		}
+		if !credential.Allows(auth.FilmWrite) || credential.Allows(auth.FilmRead) {
+			t.Errorf("Unexpected scopes %v", credential.Scopes)
+		}
+	})
+
+	t.Run("Revoked key", func(t *testing.T) {
+		authRepo.EXPECT().GetAPIKeyByHash(ctx, hashToken("fl_revoked")).Return(model.APIKey{}, model.User{}, &model.ErrNotFound{Message: "api key not found"})
+
+		_, _, err := usecase.AuthenticateBearer(ctx, "fl_revoked")
+		var unauthorized *model.ErrUnauthorized
+		if !errors.As(err, &unauthorized) {
+			t.Errorf("Expected unauthorized, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,172 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"films_library/internal/auth"
+	"films_library/internal/model"
+	"films_library/pkg/logger"
+	"films_library/pkg/pagination"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// dummyHash is compared against when the username is unknown, so that a
+// failed login takes as long whether or not the user exists.
+const dummyHash = "$2a$10$NwFIBeUEuR2E8bCI7V1PnOp4U4.q9VcXGm9VWN5WMJ.VUXB70yU1K"
+
+var errInvalidCredentials = &model.ErrUnauthorized{Message: "invalid username or password"}
+
+type AuthUsecase struct {
+	authRepo    auth.Repository
+	sessionTTL  time.Duration
+	rotateAfter time.Duration
+	tokens      TokenKeys
+	logger      logger.Interface
+}
+
+// NewAuthUsecase returns sessions that live for sessionTTL and are replaced
+// by a new token once they are older than rotateAfter. Bearer JWTs are
+// signed and verified with tokens.
+func NewAuthUsecase(ar auth.Repository, sessionTTL, rotateAfter time.Duration, tokens TokenKeys, l logger.Interface) *AuthUsecase {
+	return &AuthUsecase{ar, sessionTTL, rotateAfter, tokens, l}
+}
+
+func (au *AuthUsecase) Login(ctx context.Context, request model.LoginRequest) (model.User, model.Session, error) {
+	user, err := au.authRepo.GetUserByName(ctx, request.Username)
+	if err != nil {
+		var notFound *model.ErrNotFound
+		if !errors.As(err, &notFound) {
+			return model.User{}, model.Session{}, err
+		}
+		_ = bcrypt.CompareHashAndPassword([]byte(dummyHash), []byte(request.Password))
+		return model.User{}, model.Session{}, errInvalidCredentials
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(request.Password)); err != nil {
+		return model.User{}, model.Session{}, errInvalidCredentials
+	}
+	user.PasswordHash = ""
+
+	session, err := au.newSession(user.ID)
+	if err != nil {
+		return model.User{}, model.Session{}, err
+	}
+	if err := au.authRepo.AddSession(ctx, session); err != nil {
+		return model.User{}, model.Session{}, err
+	}
+	return user, session, nil
+}
+
+// Authenticate resolves a session token to its user. A session older than
+// rotateAfter is replaced, and the returned session then carries the new token.
+func (au *AuthUsecase) Authenticate(ctx context.Context, token string) (model.User, model.Session, error) {
+	if token == "" {
+		return model.User{}, model.Session{}, &model.ErrUnauthorized{Message: "missing session"}
+	}
+
+	session, user, err := au.authRepo.GetSession(ctx, hashToken(token))
+	if err != nil {
+		var notFound *model.ErrNotFound
+		if errors.As(err, &notFound) {
+			return model.User{}, model.Session{}, &model.ErrUnauthorized{Message: "invalid session"}
+		}
+		return model.User{}, model.Session{}, err
+	}
+
+	if time.Since(session.CreatedAt) < au.rotateAfter {
+		return user, session, nil
+	}
+
+	fresh, err := au.newSession(user.ID)
+	if err != nil {
+		return model.User{}, model.Session{}, err
+	}
+	if err := au.authRepo.RotateSession(ctx, session.ID, fresh); err != nil {
+		var notFound *model.ErrNotFound
+		if errors.As(err, &notFound) {
+			// A concurrent request rotated it first; serve this one as is.
+			return user, session, nil
+		}
+		return model.User{}, model.Session{}, err
+	}
+	return user, fresh, nil
+}
+
+func (au *AuthUsecase) Logout(ctx context.Context, token string) error {
+	return au.authRepo.RevokeSession(ctx, hashToken(token))
+}
+
+func (au *AuthUsecase) LogoutAll(ctx context.Context, userID uint64) error {
+	return au.authRepo.RevokeUserSessions(ctx, userID)
+}
+
+func (au *AuthUsecase) AddUser(ctx context.Context, request model.AddUserRequest) (model.User, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(request.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return model.User{}, err
+	}
+
+	user := model.User{Username: request.Username, Role: request.Role, PasswordHash: string(hash)}
+	id, err := au.authRepo.AddUser(ctx, user)
+	if err != nil {
+		return model.User{}, err
+	}
+
+	user.ID = id
+	user.PasswordHash = ""
+	return user, nil
+}
+
+func (au *AuthUsecase) GetUsers(ctx context.Context, filter model.UserFilter) ([]model.User, pagination.Page, error) {
+	users, err := au.authRepo.GetUsers(ctx, filter)
+	if err != nil {
+		return []model.User{}, pagination.Page{}, err
+	}
+
+	users, page := pagination.Paginate(users, filter.Params, func(user model.User) pagination.Cursor {
+		return pagination.Cursor{ID: user.ID}
+	})
+	return users, page, nil
+}
+
+// SetRole assigns role to a user. Sessions read the role on every request,
+// so the change applies to open sessions straight away.
+func (au *AuthUsecase) SetRole(ctx context.Context, id uint64, role string) (model.User, error) {
+	return au.authRepo.SetUserRole(ctx, id, role)
+}
+
+func (au *AuthUsecase) newSession(userID uint64) (model.Session, error) {
+	token, err := randomToken()
+	if err != nil {
+		return model.Session{}, err
+	}
+
+	now := time.Now()
+	return model.Session{
+		ID:        hashToken(token),
+		Token:     token,
+		UserID:    userID,
+		CreatedAt: now,
+		ExpiresAt: now.Add(au.sessionTTL),
+	}, nil
+}
+
+func randomToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,175 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mock_auth "films_library/internal/auth/mocks"
+	"films_library/internal/model"
+	"films_library/pkg/logger"
+
+	"github.com/golang/mock/gomock"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestAuthUsecase_Login(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	loggerMock := logger.NewMockInterface(ctrl)
+	authRepo := mock_auth.NewMockRepository(ctrl)
+	usecase := NewAuthUsecase(authRepo, time.Hour, time.Minute, TokenKeys{}, loggerMock)
+
+	ctx := context.Background()
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("secret-password"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stored := model.User{ID: 7, Username: "neo", Role: model.RoleViewer, PasswordHash: string(hash)}
+
+	testCases := []struct {
+		name          string
+		request       model.LoginRequest
+		mockRepoFn    func()
+		expectedUser  model.User
+		expectedError error
+	}{
+		{
+			name:    "Successful login",
+			request: model.LoginRequest{Username: "neo", Password: "secret-password"},
+			mockRepoFn: func() {
+				authRepo.EXPECT().GetUserByName(ctx, "neo").Return(stored, nil)
+				authRepo.EXPECT().AddSession(ctx, gomock.Any()).Return(nil)
+			},
+			expectedUser: model.User{ID: 7, Username: "neo", Role: model.RoleViewer},
+		},
+		{
+			name:    "Wrong password",
+			request: model.LoginRequest{Username: "neo", Password: "guess"},
+			mockRepoFn: func() {
+				authRepo.EXPECT().GetUserByName(ctx, "neo").Return(stored, nil)
+			},
+			expectedError: errInvalidCredentials,
+		},
+		{
+			name:    "Unknown user",
+			request: model.LoginRequest{Username: "smith", Password: "guess"},
+			mockRepoFn: func() {
+				authRepo.EXPECT().GetUserByName(ctx, "smith").Return(model.User{}, &model.ErrNotFound{Message: "user not found"})
+			},
+			expectedError: errInvalidCredentials,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockRepoFn()
+
+			user, session, err := usecase.Login(ctx, tc.request)
+			if err != tc.expectedError {
+				t.Errorf("Unexpected error: expected %v, got %v", tc.expectedError, err)
+			}
+
+			if user != tc.expectedUser {
+				t.Errorf("Expected user %v, got %v", tc.expectedUser, user)
+			}
+
+			if err == nil && (session.Token == "" || session.ID != hashToken(session.Token) || session.UserID != user.ID) {
+				t.Errorf("Unexpected session %+v", session)
+			}
+		})
+	}
+}
+
+func TestAuthUsecase_Authenticate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	loggerMock := logger.NewMockInterface(ctrl)
+	authRepo := mock_auth.NewMockRepository(ctrl)
+	usecase := NewAuthUsecase(authRepo, time.Hour, time.Minute, TokenKeys{}, loggerMock)
+
+	ctx := context.Background()
+	user := model.User{ID: 7, Username: "neo", Role: model.RoleViewer}
+	repoErr := errors.New("repository error")
+
+	testCases := []struct {
+		name          string
+		token         string
+		session       model.Session
+		repoErr       error
+		mockRotateFn  func()
+		rotated       bool
+		expectedError bool
+	}{
+		{
+			name:    "Fresh session",
+			token:   "token",
+			session: model.Session{ID: hashToken("token"), UserID: 7, CreatedAt: time.Now()},
+		},
+		{
+			name:    "Old session is rotated",
+			token:   "token",
+			session: model.Session{ID: hashToken("token"), UserID: 7, CreatedAt: time.Now().Add(-2 * time.Minute)},
+			mockRotateFn: func() {
+				authRepo.EXPECT().RotateSession(ctx, hashToken("token"), gomock.Any()).Return(nil)
+			},
+			rotated: true,
+		},
+		{
+			name:    "Concurrent rotation keeps the session",
+			token:   "token",
+			session: model.Session{ID: hashToken("token"), UserID: 7, CreatedAt: time.Now().Add(-2 * time.Minute)},
+			mockRotateFn: func() {
+				authRepo.EXPECT().RotateSession(ctx, hashToken("token"), gomock.Any()).Return(&model.ErrNotFound{Message: "session not found"})
+			},
+		},
+		{
+			name:          "Unknown session",
+			token:         "token",
+			repoErr:       &model.ErrNotFound{Message: "session not found"},
+			expectedError: true,
+		},
+		{
+			name:          "Error from repository",
+			token:         "token",
+			repoErr:       repoErr,
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authRepo.EXPECT().GetSession(ctx, hashToken(tc.token)).Return(tc.session, user, tc.repoErr)
+			if tc.mockRotateFn != nil {
+				tc.mockRotateFn()
+			}
+
+			gotUser, session, err := usecase.Authenticate(ctx, tc.token)
+			if (err != nil) != tc.expectedError {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if err != nil {
+				return
+			}
+
+			if gotUser != user {
+				t.Errorf("Expected user %v, got %v", user, gotUser)
+			}
+
+			if (session.Token != "") != tc.rotated {
+				t.Errorf("Expected rotated %v, got session %+v", tc.rotated, session)
+			}
+		})
+	}
+
+	t.Run("Missing token", func(t *testing.T) {
+		var unauthorized *model.ErrUnauthorized
+		if _, _, err := usecase.Authenticate(ctx, ""); !errors.As(err, &unauthorized) {
+			t.Errorf("Expected unauthorized, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,38 @@
+package collection
+
+import (
+	"context"
+
+	"films_library/internal/model"
+	"films_library/pkg/pagination"
+)
+
+type (
+	// Usecase lets owners edit their collections. Managers may edit any of
+	// them; everyone else sees public collections only.
+	Usecase interface {
+		GetCollections(ctx context.Context, filter model.CollectionFilter) ([]model.Collection, pagination.Page, error)
+		GetCollection(ctx context.Context, viewer model.User, id uint64) (model.ResponseCollection, error)
+		AddCollection(ctx context.Context, owner model.User, req model.CollectionRequest) (model.Collection, error)
+		UpdateCollection(ctx context.Context, user model.User, id uint64, req model.CollectionRequest, manager bool) (model.Collection, error)
+		DeleteCollection(ctx context.Context, user model.User, id uint64, manager bool) error
+
+		AddFilm(ctx context.Context, user model.User, id, filmID uint64, manager bool) error
+		RemoveFilm(ctx context.Context, user model.User, id, filmID uint64, manager bool) error
+		ReorderFilms(ctx context.Context, user model.User, id uint64, order model.FilmOrder, manager bool) error
+	}
+
+	Repository interface {
+		GetCollections(ctx context.Context, filter model.CollectionFilter) ([]model.Collection, error)
+		CountCollections(ctx context.Context, filter model.CollectionFilter) (int64, error)
+		GetCollection(ctx context.Context, id uint64) (model.Collection, error)
+		GetCollectionFilms(ctx context.Context, id uint64) ([]model.CollectionFilm, error)
+		AddCollection(ctx context.Context, c model.Collection) (model.Collection, error)
+		UpdateCollection(ctx context.Context, c model.Collection) (model.Collection, error)
+		DeleteCollection(ctx context.Context, id uint64) error
+
+		AddFilm(ctx context.Context, id, filmID uint64) error
+		RemoveFilm(ctx context.Context, id, filmID uint64) error
+		ReorderFilms(ctx context.Context, id uint64, filmIDs []uint64) error
+	}
+)
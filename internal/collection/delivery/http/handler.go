@@ -0,0 +1,390 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"films_library/internal/auth"
+	"films_library/internal/collection"
+	"films_library/internal/model"
+	"films_library/pkg/logger"
+	"films_library/pkg/pagination"
+	"films_library/pkg/response"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mailru/easyjson"
+)
+
+type CollectionHandler struct {
+	collectionUsecase collection.Usecase
+	logger            logger.Interface
+}
+
+// CollectionPermissions is the permission each collection route requires.
+var CollectionPermissions = auth.Permissions{
+	"GET /collections":                        auth.CollectionRead,
+	"GET /collections/{id}":                   auth.CollectionRead,
+	"POST /collections":                       auth.CollectionWrite,
+	"PUT /collections/{id}":                   auth.CollectionWrite,
+	"DELETE /collections/{id}":                auth.CollectionWrite,
+	"POST /collections/{id}/films/{filmId}":   auth.CollectionWrite,
+	"DELETE /collections/{id}/films/{filmId}": auth.CollectionWrite,
+	"PUT /collections/{id}/films":             auth.CollectionWrite,
+}
+
+func NewCollectionHandler(mux *http.ServeMux, cu collection.Usecase, l logger.Interface) {
+	r := &CollectionHandler{cu, l}
+
+	mux.HandleFunc("GET /collections", r.GetCollections)
+	mux.HandleFunc("GET /collections/{id}", r.GetCollection)
+	mux.HandleFunc("POST /collections", r.AddCollection)
+	mux.HandleFunc("PUT /collections/{id}", r.UpdateCollection)
+	mux.HandleFunc("DELETE /collections/{id}", r.DeleteCollection)
+	mux.HandleFunc("POST /collections/{id}/films/{filmId}", r.AddFilm)
+	mux.HandleFunc("DELETE /collections/{id}/films/{filmId}", r.RemoveFilm)
+	mux.HandleFunc("PUT /collections/{id}/films", r.ReorderFilms)
+}
+
+// GetCollections handles the HTTP GET request to list collections.
+// @Summary Get collections
+// @Description Retrieves a page of the public collections and those of the caller.
+// @Tags collections
+// @Produce json
+// @Param owner query integer false "Only collections of this user"
+// @Param limit query integer false "Page size (default 20, max 100)"
+// @Param offset query integer false "Number of collections to skip"
+// @Param cursor query string false "Cursor of the page to fetch"
+// @Param total query boolean false "Include the total number of collections"
+// @Success 200 {array} model.Collection "List of collections"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /collections [get]
+func (h *CollectionHandler) GetCollections(w http.ResponseWriter, r *http.Request) {
+	user, ok := auth.UserFromContext(r.Context())
+	if !ok {
+		response.ErrorResponse(w, http.StatusUnauthorized, "unauthorized", h.logger)
+		return
+	}
+
+	params, err := pagination.ParseQuery(r.URL.Query())
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Bad query param", h.logger)
+		return
+	}
+
+	filter := model.CollectionFilter{ViewerID: user.ID, Params: params}
+	if owner := r.URL.Query().Get("owner"); owner != "" {
+		filter.OwnerID, err = strconv.ParseUint(owner, 10, 64)
+		if err != nil {
+			h.logger.Error(err)
+			response.ErrorResponse(w, http.StatusBadRequest, "Bad query param", h.logger)
+			return
+		}
+	}
+
+	collections, page, err := h.collectionUsecase.GetCollections(r.Context(), filter)
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.PageResponse(w, http.StatusOK, collections, page)
+}
+
+// GetCollection handles the HTTP GET request to retrieve a collection.
+// @Summary Get collection
+// @Description Retrieves a collection with its films in order. Private collections are seen by their owner only.
+// @Tags collections
+// @Produce json
+// @Param id path integer true "ID of the collection"
+// @Success 200 {object} model.ResponseCollection "Collection"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 404 {string} string "Collection not found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /collections/{id} [get]
+func (h *CollectionHandler) GetCollection(w http.ResponseWriter, r *http.Request) {
+	user, id, ok := h.userAndID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	c, err := h.collectionUsecase.GetCollection(r.Context(), user, id)
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusOK, c)
+}
+
+// AddCollection handles the HTTP POST request to create a collection.
+// @Summary Add collection
+// @Description Creates an empty collection owned by the caller.
+// @Tags collections
+// @Accept json
+// @Produce json
+// @Param collection body model.CollectionRequest true "Collection"
+// @Success 201 {object} model.Collection "Created collection"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /collections [post]
+func (h *CollectionHandler) AddCollection(w http.ResponseWriter, r *http.Request) {
+	user, ok := auth.UserFromContext(r.Context())
+	if !ok {
+		response.ErrorResponse(w, http.StatusUnauthorized, "unauthorized", h.logger)
+		return
+	}
+
+	req, ok := h.collection(w, r)
+	if !ok {
+		return
+	}
+
+	c, err := h.collectionUsecase.AddCollection(r.Context(), user, req)
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusCreated, c)
+}
+
+// UpdateCollection handles the HTTP PUT request to update a collection.
+// @Summary Update collection
+// @Description Replaces the title, description and visibility of a collection of the caller. Managers may update any collection.
+// @Tags collections
+// @Accept json
+// @Produce json
+// @Param id path integer true "ID of the collection"
+// @Param collection body model.CollectionRequest true "Collection"
+// @Success 200 {object} model.Collection "Updated collection"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Collection belongs to another user"
+// @Failure 404 {string} string "Collection not found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /collections/{id} [put]
+func (h *CollectionHandler) UpdateCollection(w http.ResponseWriter, r *http.Request) {
+	user, id, ok := h.userAndID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	req, ok := h.collection(w, r)
+	if !ok {
+		return
+	}
+
+	c, err := h.collectionUsecase.UpdateCollection(r.Context(), user, id, req, manager(r, user))
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusOK, c)
+}
+
+// DeleteCollection handles the HTTP DELETE request to delete a collection.
+// @Summary Delete collection
+// @Description Deletes a collection of the caller. Managers may delete any collection.
+// @Tags collections
+// @Produce json
+// @Param id path integer true "ID of the collection"
+// @Success 200 {string} string "Collection deleted"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Collection belongs to another user"
+// @Failure 404 {string} string "Collection not found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /collections/{id} [delete]
+func (h *CollectionHandler) DeleteCollection(w http.ResponseWriter, r *http.Request) {
+	user, id, ok := h.userAndID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	if err := h.collectionUsecase.DeleteCollection(r.Context(), user, id, manager(r, user)); err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusOK, response.NIL())
+}
+
+// AddFilm handles the HTTP POST request to put a film in a collection.
+// @Summary Add film to collection
+// @Description Puts a film at the end of a collection of the caller.
+// @Tags collections
+// @Produce json
+// @Param id path integer true "ID of the collection"
+// @Param filmId path integer true "ID of the film"
+// @Success 201 {string} string "Film added"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Collection belongs to another user"
+// @Failure 404 {string} string "Collection or film not found"
+// @Failure 409 {string} string "Film is already in the collection"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /collections/{id}/films/{filmId} [post]
+func (h *CollectionHandler) AddFilm(w http.ResponseWriter, r *http.Request) {
+	user, id, ok := h.userAndID(w, r, "id")
+	if !ok {
+		return
+	}
+	filmId, err := strconv.ParseUint(r.PathValue("filmId"), 10, 64)
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, response.InvalidURLParameter, h.logger)
+		return
+	}
+
+	if err := h.collectionUsecase.AddFilm(r.Context(), user, id, filmId, manager(r, user)); err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusCreated, response.NIL())
+}
+
+// RemoveFilm handles the HTTP DELETE request to take a film out of a collection.
+// @Summary Remove film from collection
+// @Description Takes a film out of a collection of the caller.
+// @Tags collections
+// @Produce json
+// @Param id path integer true "ID of the collection"
+// @Param filmId path integer true "ID of the film"
+// @Success 200 {string} string "Film removed"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Collection belongs to another user"
+// @Failure 404 {string} string "Film is not in the collection"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /collections/{id}/films/{filmId} [delete]
+func (h *CollectionHandler) RemoveFilm(w http.ResponseWriter, r *http.Request) {
+	user, id, ok := h.userAndID(w, r, "id")
+	if !ok {
+		return
+	}
+	filmId, err := strconv.ParseUint(r.PathValue("filmId"), 10, 64)
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, response.InvalidURLParameter, h.logger)
+		return
+	}
+
+	if err := h.collectionUsecase.RemoveFilm(r.Context(), user, id, filmId, manager(r, user)); err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusOK, response.NIL())
+}
+
+// ReorderFilms handles the HTTP PUT request to reorder a collection.
+// @Summary Reorder collection
+// @Description Reorders a collection of the caller. The body lists every film in it exactly once.
+// @Tags collections
+// @Accept json
+// @Produce json
+// @Param id path integer true "ID of the collection"
+// @Param order body model.FilmOrder true "Films in their new order"
+// @Success 200 {string} string "Collection reordered"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Collection belongs to another user"
+// @Failure 404 {string} string "Collection not found"
+// @Failure 409 {string} string "Order does not match the collection"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /collections/{id}/films [put]
+func (h *CollectionHandler) ReorderFilms(w http.ResponseWriter, r *http.Request) {
+	user, id, ok := h.userAndID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var order model.FilmOrder
+	if err := easyjson.UnmarshalFromReader(r.Body, &order); err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Corrupted request body", h.logger)
+		return
+	}
+
+	v := validator.New()
+	if err := v.Struct(order); err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Invalid request", h.logger)
+		return
+	}
+
+	if err := h.collectionUsecase.ReorderFilms(r.Context(), user, id, order, manager(r, user)); err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusOK, response.NIL())
+}
+
+// manager reports whether the caller may edit collections of other users.
+func manager(r *http.Request, user model.User) bool {
+	credential, _ := auth.CredentialFromContext(r.Context())
+	return auth.Allowed(user.Role, auth.CollectionManage) && credential.Allows(auth.CollectionManage)
+}
+
+// collection decodes and validates a collection from the request body.
+func (h *CollectionHandler) collection(w http.ResponseWriter, r *http.Request) (model.CollectionRequest, bool) {
+	var req model.CollectionRequest
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Corrupted request body", h.logger)
+		return model.CollectionRequest{}, false
+	}
+
+	v := validator.New()
+	if err := v.Struct(req); err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Invalid request", h.logger)
+		return model.CollectionRequest{}, false
+	}
+	return req, true
+}
+
+// userAndID reads the caller and the ID in the named path segment.
+func (h *CollectionHandler) userAndID(w http.ResponseWriter, r *http.Request, name string) (model.User, uint64, bool) {
+	user, ok := auth.UserFromContext(r.Context())
+	if !ok {
+		response.ErrorResponse(w, http.StatusUnauthorized, "unauthorized", h.logger)
+		return model.User{}, 0, false
+	}
+
+	id, err := strconv.ParseUint(r.PathValue(name), 10, 64)
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, response.InvalidURLParameter, h.logger)
+		return model.User{}, 0, false
+	}
+	return user, id, true
+}
+
+// usecaseError maps errors returned by the collection usecase onto HTTP responses.
+func (h *CollectionHandler) usecaseError(w http.ResponseWriter, err error) {
+	var notFound *model.ErrNotFound
+	var conflict *model.ErrConflict
+	var forbidden *model.ErrForbidden
+
+	switch {
+	case errors.As(err, &notFound):
+		response.ErrorResponse(w, http.StatusNotFound, notFound.Error(), h.logger)
+	case errors.As(err, &conflict):
+		response.ErrorResponse(w, http.StatusConflict, conflict.Error(), h.logger)
+	case errors.As(err, &forbidden):
+		response.ErrorResponse(w, http.StatusForbidden, forbidden.Error(), h.logger)
+	default:
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusInternalServerError, "Internal server error", h.logger)
+	}
+}
@@ -0,0 +1,323 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/collection/collection.go
+
+// Package mock_collection is a generated GoMock package.
+package mock_collection
+
+import (
+	context "context"
+	model "films_library/internal/model"
+	pagination "films_library/pkg/pagination"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUsecase is a mock of Usecase interface.
+type MockUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseMockRecorder
+}
+
+// MockUsecaseMockRecorder is the mock recorder for MockUsecase.
+type MockUsecaseMockRecorder struct {
+	mock *MockUsecase
+}
+
+// NewMockUsecase creates a new mock instance.
+func NewMockUsecase(ctrl *gomock.Controller) *MockUsecase {
+	mock := &MockUsecase{ctrl: ctrl}
+	mock.recorder = &MockUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
+	return m.recorder
+}
+
+// AddCollection mocks base method.
+func (m *MockUsecase) AddCollection(ctx context.Context, owner model.User, req model.CollectionRequest) (model.Collection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCollection", ctx, owner, req)
+	ret0, _ := ret[0].(model.Collection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddCollection indicates an expected call of AddCollection.
+func (mr *MockUsecaseMockRecorder) AddCollection(ctx, owner, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCollection", reflect.TypeOf((*MockUsecase)(nil).AddCollection), ctx, owner, req)
+}
+
+// AddFilm mocks base method.
+func (m *MockUsecase) AddFilm(ctx context.Context, user model.User, id, filmID uint64, manager bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddFilm", ctx, user, id, filmID, manager)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddFilm indicates an expected call of AddFilm.
+func (mr *MockUsecaseMockRecorder) AddFilm(ctx, user, id, filmID, manager interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFilm", reflect.TypeOf((*MockUsecase)(nil).AddFilm), ctx, user, id, filmID, manager)
+}
+
+// DeleteCollection mocks base method.
+func (m *MockUsecase) DeleteCollection(ctx context.Context, user model.User, id uint64, manager bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCollection", ctx, user, id, manager)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCollection indicates an expected call of DeleteCollection.
+func (mr *MockUsecaseMockRecorder) DeleteCollection(ctx, user, id, manager interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCollection", reflect.TypeOf((*MockUsecase)(nil).DeleteCollection), ctx, user, id, manager)
+}
+
+// GetCollection mocks base method.
+func (m *MockUsecase) GetCollection(ctx context.Context, viewer model.User, id uint64) (model.ResponseCollection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCollection", ctx, viewer, id)
+	ret0, _ := ret[0].(model.ResponseCollection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCollection indicates an expected call of GetCollection.
+func (mr *MockUsecaseMockRecorder) GetCollection(ctx, viewer, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCollection", reflect.TypeOf((*MockUsecase)(nil).GetCollection), ctx, viewer, id)
+}
+
+// GetCollections mocks base method.
+func (m *MockUsecase) GetCollections(ctx context.Context, filter model.CollectionFilter) ([]model.Collection, pagination.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCollections", ctx, filter)
+	ret0, _ := ret[0].([]model.Collection)
+	ret1, _ := ret[1].(pagination.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetCollections indicates an expected call of GetCollections.
+func (mr *MockUsecaseMockRecorder) GetCollections(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCollections", reflect.TypeOf((*MockUsecase)(nil).GetCollections), ctx, filter)
+}
+
+// RemoveFilm mocks base method.
+func (m *MockUsecase) RemoveFilm(ctx context.Context, user model.User, id, filmID uint64, manager bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFilm", ctx, user, id, filmID, manager)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveFilm indicates an expected call of RemoveFilm.
+func (mr *MockUsecaseMockRecorder) RemoveFilm(ctx, user, id, filmID, manager interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFilm", reflect.TypeOf((*MockUsecase)(nil).RemoveFilm), ctx, user, id, filmID, manager)
+}
+
+// ReorderFilms mocks base method.
+func (m *MockUsecase) ReorderFilms(ctx context.Context, user model.User, id uint64, order model.FilmOrder, manager bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderFilms", ctx, user, id, order, manager)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderFilms indicates an expected call of ReorderFilms.
+func (mr *MockUsecaseMockRecorder) ReorderFilms(ctx, user, id, order, manager interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderFilms", reflect.TypeOf((*MockUsecase)(nil).ReorderFilms), ctx, user, id, order, manager)
+}
+
+// UpdateCollection mocks base method.
+func (m *MockUsecase) UpdateCollection(ctx context.Context, user model.User, id uint64, req model.CollectionRequest, manager bool) (model.Collection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCollection", ctx, user, id, req, manager)
+	ret0, _ := ret[0].(model.Collection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCollection indicates an expected call of UpdateCollection.
+func (mr *MockUsecaseMockRecorder) UpdateCollection(ctx, user, id, req, manager interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCollection", reflect.TypeOf((*MockUsecase)(nil).UpdateCollection), ctx, user, id, req, manager)
+}
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddCollection mocks base method.
+func (m *MockRepository) AddCollection(ctx context.Context, c model.Collection) (model.Collection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCollection", ctx, c)
+	ret0, _ := ret[0].(model.Collection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddCollection indicates an expected call of AddCollection.
+func (mr *MockRepositoryMockRecorder) AddCollection(ctx, c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCollection", reflect.TypeOf((*MockRepository)(nil).AddCollection), ctx, c)
+}
+
+// AddFilm mocks base method.
+func (m *MockRepository) AddFilm(ctx context.Context, id, filmID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddFilm", ctx, id, filmID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddFilm indicates an expected call of AddFilm.
+func (mr *MockRepositoryMockRecorder) AddFilm(ctx, id, filmID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFilm", reflect.TypeOf((*MockRepository)(nil).AddFilm), ctx, id, filmID)
+}
+
+// CountCollections mocks base method.
+func (m *MockRepository) CountCollections(ctx context.Context, filter model.CollectionFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountCollections", ctx, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountCollections indicates an expected call of CountCollections.
+func (mr *MockRepositoryMockRecorder) CountCollections(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountCollections", reflect.TypeOf((*MockRepository)(nil).CountCollections), ctx, filter)
+}
+
+// DeleteCollection mocks base method.
+func (m *MockRepository) DeleteCollection(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCollection", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCollection indicates an expected call of DeleteCollection.
+func (mr *MockRepositoryMockRecorder) DeleteCollection(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCollection", reflect.TypeOf((*MockRepository)(nil).DeleteCollection), ctx, id)
+}
+
+// GetCollection mocks base method.
+func (m *MockRepository) GetCollection(ctx context.Context, id uint64) (model.Collection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCollection", ctx, id)
+	ret0, _ := ret[0].(model.Collection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCollection indicates an expected call of GetCollection.
+func (mr *MockRepositoryMockRecorder) GetCollection(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCollection", reflect.TypeOf((*MockRepository)(nil).GetCollection), ctx, id)
+}
+
+// GetCollectionFilms mocks base method.
+func (m *MockRepository) GetCollectionFilms(ctx context.Context, id uint64) ([]model.CollectionFilm, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCollectionFilms", ctx, id)
+	ret0, _ := ret[0].([]model.CollectionFilm)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCollectionFilms indicates an expected call of GetCollectionFilms.
+func (mr *MockRepositoryMockRecorder) GetCollectionFilms(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCollectionFilms", reflect.TypeOf((*MockRepository)(nil).GetCollectionFilms), ctx, id)
+}
+
+// GetCollections mocks base method.
+func (m *MockRepository) GetCollections(ctx context.Context, filter model.CollectionFilter) ([]model.Collection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCollections", ctx, filter)
+	ret0, _ := ret[0].([]model.Collection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCollections indicates an expected call of GetCollections.
+func (mr *MockRepositoryMockRecorder) GetCollections(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCollections", reflect.TypeOf((*MockRepository)(nil).GetCollections), ctx, filter)
+}
+
+// RemoveFilm mocks base method.
+func (m *MockRepository) RemoveFilm(ctx context.Context, id, filmID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFilm", ctx, id, filmID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveFilm indicates an expected call of RemoveFilm.
+func (mr *MockRepositoryMockRecorder) RemoveFilm(ctx, id, filmID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFilm", reflect.TypeOf((*MockRepository)(nil).RemoveFilm), ctx, id, filmID)
+}
+
+// ReorderFilms mocks base method.
+func (m *MockRepository) ReorderFilms(ctx context.Context, id uint64, filmIDs []uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderFilms", ctx, id, filmIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderFilms indicates an expected call of ReorderFilms.
+func (mr *MockRepositoryMockRecorder) ReorderFilms(ctx, id, filmIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderFilms", reflect.TypeOf((*MockRepository)(nil).ReorderFilms), ctx, id, filmIDs)
+}
+
+// UpdateCollection mocks base method.
+func (m *MockRepository) UpdateCollection(ctx context.Context, c model.Collection) (model.Collection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCollection", ctx, c)
+	ret0, _ := ret[0].(model.Collection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCollection indicates an expected call of UpdateCollection.
+func (mr *MockRepositoryMockRecorder) UpdateCollection(ctx, c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCollection", reflect.TypeOf((*MockRepository)(nil).UpdateCollection), ctx, c)
+}
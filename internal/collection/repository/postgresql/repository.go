@@ -0,0 +1,295 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"films_library/internal/model"
+	"films_library/pkg/pagination"
+	"films_library/pkg/postgres"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	foreignKeyViolation = "23503"
+	uniqueViolation     = "23505"
+)
+
+const collectionColumns = `c.collection_id, c.title, c."description", c.owner_id, u.username, c.visibility,
+        (SELECT count(*) FROM collection_film cf WHERE cf.collection_id = c.collection_id),
+        c.created_at, c.updated_at`
+
+// filmColumns reads films as the film repository does, with a missing release
+// date as 0001-01-01 and a missing rating as -1.
+const filmColumns = `f.film_id, f.title, f."description", COALESCE(f.release_date, '0001-01-01'), COALESCE(f.rating, -1), f.rating_count, f.rating_sum`
+
+type Repository struct {
+	db postgres.DBConn
+}
+
+func NewRepository(db postgres.DBConn) *Repository {
+	return &Repository{db}
+}
+
+// GetCollections lists collections in the order they were created.
+func (r *Repository) GetCollections(ctx context.Context, filter model.CollectionFilter) ([]model.Collection, error) {
+	sqlQuery := `SELECT ` + collectionColumns + ` FROM collection c JOIN users u ON u.user_id = c.owner_id`
+
+	backward := filter.Backward()
+
+	args, where := collectionConditions(filter)
+	condition, clauses := filter.Keyset(pagination.Order{Key: "c.collection_id"}, &args)
+	if condition != "" {
+		where = append(where, condition)
+	}
+	for i, condition := range where {
+		if i == 0 {
+			sqlQuery += " WHERE " + condition
+		} else {
+			sqlQuery += " AND " + condition
+		}
+	}
+	sqlQuery += clauses
+
+	rows, err := r.db.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var collections []model.Collection
+	for rows.Next() {
+		c, err := scanCollection(rows)
+		if err != nil {
+			return nil, err
+		}
+		collections = append(collections, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if backward {
+		slices.Reverse(collections)
+	}
+	return collections, nil
+}
+
+func (r *Repository) CountCollections(ctx context.Context, filter model.CollectionFilter) (int64, error) {
+	sqlQuery := `SELECT count(*) FROM collection c`
+
+	args, where := collectionConditions(filter)
+	for i, condition := range where {
+		if i == 0 {
+			sqlQuery += " WHERE " + condition
+		} else {
+			sqlQuery += " AND " + condition
+		}
+	}
+
+	var total int64
+	if err := r.db.QueryRow(ctx, sqlQuery, args...).Scan(&total); err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+func collectionConditions(filter model.CollectionFilter) ([]interface{}, []string) {
+	args := []interface{}{model.VisibilityPublic, filter.ViewerID}
+	where := []string{"(c.visibility = $1 OR c.owner_id = $2)"}
+	if filter.OwnerID != 0 {
+		args = append(args, filter.OwnerID)
+		where = append(where, fmt.Sprintf("c.owner_id = $%d", len(args)))
+	}
+	return args, where
+}
+
+func (r *Repository) GetCollection(ctx context.Context, id uint64) (model.Collection, error) {
+	sqlQuery := `SELECT ` + collectionColumns + ` FROM collection c JOIN users u ON u.user_id = c.owner_id WHERE c.collection_id=$1`
+
+	c, err := scanCollection(r.db.QueryRow(ctx, sqlQuery, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Collection{}, &model.ErrNotFound{Message: "collection not found"}
+		}
+		return model.Collection{}, err
+	}
+	return c, nil
+}
+
+// GetCollectionFilms lists the films of a collection in its order.
+func (r *Repository) GetCollectionFilms(ctx context.Context, id uint64) ([]model.CollectionFilm, error) {
+	sqlQuery := `SELECT cf."position", ` + filmColumns + `
+        FROM collection_film cf
+        JOIN film f ON f.film_id = cf.film_id
+        WHERE cf.collection_id = $1
+        ORDER BY cf."position", cf.film_id`
+
+	rows, err := r.db.Query(ctx, sqlQuery, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	members := []model.CollectionFilm{}
+	for rows.Next() {
+		var member model.CollectionFilm
+		film := &member.Film
+		err := rows.Scan(
+			&member.Position,
+			&film.ID,
+			&film.Title,
+			&film.Description,
+			&film.ReleaseDate,
+			&film.Rating,
+			&film.RatingCount,
+			&film.RatingSum,
+		)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, member)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+func (r *Repository) AddCollection(ctx context.Context, c model.Collection) (model.Collection, error) {
+	sqlQuery := `
+        WITH c AS (
+            INSERT INTO collection (title, "description", owner_id, visibility) VALUES ($1, $2, $3, $4)
+            RETURNING *)
+        SELECT c.collection_id, c.title, c."description", c.owner_id, u.username, c.visibility, 0, c.created_at, c.updated_at
+        FROM c JOIN users u ON u.user_id = c.owner_id`
+
+	added, err := scanCollection(r.db.QueryRow(ctx, sqlQuery, c.Title, c.Description, c.OwnerID, c.Visibility))
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation {
+			return model.Collection{}, &model.ErrNotFound{Message: "user not found"}
+		}
+		return model.Collection{}, err
+	}
+	return added, nil
+}
+
+func (r *Repository) UpdateCollection(ctx context.Context, c model.Collection) (model.Collection, error) {
+	sqlQuery := `UPDATE collection SET title=$2, "description"=$3, visibility=$4, updated_at=now() WHERE collection_id=$1`
+
+	res, err := r.db.Exec(ctx, sqlQuery, c.ID, c.Title, c.Description, c.Visibility)
+	if err != nil {
+		return model.Collection{}, err
+	}
+	if res.RowsAffected() == 0 {
+		return model.Collection{}, &model.ErrNotFound{Message: "collection not found"}
+	}
+	return r.GetCollection(ctx, c.ID)
+}
+
+func (r *Repository) DeleteCollection(ctx context.Context, id uint64) error {
+	res, err := r.db.Exec(ctx, `DELETE FROM collection WHERE collection_id=$1`, id)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return &model.ErrNotFound{Message: "collection not found"}
+	}
+	return nil
+}
+
+// AddFilm puts a film at the end of a collection.
+func (r *Repository) AddFilm(ctx context.Context, id, filmID uint64) error {
+	sqlQuery := `
+        INSERT INTO collection_film (collection_id, film_id, "position")
+        SELECT $1, $2, COALESCE(max("position"), 0) + 1 FROM collection_film WHERE collection_id = $1`
+
+	if _, err := r.db.Exec(ctx, sqlQuery, id, filmID); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case foreignKeyViolation:
+				if pgErr.ConstraintName == "collection_film_film_id_fkey" {
+					return &model.ErrNotFound{Message: "film not found"}
+				}
+				return &model.ErrNotFound{Message: "collection not found"}
+			case uniqueViolation:
+				return &model.ErrConflict{Message: "film is already in the collection"}
+			}
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *Repository) RemoveFilm(ctx context.Context, id, filmID uint64) error {
+	res, err := r.db.Exec(ctx, `DELETE FROM collection_film WHERE collection_id=$1 AND film_id=$2`, id, filmID)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return &model.ErrNotFound{Message: "film is not in the collection"}
+	}
+	return nil
+}
+
+// ReorderFilms numbers the films of a collection in the order of filmIDs,
+// which must list each of them once.
+func (r *Repository) ReorderFilms(ctx context.Context, id uint64, filmIDs []uint64) error {
+	return r.db.BeginTxFunc(ctx, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, `SELECT film_id FROM collection_film WHERE collection_id=$1 FOR UPDATE`, id)
+		if err != nil {
+			return err
+		}
+		current := make(map[uint64]bool)
+		for rows.Next() {
+			var filmID uint64
+			if err := rows.Scan(&filmID); err != nil {
+				rows.Close()
+				return err
+			}
+			current[filmID] = true
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return err
+		}
+
+		if len(current) != len(filmIDs) {
+			return &model.ErrConflict{Message: "order must list every film of the collection once"}
+		}
+		for _, filmID := range filmIDs {
+			if !current[filmID] {
+				return &model.ErrConflict{Message: "order must list every film of the collection once"}
+			}
+		}
+
+		sqlQuery := `
+            UPDATE collection_film cf SET "position" = o.ord
+            FROM unnest($2::bigint[]) WITH ORDINALITY AS o(film_id, ord)
+            WHERE cf.collection_id = $1 AND cf.film_id = o.film_id`
+		_, err = tx.Exec(ctx, sqlQuery, id, filmIDs)
+		return err
+	})
+}
+
+func scanCollection(row pgx.Row) (model.Collection, error) {
+	var c model.Collection
+	err := row.Scan(
+		&c.ID,
+		&c.Title,
+		&c.Description,
+		&c.OwnerID,
+		&c.Owner,
+		&c.Visibility,
+		&c.Films,
+		&c.CreatedAt,
+		&c.UpdatedAt,
+	)
+	return c, err
+}
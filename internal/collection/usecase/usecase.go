@@ -0,0 +1,126 @@
+package usecase
+
+import (
+	"context"
+
+	"films_library/internal/collection"
+	"films_library/internal/model"
+	"films_library/pkg/logger"
+	"films_library/pkg/pagination"
+)
+
+type CollectionUsecase struct {
+	collectionRepo collection.Repository
+	prior          model.ScorePrior
+	logger         logger.Interface
+}
+
+func NewCollectionUsecase(cr collection.Repository, prior model.ScorePrior, l logger.Interface) *CollectionUsecase {
+	return &CollectionUsecase{cr, prior, l}
+}
+
+func (cu *CollectionUsecase) GetCollections(ctx context.Context, filter model.CollectionFilter) ([]model.Collection, pagination.Page, error) {
+	collections, err := cu.collectionRepo.GetCollections(ctx, filter)
+	if err != nil {
+		return []model.Collection{}, pagination.Page{}, err
+	}
+
+	collections, page := pagination.Paginate(collections, filter.Params, func(c model.Collection) pagination.Cursor {
+		return pagination.Cursor{ID: c.ID}
+	})
+
+	if filter.WithTotal {
+		total, err := cu.collectionRepo.CountCollections(ctx, filter)
+		if err != nil {
+			return []model.Collection{}, pagination.Page{}, err
+		}
+		page.Total = &total
+	}
+	return collections, page, nil
+}
+
+// GetCollection returns a collection with its films. Private collections of
+// other users are reported as missing.
+func (cu *CollectionUsecase) GetCollection(ctx context.Context, viewer model.User, id uint64) (model.ResponseCollection, error) {
+	c, err := cu.collectionRepo.GetCollection(ctx, id)
+	if err != nil {
+		return model.ResponseCollection{}, err
+	}
+	if c.Visibility != model.VisibilityPublic && c.OwnerID != viewer.ID {
+		return model.ResponseCollection{}, &model.ErrNotFound{Message: "collection not found"}
+	}
+
+	members, err := cu.collectionRepo.GetCollectionFilms(ctx, id)
+	if err != nil {
+		return model.ResponseCollection{}, err
+	}
+	for i := range members {
+		members[i].Film.CommunityScore = cu.prior.Score(members[i].Film.RatingSum, members[i].Film.RatingCount)
+	}
+	return model.ResponseCollection{Collection: c, Members: members}, nil
+}
+
+func (cu *CollectionUsecase) AddCollection(ctx context.Context, owner model.User, req model.CollectionRequest) (model.Collection, error) {
+	return cu.collectionRepo.AddCollection(ctx, model.Collection{
+		Title:       req.Title,
+		Description: req.Description,
+		OwnerID:     owner.ID,
+		Visibility:  req.Visibility,
+	})
+}
+
+func (cu *CollectionUsecase) UpdateCollection(ctx context.Context, user model.User, id uint64, req model.CollectionRequest, manager bool) (model.Collection, error) {
+	c, err := cu.editable(ctx, user, id, manager)
+	if err != nil {
+		return model.Collection{}, err
+	}
+
+	c.Title = req.Title
+	c.Description = req.Description
+	c.Visibility = req.Visibility
+	return cu.collectionRepo.UpdateCollection(ctx, c)
+}
+
+func (cu *CollectionUsecase) DeleteCollection(ctx context.Context, user model.User, id uint64, manager bool) error {
+	if _, err := cu.editable(ctx, user, id, manager); err != nil {
+		return err
+	}
+	return cu.collectionRepo.DeleteCollection(ctx, id)
+}
+
+func (cu *CollectionUsecase) AddFilm(ctx context.Context, user model.User, id, filmID uint64, manager bool) error {
+	if _, err := cu.editable(ctx, user, id, manager); err != nil {
+		return err
+	}
+	return cu.collectionRepo.AddFilm(ctx, id, filmID)
+}
+
+func (cu *CollectionUsecase) RemoveFilm(ctx context.Context, user model.User, id, filmID uint64, manager bool) error {
+	if _, err := cu.editable(ctx, user, id, manager); err != nil {
+		return err
+	}
+	return cu.collectionRepo.RemoveFilm(ctx, id, filmID)
+}
+
+func (cu *CollectionUsecase) ReorderFilms(ctx context.Context, user model.User, id uint64, order model.FilmOrder, manager bool) error {
+	if _, err := cu.editable(ctx, user, id, manager); err != nil {
+		return err
+	}
+	return cu.collectionRepo.ReorderFilms(ctx, id, order.FilmIDs)
+}
+
+// editable returns a collection user may change: one of their own, or any
+// one for managers. Private collections of other users stay hidden.
+func (cu *CollectionUsecase) editable(ctx context.Context, user model.User, id uint64, manager bool) (model.Collection, error) {
+	c, err := cu.collectionRepo.GetCollection(ctx, id)
+	if err != nil {
+		return model.Collection{}, err
+	}
+	if c.OwnerID == user.ID || manager {
+		return c, nil
+	}
+	if c.Visibility != model.VisibilityPublic {
+		return model.Collection{}, &model.ErrNotFound{Message: "collection not found"}
+	}
+	return model.Collection{}, &model.ErrForbidden{Message: "collection belongs to another user"}
+}
@@ -0,0 +1,154 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	mock_collection "films_library/internal/collection/mocks"
+	"films_library/internal/model"
+	"films_library/pkg/logger"
+
+	"github.com/golang/mock/gomock"
+)
+
+func TestCollectionUsecase_GetCollection(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	loggerMock := logger.NewMockInterface(ctrl)
+	collectionRepo := mock_collection.NewMockRepository(ctrl)
+	usecase := NewCollectionUsecase(collectionRepo, model.ScorePrior{Mean: 6, Weight: 10}, loggerMock)
+
+	ctx := context.Background()
+	members := []model.CollectionFilm{{Position: 1, Film: model.Film{ID: 4, RatingCount: 10, RatingSum: 100}}}
+
+	testCases := []struct {
+		name          string
+		viewer        model.User
+		visibility    string
+		expectFilms   bool
+		expectedError error
+	}{
+		{
+			name:        "Public collection is seen by anyone",
+			viewer:      model.User{ID: 9},
+			visibility:  model.VisibilityPublic,
+			expectFilms: true,
+		},
+		{
+			name:        "Owner sees private collection",
+			viewer:      model.User{ID: 3},
+			visibility:  model.VisibilityPrivate,
+			expectFilms: true,
+		},
+		{
+			name:          "Private collection of another user is hidden",
+			viewer:        model.User{ID: 9},
+			visibility:    model.VisibilityPrivate,
+			expectedError: &model.ErrNotFound{Message: "collection not found"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			stored := model.Collection{ID: 7, OwnerID: 3, Visibility: tc.visibility}
+			collectionRepo.EXPECT().GetCollection(ctx, uint64(7)).Return(stored, nil)
+			if tc.expectFilms {
+				collectionRepo.EXPECT().GetCollectionFilms(ctx, uint64(7)).
+					Return(append([]model.CollectionFilm(nil), members...), nil)
+			}
+
+			c, err := usecase.GetCollection(ctx, tc.viewer, 7)
+			if !reflect.DeepEqual(err, tc.expectedError) {
+				t.Fatalf("Expected error %v, got %v", tc.expectedError, err)
+			}
+			if !tc.expectFilms {
+				return
+			}
+			if len(c.Members) != 1 || c.Members[0].Film.CommunityScore != 8 {
+				t.Errorf("Expected one scored member, got %v", c.Members)
+			}
+		})
+	}
+}
+
+func TestCollectionUsecase_AddFilm(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	loggerMock := logger.NewMockInterface(ctrl)
+	collectionRepo := mock_collection.NewMockRepository(ctrl)
+	usecase := NewCollectionUsecase(collectionRepo, model.ScorePrior{}, loggerMock)
+
+	ctx := context.Background()
+
+	testCases := []struct {
+		name          string
+		user          model.User
+		visibility    string
+		manager       bool
+		expectAdd     bool
+		expectedError error
+	}{
+		{
+			name:       "Owner adds film",
+			user:       model.User{ID: 3},
+			visibility: model.VisibilityPrivate,
+			expectAdd:  true,
+		},
+		{
+			name:       "Manager adds film to collection of another user",
+			user:       model.User{ID: 9},
+			visibility: model.VisibilityPrivate,
+			manager:    true,
+			expectAdd:  true,
+		},
+		{
+			name:          "Public collection of another user is forbidden",
+			user:          model.User{ID: 9},
+			visibility:    model.VisibilityPublic,
+			expectedError: &model.ErrForbidden{Message: "collection belongs to another user"},
+		},
+		{
+			name:          "Private collection of another user is hidden",
+			user:          model.User{ID: 9},
+			visibility:    model.VisibilityPrivate,
+			expectedError: &model.ErrNotFound{Message: "collection not found"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			stored := model.Collection{ID: 7, OwnerID: 3, Visibility: tc.visibility}
+			collectionRepo.EXPECT().GetCollection(ctx, uint64(7)).Return(stored, nil)
+			if tc.expectAdd {
+				collectionRepo.EXPECT().AddFilm(ctx, uint64(7), uint64(4)).Return(nil)
+			}
+
+			err := usecase.AddFilm(ctx, tc.user, 7, 4, tc.manager)
+			if !reflect.DeepEqual(err, tc.expectedError) {
+				t.Errorf("Expected error %v, got %v", tc.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestCollectionUsecase_DeleteCollectionNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	loggerMock := logger.NewMockInterface(ctrl)
+	collectionRepo := mock_collection.NewMockRepository(ctrl)
+	usecase := NewCollectionUsecase(collectionRepo, model.ScorePrior{}, loggerMock)
+
+	ctx := context.Background()
+	notFound := &model.ErrNotFound{Message: "collection not found"}
+	collectionRepo.EXPECT().GetCollection(ctx, uint64(7)).Return(model.Collection{}, notFound)
+
+	err := usecase.DeleteCollection(ctx, model.User{ID: 3}, 7, false)
+	if !errors.Is(err, notFound) {
+		t.Errorf("Expected error %v, got %v", notFound, err)
+	}
+}
@@ -0,0 +1,27 @@
+package crew
+
+import (
+	"context"
+
+	"films_library/internal/model"
+	"films_library/pkg/pagination"
+)
+
+type (
+	Usecase interface {
+		GetPeople(ctx context.Context, filter model.CrewFilter) ([]model.ResponsePerson, pagination.Page, error)
+		GetPerson(ctx context.Context, id uint64) (model.ResponsePerson, error)
+		AddPerson(ctx context.Context, person model.Person) (uint64, error)
+		UpdatePerson(ctx context.Context, person model.Person) (model.Person, error)
+		DeletePerson(ctx context.Context, id uint64) error
+	}
+
+	Repository interface {
+		GetPeople(ctx context.Context, filter model.CrewFilter) ([]model.ResponsePerson, error)
+		CountPeople(ctx context.Context, filter model.CrewFilter) (int64, error)
+		GetPerson(ctx context.Context, id uint64) (model.ResponsePerson, error)
+		AddPerson(ctx context.Context, person model.Person) (uint64, error)
+		UpdatePerson(ctx context.Context, person model.Person) (model.Person, error)
+		DeletePerson(ctx context.Context, id uint64) error
+	}
+)
@@ -0,0 +1,231 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"films_library/internal/auth"
+	"films_library/internal/crew"
+	"films_library/internal/model"
+	"films_library/pkg/logger"
+	"films_library/pkg/pagination"
+	"films_library/pkg/response"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mailru/easyjson"
+)
+
+type CrewHandler struct {
+	crewUsecase crew.Usecase
+	logger      logger.Interface
+}
+
+// CrewPermissions is the permission each crew route requires.
+var CrewPermissions = auth.Permissions{
+	"GET /crew":         auth.CrewRead,
+	"GET /crew/{id}":    auth.CrewRead,
+	"POST /crew":        auth.CrewWrite,
+	"PUT /crew/{id}":    auth.CrewWrite,
+	"DELETE /crew/{id}": auth.CrewDelete,
+}
+
+func NewCrewHandler(mux *http.ServeMux, cu crew.Usecase, l logger.Interface) {
+	r := &CrewHandler{cu, l}
+
+	mux.HandleFunc("GET /crew", r.GetPeople)
+	mux.HandleFunc("GET /crew/{id}", r.GetPerson)
+	mux.HandleFunc("POST /crew", r.AddPerson)
+	mux.HandleFunc("PUT /crew/{id}", r.UpdatePerson)
+	mux.HandleFunc("DELETE /crew/{id}", r.DeletePerson)
+}
+
+// GetPeople handles the HTTP GET request to retrieve a list of crew members.
+// @Summary Get crew
+// @Description Retrieves a page of crew members with their credits.
+// @Tags crew
+// @Produce json
+// @Param role query string false "Only people credited in this role (director, writer, composer, producer, cinematographer)"
+// @Param limit query integer false "Page size (default 20, max 100)"
+// @Param offset query integer false "Number of people to skip"
+// @Param cursor query string false "Cursor of the page to fetch"
+// @Param total query boolean false "Include the total number of people"
+// @Success 200 {array} model.ResponsePerson "List of crew members"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /crew [get]
+func (h *CrewHandler) GetPeople(w http.ResponseWriter, r *http.Request) {
+	queryParams := r.URL.Query()
+
+	params, err := pagination.ParseQuery(queryParams)
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Bad query param", h.logger)
+		return
+	}
+
+	filter := model.CrewFilter{Role: queryParams.Get("role"), Params: params}
+
+	v := validator.New()
+	if err := v.Struct(filter); err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Invalid request", h.logger)
+		return
+	}
+
+	people, page, err := h.crewUsecase.GetPeople(r.Context(), filter)
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.PageResponse(w, http.StatusOK, people, page)
+}
+
+// GetPerson handles the HTTP GET request to retrieve a crew member.
+// @Summary Get crew member
+// @Description Retrieves a crew member with their credits.
+// @Tags crew
+// @Produce json
+// @Param id path integer true "ID of the person"
+// @Success 200 {object} model.ResponsePerson "Crew member"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Person not found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /crew/{id} [get]
+func (h *CrewHandler) GetPerson(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, response.InvalidURLParameter, h.logger)
+		return
+	}
+
+	person, err := h.crewUsecase.GetPerson(r.Context(), id)
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusOK, person)
+}
+
+// AddPerson handles the HTTP POST request to add a crew member.
+// @Summary Add crew member
+// @Description Adds a person who can be credited on the crew of films.
+// @Tags crew
+// @Accept json
+// @Produce json
+// @Param person body model.Person true "Person to be added"
+// @Success 201 {integer} integer "ID of the newly added person"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /crew [post]
+func (h *CrewHandler) AddPerson(w http.ResponseWriter, r *http.Request) {
+	person, ok := h.person(w, r)
+	if !ok {
+		return
+	}
+
+	id, err := h.crewUsecase.AddPerson(r.Context(), person)
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusCreated, id)
+}
+
+// UpdatePerson handles the HTTP PUT request to update a crew member.
+// @Summary Update crew member
+// @Description Replaces the name and birth date of a crew member.
+// @Tags crew
+// @Accept json
+// @Produce json
+// @Param id path integer true "ID of the person"
+// @Param person body model.Person true "Updated person"
+// @Success 200 {object} model.Person "Updated person"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Person not found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /crew/{id} [put]
+func (h *CrewHandler) UpdatePerson(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, response.InvalidURLParameter, h.logger)
+		return
+	}
+
+	person, ok := h.person(w, r)
+	if !ok {
+		return
+	}
+	person.ID = id
+
+	updated, err := h.crewUsecase.UpdatePerson(r.Context(), person)
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusOK, updated)
+}
+
+// DeletePerson handles the HTTP DELETE request to delete a crew member.
+// @Summary Delete crew member
+// @Description Deletes a crew member and their credits.
+// @Tags crew
+// @Produce json
+// @Param id path integer true "ID of the person"
+// @Success 200 {string} string "Person deleted"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Person not found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /crew/{id} [delete]
+func (h *CrewHandler) DeletePerson(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, response.InvalidURLParameter, h.logger)
+		return
+	}
+
+	if err := h.crewUsecase.DeletePerson(r.Context(), id); err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusOK, response.NIL())
+}
+
+// person decodes and validates a person from the request body.
+func (h *CrewHandler) person(w http.ResponseWriter, r *http.Request) (model.Person, bool) {
+	var person model.Person
+	if err := easyjson.UnmarshalFromReader(r.Body, &person); err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Corrupted request body", h.logger)
+		return model.Person{}, false
+	}
+
+	v := validator.New()
+	if err := v.Struct(person); err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Invalid request", h.logger)
+		return model.Person{}, false
+	}
+	return person, true
+}
+
+// usecaseError maps errors returned by the crew usecase onto HTTP responses.
+func (h *CrewHandler) usecaseError(w http.ResponseWriter, err error) {
+	var notFound *model.ErrNotFound
+
+	switch {
+	case errors.As(err, &notFound):
+		response.ErrorResponse(w, http.StatusNotFound, notFound.Error(), h.logger)
+	default:
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusInternalServerError, "Internal server error", h.logger)
+	}
+}
@@ -0,0 +1,224 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/crew/crew.go
+
+// Package mock_crew is a generated GoMock package.
+package mock_crew
+
+import (
+	context "context"
+	model "films_library/internal/model"
+	pagination "films_library/pkg/pagination"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUsecase is a mock of Usecase interface.
+type MockUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseMockRecorder
+}
+
+// MockUsecaseMockRecorder is the mock recorder for MockUsecase.
+type MockUsecaseMockRecorder struct {
+	mock *MockUsecase
+}
+
+// NewMockUsecase creates a new mock instance.
+func NewMockUsecase(ctrl *gomock.Controller) *MockUsecase {
+	mock := &MockUsecase{ctrl: ctrl}
+	mock.recorder = &MockUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
+	return m.recorder
+}
+
+// AddPerson mocks base method.
+func (m *MockUsecase) AddPerson(ctx context.Context, person model.Person) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPerson", ctx, person)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddPerson indicates an expected call of AddPerson.
+func (mr *MockUsecaseMockRecorder) AddPerson(ctx, person interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPerson", reflect.TypeOf((*MockUsecase)(nil).AddPerson), ctx, person)
+}
+
+// DeletePerson mocks base method.
+func (m *MockUsecase) DeletePerson(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePerson", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePerson indicates an expected call of DeletePerson.
+func (mr *MockUsecaseMockRecorder) DeletePerson(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePerson", reflect.TypeOf((*MockUsecase)(nil).DeletePerson), ctx, id)
+}
+
+// GetPeople mocks base method.
+func (m *MockUsecase) GetPeople(ctx context.Context, filter model.CrewFilter) ([]model.ResponsePerson, pagination.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPeople", ctx, filter)
+	ret0, _ := ret[0].([]model.ResponsePerson)
+	ret1, _ := ret[1].(pagination.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPeople indicates an expected call of GetPeople.
+func (mr *MockUsecaseMockRecorder) GetPeople(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPeople", reflect.TypeOf((*MockUsecase)(nil).GetPeople), ctx, filter)
+}
+
+// GetPerson mocks base method.
+func (m *MockUsecase) GetPerson(ctx context.Context, id uint64) (model.ResponsePerson, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPerson", ctx, id)
+	ret0, _ := ret[0].(model.ResponsePerson)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPerson indicates an expected call of GetPerson.
+func (mr *MockUsecaseMockRecorder) GetPerson(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPerson", reflect.TypeOf((*MockUsecase)(nil).GetPerson), ctx, id)
+}
+
+// UpdatePerson mocks base method.
+func (m *MockUsecase) UpdatePerson(ctx context.Context, person model.Person) (model.Person, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePerson", ctx, person)
+	ret0, _ := ret[0].(model.Person)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePerson indicates an expected call of UpdatePerson.
+func (mr *MockUsecaseMockRecorder) UpdatePerson(ctx, person interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePerson", reflect.TypeOf((*MockUsecase)(nil).UpdatePerson), ctx, person)
+}
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddPerson mocks base method.
+func (m *MockRepository) AddPerson(ctx context.Context, person model.Person) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPerson", ctx, person)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddPerson indicates an expected call of AddPerson.
+func (mr *MockRepositoryMockRecorder) AddPerson(ctx, person interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPerson", reflect.TypeOf((*MockRepository)(nil).AddPerson), ctx, person)
+}
+
+// CountPeople mocks base method.
+func (m *MockRepository) CountPeople(ctx context.Context, filter model.CrewFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountPeople", ctx, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountPeople indicates an expected call of CountPeople.
+func (mr *MockRepositoryMockRecorder) CountPeople(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountPeople", reflect.TypeOf((*MockRepository)(nil).CountPeople), ctx, filter)
+}
+
+// DeletePerson mocks base method.
+func (m *MockRepository) DeletePerson(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePerson", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePerson indicates an expected call of DeletePerson.
+func (mr *MockRepositoryMockRecorder) DeletePerson(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePerson", reflect.TypeOf((*MockRepository)(nil).DeletePerson), ctx, id)
+}
+
+// GetPeople mocks base method.
+func (m *MockRepository) GetPeople(ctx context.Context, filter model.CrewFilter) ([]model.ResponsePerson, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPeople", ctx, filter)
+	ret0, _ := ret[0].([]model.ResponsePerson)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPeople indicates an expected call of GetPeople.
+func (mr *MockRepositoryMockRecorder) GetPeople(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPeople", reflect.TypeOf((*MockRepository)(nil).GetPeople), ctx, filter)
+}
+
+// GetPerson mocks base method.
+func (m *MockRepository) GetPerson(ctx context.Context, id uint64) (model.ResponsePerson, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPerson", ctx, id)
+	ret0, _ := ret[0].(model.ResponsePerson)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPerson indicates an expected call of GetPerson.
+func (mr *MockRepositoryMockRecorder) GetPerson(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPerson", reflect.TypeOf((*MockRepository)(nil).GetPerson), ctx, id)
+}
+
+// UpdatePerson mocks base method.
+func (m *MockRepository) UpdatePerson(ctx context.Context, person model.Person) (model.Person, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePerson", ctx, person)
+	ret0, _ := ret[0].(model.Person)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePerson indicates an expected call of UpdatePerson.
+func (mr *MockRepositoryMockRecorder) UpdatePerson(ctx, person interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePerson", reflect.TypeOf((*MockRepository)(nil).UpdatePerson), ctx, person)
+}
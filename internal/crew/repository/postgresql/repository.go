@@ -0,0 +1,185 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"films_library/internal/model"
+	"films_library/pkg/pagination"
+	"films_library/pkg/postgres"
+
+	"github.com/jackc/pgx/v4"
+)
+
+const personColumns = `p.person_id, p."name", COALESCE(to_char(p.birth_date, 'YYYY-MM-DD'), '')`
+
+type Repository struct {
+	db postgres.DBConn
+}
+
+func NewRepository(db postgres.DBConn) *Repository {
+	return &Repository{db}
+}
+
+func (r *Repository) GetPeople(ctx context.Context, filter model.CrewFilter) ([]model.ResponsePerson, error) {
+	sqlQuery := `SELECT ` + personColumns + ` FROM person p`
+
+	backward := filter.Backward()
+
+	var args []interface{}
+	var where []string
+	if filter.Role != "" {
+		args = append(args, filter.Role)
+		where = append(where, fmt.Sprintf(`EXISTS (SELECT 1 FROM film_crew fc WHERE fc.person_id = p.person_id AND fc."role" = $%d)`, len(args)))
+	}
+	condition, clauses := filter.Keyset(pagination.Order{Key: "p.person_id"}, &args)
+	if condition != "" {
+		where = append(where, condition)
+	}
+	for i, condition := range where {
+		if i == 0 {
+			sqlQuery += " WHERE " + condition
+		} else {
+			sqlQuery += " AND " + condition
+		}
+	}
+	sqlQuery += clauses
+
+	rows, err := r.db.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var people []model.ResponsePerson
+	for rows.Next() {
+		person := model.ResponsePerson{Credits: []model.CrewCredit{}}
+		if err := rows.Scan(&person.ID, &person.Name, &person.BirthDate); err != nil {
+			return nil, err
+		}
+		people = append(people, person)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if backward {
+		slices.Reverse(people)
+	}
+
+	if err := r.attachCredits(ctx, people); err != nil {
+		return nil, err
+	}
+	return people, nil
+}
+
+func (r *Repository) CountPeople(ctx context.Context, filter model.CrewFilter) (int64, error) {
+	sqlQuery := `SELECT count(*) FROM person p`
+
+	var args []interface{}
+	if filter.Role != "" {
+		args = append(args, filter.Role)
+		sqlQuery += ` WHERE EXISTS (SELECT 1 FROM film_crew fc WHERE fc.person_id = p.person_id AND fc."role" = $1)`
+	}
+
+	var total int64
+	if err := r.db.QueryRow(ctx, sqlQuery, args...).Scan(&total); err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+func (r *Repository) GetPerson(ctx context.Context, id uint64) (model.ResponsePerson, error) {
+	sqlQuery := `SELECT ` + personColumns + ` FROM person p WHERE p.person_id=$1`
+
+	person := model.ResponsePerson{Credits: []model.CrewCredit{}}
+	err := r.db.QueryRow(ctx, sqlQuery, id).Scan(&person.ID, &person.Name, &person.BirthDate)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.ResponsePerson{}, &model.ErrNotFound{Message: "person not found"}
+		}
+		return model.ResponsePerson{}, err
+	}
+
+	people := []model.ResponsePerson{person}
+	if err := r.attachCredits(ctx, people); err != nil {
+		return model.ResponsePerson{}, err
+	}
+	return people[0], nil
+}
+
+func (r *Repository) AddPerson(ctx context.Context, person model.Person) (uint64, error) {
+	sqlQuery := `INSERT INTO person ("name", birth_date) VALUES ($1, NULLIF($2, '')::date) RETURNING person_id`
+
+	var id uint64
+	if err := r.db.QueryRow(ctx, sqlQuery, person.Name, person.BirthDate).Scan(&id); err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+func (r *Repository) UpdatePerson(ctx context.Context, person model.Person) (model.Person, error) {
+	sqlQuery := `UPDATE person SET "name"=$2, birth_date=NULLIF($3, '')::date WHERE person_id=$1`
+
+	res, err := r.db.Exec(ctx, sqlQuery, person.ID, person.Name, person.BirthDate)
+	if err != nil {
+		return model.Person{}, err
+	}
+	if res.RowsAffected() == 0 {
+		return model.Person{}, &model.ErrNotFound{Message: "person not found"}
+	}
+	return person, nil
+}
+
+func (r *Repository) DeletePerson(ctx context.Context, id uint64) error {
+	sqlQuery := `DELETE FROM person WHERE person_id=$1`
+
+	res, err := r.db.Exec(ctx, sqlQuery, id)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return &model.ErrNotFound{Message: "person not found"}
+	}
+	return nil
+}
+
+// attachCredits loads the credits of every person with a single query.
+func (r *Repository) attachCredits(ctx context.Context, people []model.ResponsePerson) error {
+	if len(people) == 0 {
+		return nil
+	}
+
+	ids := make([]uint64, 0, len(people))
+	index := make(map[uint64]int, len(people))
+	for i, person := range people {
+		ids = append(ids, person.ID)
+		index[person.ID] = i
+	}
+
+	sqlQuery := `
+        SELECT fc.person_id, f.film_id, f.title, fc."role"
+        FROM film_crew AS fc
+        JOIN film f ON f.film_id = fc.film_id
+        WHERE fc.person_id = ANY($1)
+        ORDER BY fc.person_id, f.release_date, fc."role"`
+
+	rows, err := r.db.Query(ctx, sqlQuery, ids)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var personID uint64
+		var credit model.CrewCredit
+		if err := rows.Scan(&personID, &credit.FilmID, &credit.Title, &credit.Role); err != nil {
+			return err
+		}
+		i := index[personID]
+		people[i].Credits = append(people[i].Credits, credit)
+	}
+	return rows.Err()
+}
@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"context"
+
+	"films_library/internal/crew"
+	"films_library/internal/model"
+	"films_library/pkg/logger"
+	"films_library/pkg/pagination"
+)
+
+type CrewUsecase struct {
+	crewRepo crew.Repository
+	logger   logger.Interface
+}
+
+func NewCrewUsecase(cr crew.Repository, l logger.Interface) *CrewUsecase {
+	return &CrewUsecase{cr, l}
+}
+
+func (cu *CrewUsecase) GetPeople(ctx context.Context, filter model.CrewFilter) ([]model.ResponsePerson, pagination.Page, error) {
+	people, err := cu.crewRepo.GetPeople(ctx, filter)
+	if err != nil {
+		return []model.ResponsePerson{}, pagination.Page{}, err
+	}
+
+	people, page := pagination.Paginate(people, filter.Params, func(person model.ResponsePerson) pagination.Cursor {
+		return pagination.Cursor{ID: person.ID}
+	})
+
+	if filter.WithTotal {
+		total, err := cu.crewRepo.CountPeople(ctx, filter)
+		if err != nil {
+			return []model.ResponsePerson{}, pagination.Page{}, err
+		}
+		page.Total = &total
+	}
+	return people, page, nil
+}
+
+func (cu *CrewUsecase) GetPerson(ctx context.Context, id uint64) (model.ResponsePerson, error) {
+	return cu.crewRepo.GetPerson(ctx, id)
+}
+
+func (cu *CrewUsecase) AddPerson(ctx context.Context, person model.Person) (uint64, error) {
+	return cu.crewRepo.AddPerson(ctx, person)
+}
+
+func (cu *CrewUsecase) UpdatePerson(ctx context.Context, person model.Person) (model.Person, error) {
+	return cu.crewRepo.UpdatePerson(ctx, person)
+}
+
+func (cu *CrewUsecase) DeletePerson(ctx context.Context, id uint64) error {
+	return cu.crewRepo.DeletePerson(ctx, id)
+}
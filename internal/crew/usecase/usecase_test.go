@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	mock_crew "films_library/internal/crew/mocks"
+	"films_library/internal/model"
+	"films_library/pkg/logger"
+	"films_library/pkg/pagination"
+
+	"github.com/golang/mock/gomock"
+)
+
+func TestCrewUsecase_GetPeople(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	loggerMock := logger.NewMockInterface(ctrl)
+	crewRepo := mock_crew.NewMockRepository(ctrl)
+	usecase := NewCrewUsecase(crewRepo, loggerMock)
+
+	ctx := context.Background()
+
+	people := []model.ResponsePerson{
+		{Person: model.Person{ID: 1, Name: "Lana Wachowski"}, Credits: []model.CrewCredit{{FilmID: 3, Title: "The Matrix", Role: model.CrewDirector}}},
+		{Person: model.Person{ID: 2, Name: "Don Davis"}, Credits: []model.CrewCredit{{FilmID: 3, Title: "The Matrix", Role: model.CrewComposer}}},
+	}
+	var total int64 = 2
+
+	testCases := []struct {
+		name           string
+		filter         model.CrewFilter
+		repoPeople     []model.ResponsePerson
+		repoError      error
+		countTotal     bool
+		expectedPeople []model.ResponsePerson
+		expectedPage   pagination.Page
+		expectedError  error
+	}{
+		{
+			name:           "Single page",
+			filter:         model.CrewFilter{Params: pagination.Params{Limit: 5}},
+			repoPeople:     people,
+			expectedPeople: people,
+		},
+		{
+			name:           "More people follow",
+			filter:         model.CrewFilter{Role: model.CrewDirector, Params: pagination.Params{Limit: 1}},
+			repoPeople:     people,
+			expectedPeople: people[:1],
+			expectedPage:   pagination.Page{Next: pagination.Encode(pagination.Cursor{ID: 1})},
+		},
+		{
+			name:           "With total",
+			filter:         model.CrewFilter{Params: pagination.Params{WithTotal: true}},
+			repoPeople:     people,
+			countTotal:     true,
+			expectedPeople: people,
+			expectedPage:   pagination.Page{Total: &total},
+		},
+		{
+			name:           "Error from repository",
+			filter:         model.CrewFilter{},
+			repoError:      errors.New("repository error"),
+			expectedPeople: []model.ResponsePerson{},
+			expectedError:  errors.New("repository error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			crewRepo.EXPECT().GetPeople(ctx, tc.filter).Return(tc.repoPeople, tc.repoError)
+			if tc.countTotal {
+				crewRepo.EXPECT().CountPeople(ctx, tc.filter).Return(total, nil)
+			}
+
+			people, page, err := usecase.GetPeople(ctx, tc.filter)
+			if (err == nil) != (tc.expectedError == nil) || err != nil && err.Error() != tc.expectedError.Error() {
+				t.Errorf("Unexpected error: expected %v, got %v", tc.expectedError, err)
+			}
+
+			if !reflect.DeepEqual(people, tc.expectedPeople) {
+				t.Errorf("Expected people %v, got %v", tc.expectedPeople, people)
+			}
+
+			if !reflect.DeepEqual(page, tc.expectedPage) {
+				t.Errorf("Expected page %v, got %v", tc.expectedPage, page)
+			}
+		})
+	}
+}
@@ -0,0 +1,278 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"films_library/internal/auth"
+	"films_library/internal/externalid"
+	"films_library/internal/model"
+	"films_library/pkg/logger"
+	"films_library/pkg/response"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mailru/easyjson"
+)
+
+type ExternalIDHandler struct {
+	externalUsecase externalid.Usecase
+	logger          logger.Interface
+}
+
+// ExternalIDPermissions is the permission each external ID route requires.
+var ExternalIDPermissions = auth.Permissions{
+	"GET /films/{id}/external-ids":                   auth.FilmRead,
+	"PUT /films/{id}/external-ids/{provider}":        auth.FilmWrite,
+	"DELETE /films/{id}/external-ids/{provider}":     auth.FilmWrite,
+	"GET /films/by-external/{provider}/{externalId}": auth.FilmRead,
+
+	"GET /actors/{id}/external-ids":                   auth.ActorRead,
+	"PUT /actors/{id}/external-ids/{provider}":        auth.ActorWrite,
+	"DELETE /actors/{id}/external-ids/{provider}":     auth.ActorWrite,
+	"GET /actors/by-external/{provider}/{externalId}": auth.ActorRead,
+}
+
+func NewExternalIDHandler(mux *http.ServeMux, eu externalid.Usecase, l logger.Interface) {
+	r := &ExternalIDHandler{eu, l}
+
+	mux.HandleFunc("GET /films/{id}/external-ids", r.GetFilmExternalIDs)
+	mux.HandleFunc("PUT /films/{id}/external-ids/{provider}", r.SetFilmExternalID)
+	mux.HandleFunc("DELETE /films/{id}/external-ids/{provider}", r.DeleteFilmExternalID)
+	mux.HandleFunc("GET /films/by-external/{provider}/{externalId}", r.FindFilm)
+
+	mux.HandleFunc("GET /actors/{id}/external-ids", r.GetActorExternalIDs)
+	mux.HandleFunc("PUT /actors/{id}/external-ids/{provider}", r.SetActorExternalID)
+	mux.HandleFunc("DELETE /actors/{id}/external-ids/{provider}", r.DeleteActorExternalID)
+	mux.HandleFunc("GET /actors/by-external/{provider}/{externalId}", r.FindActor)
+}
+
+// GetFilmExternalIDs handles the HTTP GET request to retrieve the external IDs of a film.
+// @Summary Get film external IDs
+// @Description Retrieves the IDs of a film in other catalogues: imdb, tmdb and wikidata.
+// @Tags external-ids
+// @Produce json
+// @Param id path integer true "ID of the film"
+// @Success 200 {array} model.ExternalID "External IDs"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /films/{id}/external-ids [get]
+func (h *ExternalIDHandler) GetFilmExternalIDs(w http.ResponseWriter, r *http.Request) {
+	h.getExternalIDs(w, r, model.EntityFilm)
+}
+
+// SetFilmExternalID handles the HTTP PUT request to set the external ID of a film with a provider.
+// @Summary Set film external ID
+// @Description Sets or replaces the ID of a film with a provider: an IMDb title ID such as tt0133093, a TMDb movie ID or a Wikidata item such as Q83495.
+// @Tags external-ids
+// @Accept json
+// @Produce json
+// @Param id path integer true "ID of the film"
+// @Param provider path string true "imdb, tmdb or wikidata"
+// @Param externalId body model.ExternalIDRequest true "External ID"
+// @Success 200 {object} model.ExternalID "External ID"
+// @Failure 400 {string} string "Unknown provider or invalid ID"
+// @Failure 404 {string} string "Film not found"
+// @Failure 409 {string} string "ID belongs to another film"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /films/{id}/external-ids/{provider} [put]
+func (h *ExternalIDHandler) SetFilmExternalID(w http.ResponseWriter, r *http.Request) {
+	h.setExternalID(w, r, model.EntityFilm)
+}
+
+// DeleteFilmExternalID handles the HTTP DELETE request to remove the external ID of a film with a provider.
+// @Summary Delete film external ID
+// @Description Removes the ID of a film with a provider.
+// @Tags external-ids
+// @Produce json
+// @Param id path integer true "ID of the film"
+// @Param provider path string true "imdb, tmdb or wikidata"
+// @Success 200 {string} string "External ID deleted"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "External ID not found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /films/{id}/external-ids/{provider} [delete]
+func (h *ExternalIDHandler) DeleteFilmExternalID(w http.ResponseWriter, r *http.Request) {
+	h.deleteExternalID(w, r, model.EntityFilm)
+}
+
+// FindFilm handles the HTTP GET request to retrieve a film by its external ID.
+// @Summary Get film by external ID
+// @Description Retrieves the film with an ID in another catalogue, as GET /films/{id} does.
+// @Tags external-ids
+// @Produce json
+// @Param provider path string true "imdb, tmdb or wikidata"
+// @Param externalId path string true "External ID, such as tt0133093"
+// @Success 200 {object} model.ResponseFilm "Film with cast"
+// @Failure 400 {string} string "Unknown provider or invalid ID"
+// @Failure 404 {string} string "Film not found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /films/by-external/{provider}/{externalId} [get]
+func (h *ExternalIDHandler) FindFilm(w http.ResponseWriter, r *http.Request) {
+	film, err := h.externalUsecase.FindFilm(r.Context(), r.PathValue("provider"), r.PathValue("externalId"))
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusOK, film)
+}
+
+// GetActorExternalIDs handles the HTTP GET request to retrieve the external IDs of an actor.
+// @Summary Get actor external IDs
+// @Description Retrieves the IDs of an actor in other catalogues: imdb, tmdb and wikidata.
+// @Tags external-ids
+// @Produce json
+// @Param id path integer true "ID of the actor"
+// @Success 200 {array} model.ExternalID "External IDs"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /actors/{id}/external-ids [get]
+func (h *ExternalIDHandler) GetActorExternalIDs(w http.ResponseWriter, r *http.Request) {
+	h.getExternalIDs(w, r, model.EntityActor)
+}
+
+// SetActorExternalID handles the HTTP PUT request to set the external ID of an actor with a provider.
+// @Summary Set actor external ID
+// @Description Sets or replaces the ID of an actor with a provider: an IMDb name ID such as nm0000206, a TMDb person ID or a Wikidata item such as Q43416.
+// @Tags external-ids
+// @Accept json
+// @Produce json
+// @Param id path integer true "ID of the actor"
+// @Param provider path string true "imdb, tmdb or wikidata"
+// @Param externalId body model.ExternalIDRequest true "External ID"
+// @Success 200 {object} model.ExternalID "External ID"
+// @Failure 400 {string} string "Unknown provider or invalid ID"
+// @Failure 404 {string} string "Actor not found"
+// @Failure 409 {string} string "ID belongs to another actor"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /actors/{id}/external-ids/{provider} [put]
+func (h *ExternalIDHandler) SetActorExternalID(w http.ResponseWriter, r *http.Request) {
+	h.setExternalID(w, r, model.EntityActor)
+}
+
+// DeleteActorExternalID handles the HTTP DELETE request to remove the external ID of an actor with a provider.
+// @Summary Delete actor external ID
+// @Description Removes the ID of an actor with a provider.
+// @Tags external-ids
+// @Produce json
+// @Param id path integer true "ID of the actor"
+// @Param provider path string true "imdb, tmdb or wikidata"
+// @Success 200 {string} string "External ID deleted"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "External ID not found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /actors/{id}/external-ids/{provider} [delete]
+func (h *ExternalIDHandler) DeleteActorExternalID(w http.ResponseWriter, r *http.Request) {
+	h.deleteExternalID(w, r, model.EntityActor)
+}
+
+// FindActor handles the HTTP GET request to retrieve an actor by their external ID.
+// @Summary Get actor by external ID
+// @Description Retrieves the actor with an ID in another catalogue, with their films.
+// @Tags external-ids
+// @Produce json
+// @Param provider path string true "imdb, tmdb or wikidata"
+// @Param externalId path string true "External ID, such as nm0000206"
+// @Success 200 {object} model.ResponseActor "Actor with films"
+// @Failure 400 {string} string "Unknown provider or invalid ID"
+// @Failure 404 {string} string "Actor not found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /actors/by-external/{provider}/{externalId} [get]
+func (h *ExternalIDHandler) FindActor(w http.ResponseWriter, r *http.Request) {
+	actor, err := h.externalUsecase.FindActor(r.Context(), r.PathValue("provider"), r.PathValue("externalId"))
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusOK, actor)
+}
+
+func (h *ExternalIDHandler) getExternalIDs(w http.ResponseWriter, r *http.Request, entity string) {
+	id, ok := h.id(w, r)
+	if !ok {
+		return
+	}
+
+	ids, err := h.externalUsecase.GetExternalIDs(r.Context(), entity, id)
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusOK, ids)
+}
+
+func (h *ExternalIDHandler) setExternalID(w http.ResponseWriter, r *http.Request, entity string) {
+	id, ok := h.id(w, r)
+	if !ok {
+		return
+	}
+
+	var req model.ExternalIDRequest
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Corrupted request body", h.logger)
+		return
+	}
+
+	v := validator.New()
+	if err := v.Struct(req); err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Invalid request", h.logger)
+		return
+	}
+
+	externalID, err := h.externalUsecase.SetExternalID(r.Context(), entity, id, r.PathValue("provider"), req)
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusOK, externalID)
+}
+
+func (h *ExternalIDHandler) deleteExternalID(w http.ResponseWriter, r *http.Request, entity string) {
+	id, ok := h.id(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.externalUsecase.DeleteExternalID(r.Context(), entity, id, r.PathValue("provider")); err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusOK, response.NIL())
+}
+
+// id reads the film or actor ID from the path.
+func (h *ExternalIDHandler) id(w http.ResponseWriter, r *http.Request) (uint64, bool) {
+	id, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, response.InvalidURLParameter, h.logger)
+		return 0, false
+	}
+	return id, true
+}
+
+// usecaseError maps errors returned by the external ID usecase onto HTTP responses.
+func (h *ExternalIDHandler) usecaseError(w http.ResponseWriter, err error) {
+	var notFound *model.ErrNotFound
+	var conflict *model.ErrConflict
+	var badRequest *model.ErrBadRequest
+
+	switch {
+	case errors.As(err, &notFound):
+		response.ErrorResponse(w, http.StatusNotFound, notFound.Error(), h.logger)
+	case errors.As(err, &conflict):
+		response.ErrorResponse(w, http.StatusConflict, conflict.Error(), h.logger)
+	case errors.As(err, &badRequest):
+		response.ErrorResponse(w, http.StatusBadRequest, badRequest.Error(), h.logger)
+	default:
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusInternalServerError, "Internal server error", h.logger)
+	}
+}
@@ -0,0 +1,30 @@
+package externalid
+
+import (
+	"context"
+
+	"films_library/internal/model"
+)
+
+type (
+	// Usecase keeps the IDs films and actors have in other catalogues and
+	// finds films and actors by them. Entities are model.EntityFilm or
+	// model.EntityActor.
+	Usecase interface {
+		GetExternalIDs(ctx context.Context, entity string, id uint64) ([]model.ExternalID, error)
+		// SetExternalID sets or replaces the ID of an entity with a provider.
+		SetExternalID(ctx context.Context, entity string, id uint64, provider string, req model.ExternalIDRequest) (model.ExternalID, error)
+		DeleteExternalID(ctx context.Context, entity string, id uint64, provider string) error
+
+		FindFilm(ctx context.Context, provider, externalID string) (model.ResponseFilm, error)
+		FindActor(ctx context.Context, provider, externalID string) (model.ResponseActor, error)
+	}
+
+	Repository interface {
+		GetExternalIDs(ctx context.Context, entity string, id uint64) ([]model.ExternalID, error)
+		SetExternalID(ctx context.Context, entity string, id uint64, externalID model.ExternalID) (model.ExternalID, error)
+		DeleteExternalID(ctx context.Context, entity string, id uint64, provider string) error
+		// FindEntity returns the ID of the entity with an external ID.
+		FindEntity(ctx context.Context, entity, provider, externalID string) (uint64, error)
+	}
+)
@@ -0,0 +1,192 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/externalid/externalid.go
+
+// Package mock_externalid is a generated GoMock package.
+package mock_externalid
+
+import (
+	context "context"
+	model "films_library/internal/model"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUsecase is a mock of Usecase interface.
+type MockUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseMockRecorder
+}
+
+// MockUsecaseMockRecorder is the mock recorder for MockUsecase.
+type MockUsecaseMockRecorder struct {
+	mock *MockUsecase
+}
+
+// NewMockUsecase creates a new mock instance.
+func NewMockUsecase(ctrl *gomock.Controller) *MockUsecase {
+	mock := &MockUsecase{ctrl: ctrl}
+	mock.recorder = &MockUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
+	return m.recorder
+}
+
+// DeleteExternalID mocks base method.
+func (m *MockUsecase) DeleteExternalID(ctx context.Context, entity string, id uint64, provider string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExternalID", ctx, entity, id, provider)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteExternalID indicates an expected call of DeleteExternalID.
+func (mr *MockUsecaseMockRecorder) DeleteExternalID(ctx, entity, id, provider interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExternalID", reflect.TypeOf((*MockUsecase)(nil).DeleteExternalID), ctx, entity, id, provider)
+}
+
+// FindActor mocks base method.
+func (m *MockUsecase) FindActor(ctx context.Context, provider, externalID string) (model.ResponseActor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActor", ctx, provider, externalID)
+	ret0, _ := ret[0].(model.ResponseActor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindActor indicates an expected call of FindActor.
+func (mr *MockUsecaseMockRecorder) FindActor(ctx, provider, externalID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActor", reflect.TypeOf((*MockUsecase)(nil).FindActor), ctx, provider, externalID)
+}
+
+// FindFilm mocks base method.
+func (m *MockUsecase) FindFilm(ctx context.Context, provider, externalID string) (model.ResponseFilm, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindFilm", ctx, provider, externalID)
+	ret0, _ := ret[0].(model.ResponseFilm)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindFilm indicates an expected call of FindFilm.
+func (mr *MockUsecaseMockRecorder) FindFilm(ctx, provider, externalID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFilm", reflect.TypeOf((*MockUsecase)(nil).FindFilm), ctx, provider, externalID)
+}
+
+// GetExternalIDs mocks base method.
+func (m *MockUsecase) GetExternalIDs(ctx context.Context, entity string, id uint64) ([]model.ExternalID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExternalIDs", ctx, entity, id)
+	ret0, _ := ret[0].([]model.ExternalID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExternalIDs indicates an expected call of GetExternalIDs.
+func (mr *MockUsecaseMockRecorder) GetExternalIDs(ctx, entity, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExternalIDs", reflect.TypeOf((*MockUsecase)(nil).GetExternalIDs), ctx, entity, id)
+}
+
+// SetExternalID mocks base method.
+func (m *MockUsecase) SetExternalID(ctx context.Context, entity string, id uint64, provider string, req model.ExternalIDRequest) (model.ExternalID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetExternalID", ctx, entity, id, provider, req)
+	ret0, _ := ret[0].(model.ExternalID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetExternalID indicates an expected call of SetExternalID.
+func (mr *MockUsecaseMockRecorder) SetExternalID(ctx, entity, id, provider, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetExternalID", reflect.TypeOf((*MockUsecase)(nil).SetExternalID), ctx, entity, id, provider, req)
+}
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteExternalID mocks base method.
+func (m *MockRepository) DeleteExternalID(ctx context.Context, entity string, id uint64, provider string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExternalID", ctx, entity, id, provider)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteExternalID indicates an expected call of DeleteExternalID.
+func (mr *MockRepositoryMockRecorder) DeleteExternalID(ctx, entity, id, provider interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExternalID", reflect.TypeOf((*MockRepository)(nil).DeleteExternalID), ctx, entity, id, provider)
+}
+
+// FindEntity mocks base method.
+func (m *MockRepository) FindEntity(ctx context.Context, entity, provider, externalID string) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindEntity", ctx, entity, provider, externalID)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindEntity indicates an expected call of FindEntity.
+func (mr *MockRepositoryMockRecorder) FindEntity(ctx, entity, provider, externalID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindEntity", reflect.TypeOf((*MockRepository)(nil).FindEntity), ctx, entity, provider, externalID)
+}
+
+// GetExternalIDs mocks base method.
+func (m *MockRepository) GetExternalIDs(ctx context.Context, entity string, id uint64) ([]model.ExternalID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExternalIDs", ctx, entity, id)
+	ret0, _ := ret[0].([]model.ExternalID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExternalIDs indicates an expected call of GetExternalIDs.
+func (mr *MockRepositoryMockRecorder) GetExternalIDs(ctx, entity, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExternalIDs", reflect.TypeOf((*MockRepository)(nil).GetExternalIDs), ctx, entity, id)
+}
+
+// SetExternalID mocks base method.
+func (m *MockRepository) SetExternalID(ctx context.Context, entity string, id uint64, externalID model.ExternalID) (model.ExternalID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetExternalID", ctx, entity, id, externalID)
+	ret0, _ := ret[0].(model.ExternalID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetExternalID indicates an expected call of SetExternalID.
+func (mr *MockRepositoryMockRecorder) SetExternalID(ctx, entity, id, externalID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetExternalID", reflect.TypeOf((*MockRepository)(nil).SetExternalID), ctx, entity, id, externalID)
+}
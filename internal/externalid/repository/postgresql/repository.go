@@ -0,0 +1,139 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"films_library/internal/model"
+	"films_library/pkg/postgres"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	foreignKeyViolation = "23503"
+	uniqueViolation     = "23505"
+)
+
+// table is where the external IDs of an entity are kept.
+type table struct {
+	name   string
+	column string
+}
+
+var tables = map[string]table{
+	model.EntityFilm:  {"film_external_id", "film_id"},
+	model.EntityActor: {"actor_external_id", "actor_id"},
+}
+
+type Repository struct {
+	db postgres.DBConn
+}
+
+func NewRepository(db postgres.DBConn) *Repository {
+	return &Repository{db}
+}
+
+func (r *Repository) GetExternalIDs(ctx context.Context, entity string, id uint64) ([]model.ExternalID, error) {
+	t, err := tableOf(entity)
+	if err != nil {
+		return nil, err
+	}
+	sqlQuery := fmt.Sprintf(`SELECT provider, external_id, created_at FROM %s WHERE %s=$1 ORDER BY provider`, t.name, t.column)
+
+	rows, err := r.db.Query(ctx, sqlQuery, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := []model.ExternalID{}
+	for rows.Next() {
+		var externalID model.ExternalID
+		if err := rows.Scan(&externalID.Provider, &externalID.ID, &externalID.CreatedAt); err != nil {
+			return nil, err
+		}
+		ids = append(ids, externalID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// SetExternalID replaces the ID the entity had with the provider, if any.
+func (r *Repository) SetExternalID(ctx context.Context, entity string, id uint64, externalID model.ExternalID) (model.ExternalID, error) {
+	t, err := tableOf(entity)
+	if err != nil {
+		return model.ExternalID{}, err
+	}
+	sqlQuery := fmt.Sprintf(`
+        INSERT INTO %[1]s (%[2]s, provider, external_id) VALUES ($1, $2, $3)
+        ON CONFLICT (%[2]s, provider) DO UPDATE SET
+            external_id = EXCLUDED.external_id,
+            created_at = CASE WHEN %[1]s.external_id = EXCLUDED.external_id THEN %[1]s.created_at ELSE now() END
+        RETURNING created_at`, t.name, t.column)
+
+	err = r.db.QueryRow(ctx, sqlQuery, id, externalID.Provider, externalID.ID).Scan(&externalID.CreatedAt)
+	if err != nil {
+		return model.ExternalID{}, externalIDError(entity, externalID, err)
+	}
+	return externalID, nil
+}
+
+func (r *Repository) DeleteExternalID(ctx context.Context, entity string, id uint64, provider string) error {
+	t, err := tableOf(entity)
+	if err != nil {
+		return err
+	}
+	sqlQuery := fmt.Sprintf(`DELETE FROM %s WHERE %s=$1 AND provider=$2`, t.name, t.column)
+
+	res, err := r.db.Exec(ctx, sqlQuery, id, provider)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return &model.ErrNotFound{Message: "external ID not found"}
+	}
+	return nil
+}
+
+func (r *Repository) FindEntity(ctx context.Context, entity, provider, externalID string) (uint64, error) {
+	t, err := tableOf(entity)
+	if err != nil {
+		return 0, err
+	}
+	sqlQuery := fmt.Sprintf(`SELECT %s FROM %s WHERE provider=$1 AND external_id=$2`, t.column, t.name)
+
+	var id uint64
+	err = r.db.QueryRow(ctx, sqlQuery, provider, externalID).Scan(&id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, &model.ErrNotFound{Message: entity + " not found"}
+	}
+	return id, err
+}
+
+func tableOf(entity string) (table, error) {
+	t, ok := tables[entity]
+	if !ok {
+		return table{}, fmt.Errorf("unknown entity %q", entity)
+	}
+	return t, nil
+}
+
+// externalIDError tells of missing entities and IDs taken by another one.
+func externalIDError(entity string, externalID model.ExternalID, err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+	switch pgErr.Code {
+	case foreignKeyViolation:
+		return &model.ErrNotFound{Message: entity + " not found"}
+	case uniqueViolation:
+		return &model.ErrConflict{Message: fmt.Sprintf("%s ID %s belongs to another %s", externalID.Provider, externalID.ID, entity)}
+	}
+	return err
+}
@@ -0,0 +1,118 @@
+package usecase
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"films_library/internal/actor"
+	"films_library/internal/externalid"
+	"films_library/internal/film"
+	"films_library/internal/model"
+	"films_library/pkg/logger"
+)
+
+var (
+	tmdbID     = regexp.MustCompile(`^[1-9][0-9]{0,9}$`)
+	wikidataID = regexp.MustCompile(`^Q[1-9][0-9]*$`)
+)
+
+// formats are the forms of the IDs each provider gives films and actors.
+var formats = map[string]map[string]*regexp.Regexp{
+	model.EntityFilm: {
+		model.ProviderIMDb:     regexp.MustCompile(`^tt[0-9]{7,}$`),
+		model.ProviderTMDb:     tmdbID,
+		model.ProviderWikidata: wikidataID,
+	},
+	model.EntityActor: {
+		model.ProviderIMDb:     regexp.MustCompile(`^nm[0-9]{7,}$`),
+		model.ProviderTMDb:     tmdbID,
+		model.ProviderWikidata: wikidataID,
+	},
+}
+
+type ExternalIDUsecase struct {
+	externalRepo externalid.Repository
+	FilmUsecase  film.Usecase
+	ActorUsecase actor.Usecase
+	logger       logger.Interface
+}
+
+func NewExternalIDUsecase(er externalid.Repository, fu film.Usecase, au actor.Usecase, l logger.Interface) *ExternalIDUsecase {
+	return &ExternalIDUsecase{er, fu, au, l}
+}
+
+func (eu *ExternalIDUsecase) GetExternalIDs(ctx context.Context, entity string, id uint64) ([]model.ExternalID, error) {
+	return eu.externalRepo.GetExternalIDs(ctx, entity, id)
+}
+
+func (eu *ExternalIDUsecase) SetExternalID(ctx context.Context, entity string, id uint64, provider string, req model.ExternalIDRequest) (model.ExternalID, error) {
+	externalID, err := normalize(entity, provider, req.ID)
+	if err != nil {
+		return model.ExternalID{}, err
+	}
+	return eu.externalRepo.SetExternalID(ctx, entity, id, model.ExternalID{Provider: provider, ID: externalID})
+}
+
+func (eu *ExternalIDUsecase) DeleteExternalID(ctx context.Context, entity string, id uint64, provider string) error {
+	if _, ok := formats[entity][provider]; !ok {
+		return &model.ErrBadRequest{Message: "unknown provider"}
+	}
+	return eu.externalRepo.DeleteExternalID(ctx, entity, id, provider)
+}
+
+func (eu *ExternalIDUsecase) FindFilm(ctx context.Context, provider, externalID string) (model.ResponseFilm, error) {
+	externalID, err := normalize(model.EntityFilm, provider, externalID)
+	if err != nil {
+		return model.ResponseFilm{}, err
+	}
+
+	id, err := eu.externalRepo.FindEntity(ctx, model.EntityFilm, provider, externalID)
+	if err != nil {
+		return model.ResponseFilm{}, err
+	}
+	return eu.FilmUsecase.GetFilm(ctx, id)
+}
+
+func (eu *ExternalIDUsecase) FindActor(ctx context.Context, provider, externalID string) (model.ResponseActor, error) {
+	externalID, err := normalize(model.EntityActor, provider, externalID)
+	if err != nil {
+		return model.ResponseActor{}, err
+	}
+
+	id, err := eu.externalRepo.FindEntity(ctx, model.EntityActor, provider, externalID)
+	if err != nil {
+		return model.ResponseActor{}, err
+	}
+
+	actors, _, err := eu.ActorUsecase.GetActors(ctx, model.ActorFilter{IDs: []uint{uint(id)}})
+	if err != nil {
+		return model.ResponseActor{}, err
+	}
+	if len(actors) == 0 {
+		return model.ResponseActor{}, &model.ErrNotFound{Message: "actor not found"}
+	}
+	return actors[0], nil
+}
+
+// normalize checks an external ID against the format of its provider, after
+// fixing the case of its prefix: tt0133093, not TT0133093, and Q83495.
+func normalize(entity, provider, externalID string) (string, error) {
+	format, ok := formats[entity][provider]
+	if !ok {
+		return "", &model.ErrBadRequest{Message: "unknown provider"}
+	}
+
+	externalID = strings.TrimSpace(externalID)
+	switch provider {
+	case model.ProviderIMDb:
+		externalID = strings.ToLower(externalID)
+	case model.ProviderWikidata:
+		externalID = strings.ToUpper(externalID)
+	}
+
+	if !format.MatchString(externalID) {
+		return "", &model.ErrBadRequest{Message: "invalid " + provider + " ID"}
+	}
+	return externalID, nil
+}
@@ -0,0 +1,107 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	mock_actor "films_library/internal/actor/mocks"
+	mock_externalid "films_library/internal/externalid/mocks"
+	mock_film "films_library/internal/film/mocks"
+	"films_library/internal/model"
+	"films_library/pkg/logger"
+	"films_library/pkg/pagination"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExternalIDUsecase_SetExternalID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	externalRepo := mock_externalid.NewMockRepository(ctrl)
+	usecase := NewExternalIDUsecase(externalRepo, mock_film.NewMockUsecase(ctrl), mock_actor.NewMockUsecase(ctrl), logger.NewMockInterface(ctrl))
+
+	ctx := context.Background()
+	cases := []struct {
+		name     string
+		entity   string
+		provider string
+		id       string
+		stored   string
+		err      bool
+	}{
+		{"IMDb title", model.EntityFilm, model.ProviderIMDb, " TT0133093 ", "tt0133093", false},
+		{"IMDb name on a film", model.EntityFilm, model.ProviderIMDb, "nm0000206", "", true},
+		{"IMDb name", model.EntityActor, model.ProviderIMDb, "nm0000206", "nm0000206", false},
+		{"Short IMDb ID", model.EntityActor, model.ProviderIMDb, "nm206", "", true},
+		{"TMDb ID", model.EntityFilm, model.ProviderTMDb, "603", "603", false},
+		{"Zero-padded TMDb ID", model.EntityFilm, model.ProviderTMDb, "0603", "", true},
+		{"Wikidata item", model.EntityActor, model.ProviderWikidata, "q43416", "Q43416", false},
+		{"Wikidata property", model.EntityFilm, model.ProviderWikidata, "P345", "", true},
+		{"Unknown provider", model.EntityFilm, "letterboxd", "the-matrix", "", true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.stored != "" {
+				externalRepo.EXPECT().SetExternalID(ctx, tc.entity, uint64(1), model.ExternalID{Provider: tc.provider, ID: tc.stored}).
+					Return(model.ExternalID{Provider: tc.provider, ID: tc.stored}, nil)
+			}
+
+			externalID, err := usecase.SetExternalID(ctx, tc.entity, 1, tc.provider, model.ExternalIDRequest{ID: tc.id})
+			if tc.err {
+				var badRequest *model.ErrBadRequest
+				assert.ErrorAs(t, err, &badRequest)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.stored, externalID.ID)
+		})
+	}
+}
+
+func TestExternalIDUsecase_FindFilm(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	externalRepo := mock_externalid.NewMockRepository(ctrl)
+	filmUsecase := mock_film.NewMockUsecase(ctrl)
+	usecase := NewExternalIDUsecase(externalRepo, filmUsecase, mock_actor.NewMockUsecase(ctrl), logger.NewMockInterface(ctrl))
+
+	ctx := context.Background()
+	film := model.ResponseFilm{Film: model.Film{ID: 7, Title: "The Matrix", ExternalIDs: &model.ExternalIDs{IMDb: "tt0133093"}}}
+
+	externalRepo.EXPECT().FindEntity(ctx, model.EntityFilm, model.ProviderIMDb, "tt0133093").Return(uint64(7), nil)
+	filmUsecase.EXPECT().GetFilm(ctx, uint64(7)).Return(film, nil)
+
+	found, err := usecase.FindFilm(ctx, model.ProviderIMDb, "tt0133093")
+	assert.NoError(t, err)
+	assert.Equal(t, film, found)
+}
+
+func TestExternalIDUsecase_FindActor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	externalRepo := mock_externalid.NewMockRepository(ctrl)
+	actorUsecase := mock_actor.NewMockUsecase(ctrl)
+	usecase := NewExternalIDUsecase(externalRepo, mock_film.NewMockUsecase(ctrl), actorUsecase, logger.NewMockInterface(ctrl))
+
+	ctx := context.Background()
+	actor := model.ResponseActor{ActorID: 3, Name: "Keanu Reeves", ExternalIDs: model.ExternalIDs{Wikidata: "Q43416"}}
+
+	externalRepo.EXPECT().FindEntity(ctx, model.EntityActor, model.ProviderWikidata, "Q43416").Return(uint64(3), nil)
+	actorUsecase.EXPECT().GetActors(ctx, model.ActorFilter{IDs: []uint{3}}).Return([]model.ResponseActor{actor}, pagination.Page{}, nil)
+
+	found, err := usecase.FindActor(ctx, model.ProviderWikidata, "Q43416")
+	assert.NoError(t, err)
+	assert.Equal(t, actor, found)
+
+	externalRepo.EXPECT().FindEntity(ctx, model.EntityActor, model.ProviderTMDb, "6384").
+		Return(uint64(0), &model.ErrNotFound{Message: "actor not found"})
+
+	_, err = usecase.FindActor(ctx, model.ProviderTMDb, "6384")
+	var notFound *model.ErrNotFound
+	assert.ErrorAs(t, err, &notFound)
+}
@@ -1,12 +1,22 @@
 package http
 
 import (
+	"bytes"
+	"errors"
+	"io"
 	"net/http"
+	"net/url"
 	"strconv"
+	"strings"
+	"time"
 
+	"films_library/internal/auth"
 	"films_library/internal/film"
 	"films_library/internal/model"
+	"films_library/pkg/export"
+	"films_library/pkg/httpserver"
 	"films_library/pkg/logger"
+	"films_library/pkg/pagination"
 	"films_library/pkg/response"
 
 	"github.com/go-playground/validator/v10"
@@ -18,25 +28,68 @@ type FilmHandler struct {
 	logger      logger.Interface
 }
 
+// FilmPermissions is the permission each film route requires.
+var FilmPermissions = auth.Permissions{
+	"GET /film":                           auth.FilmRead,
+	"POST /film/add":                      auth.FilmWrite,
+	"PUT /film/update":                    auth.FilmWrite,
+	"DELETE /film/delete":                 auth.FilmDelete,
+	"GET /film/search":                    auth.FilmRead,
+	"GET /films/{id}":                     auth.FilmRead,
+	"POST /films/{id}/actors/{actorId}":   auth.FilmWrite,
+	"PUT /films/{id}/actors/{actorId}":    auth.FilmWrite,
+	"DELETE /films/{id}/actors/{actorId}": auth.FilmWrite,
+	"POST /films/{id}/crew/{personId}":    auth.FilmWrite,
+	"DELETE /films/{id}/crew/{personId}":  auth.FilmWrite,
+	"GET /export/films":                   auth.FilmRead,
+}
+
 func NewFilmHandler(mux *http.ServeMux, fu film.Usecase, l logger.Interface) {
 	r := &FilmHandler{fu, l}
 
-	mux.HandleFunc("/film", r.GetFilms)
-	mux.HandleFunc("/film/add", r.AddFilm)
-	mux.HandleFunc("/film/update", r.UpdateFilm)
-	mux.HandleFunc("/film/delete", r.DeleteFilm)
-	mux.HandleFunc("/film/search", r.SearchFilm)
+	mux.HandleFunc("GET /film", r.GetFilms)
+	mux.HandleFunc("POST /film/add", r.AddFilm)
+	mux.HandleFunc("PUT /film/update", r.UpdateFilm)
+	mux.HandleFunc("DELETE /film/delete", r.DeleteFilm)
+	mux.HandleFunc("GET /film/search", r.SearchFilm)
+	mux.HandleFunc("GET /films/{id}", r.GetFilm)
+	mux.HandleFunc("POST /films/{id}/actors/{actorId}", r.LinkActor)
+	mux.HandleFunc("PUT /films/{id}/actors/{actorId}", r.UpdateCastRole)
+	mux.HandleFunc("DELETE /films/{id}/actors/{actorId}", r.UnlinkActor)
+	mux.HandleFunc("POST /films/{id}/crew/{personId}", r.LinkCrew)
+	mux.HandleFunc("DELETE /films/{id}/crew/{personId}", r.UnlinkCrew)
+	mux.HandleFunc("GET /export/films", r.ExportFilms)
 }
 
 // GetFilms handles the HTTP GET request to retrieve a list of films.
-// It allows optional query parameters for sorting the results.
+// It allows optional query parameters for filtering, sorting and paginating the results.
 // @Summary Get films
-// @Description Retrieves a list of films with optional sorting.
+// @Description Retrieves a page of films with optional filtering and sorting.
 // @Tags films
 // @Produce json
 // @Param sort_by query string false "Field to sort by (e.g., 'rating')"
 // @Param sort_order query string false "Sort order ('asc' for ascending or 'desc' for descending)"
+// @Param min_rating query integer false "Lowest rating, inclusive"
+// @Param max_rating query integer false "Highest rating, inclusive"
+// @Param released_after query string false "Earliest release date, inclusive (YYYY-MM-DD)"
+// @Param released_before query string false "Latest release date, inclusive (YYYY-MM-DD)"
+// @Param actor_id query []integer false "Only films starring all of these actors" collectionFormat(multi)
+// @Param title_prefix query string false "Case-insensitive title prefix"
+// @Param crew_id query []integer false "Only films crewed by all of these people" collectionFormat(multi)
+// @Param crew_role query string false "Role the crew_id people must hold (director, writer, composer, producer, cinematographer)"
+// @Param genre_id query []integer false "Only films in these genres" collectionFormat(multi)
+// @Param genre_match query string false "Whether films need 'any' (default) or 'all' of the genre_id genres"
+// @Param exclude_genre_id query []integer false "Leave out films in any of these genres" collectionFormat(multi)
+// @Param tag_id query []integer false "Only films with these tags" collectionFormat(multi)
+// @Param tag_match query string false "Whether films need 'any' (default) or 'all' of the tag_id tags"
+// @Param exclude_tag_id query []integer false "Leave out films with any of these tags" collectionFormat(multi)
+// @Param facets query boolean false "Include the number of matching films per genre"
+// @Param limit query integer false "Page size (default 20, max 100)"
+// @Param offset query integer false "Number of films to skip"
+// @Param cursor query string false "Cursor of the page to fetch, valid with the sort_by and sort_order it was issued for"
+// @Param total query boolean false "Include the total number of films"
 // @Success 200 {array} model.Film "List of films"
+// @Failure 400 {string} string "Bad Request"
 // @Failure 500 {string} string "Internal Server Error"
 // @Router /film [get]
 func (h *FilmHandler) GetFilms(w http.ResponseWriter, r *http.Request) {
@@ -52,25 +105,265 @@ func (h *FilmHandler) GetFilms(w http.ResponseWriter, r *http.Request) {
 		sortOrder = "desc"
 	}
 
-	filter := model.FilmFilter{
-		SortBy:    sortBy,
-		SortOrder: sortOrder,
+	filter, err := filmFilter(queryParams)
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Bad query param", h.logger)
+		return
+	}
+
+	params, err := pagination.ParseQuery(queryParams)
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Bad query param", h.logger)
+		return
 	}
 
+	filter.SortBy = sortBy
+	filter.SortOrder = sortOrder
+	filter.Params = params
+
 	v := validator.New()
-	if err := v.Struct(filter); err != nil {
+	v.RegisterStructValidation(model.ValidateFilmFilter, model.FilmFilter{})
+	if err := v.StructPartial(filter, "SortBy", "SortOrder"); err != nil {
 		filter.SortBy = "rating"
 		filter.SortOrder = "desc"
 	}
+	if err := v.Struct(filter); err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Invalid request", h.logger)
+		return
+	}
 
-	films, err := h.filmUsecase.GetFilms(r.Context(), filter)
+	films, page, err := h.filmUsecase.GetFilms(r.Context(), filter)
 	if err != nil {
 		h.logger.Error(err)
 		response.ErrorResponse(w, http.StatusInternalServerError, "Internal server error", h.logger)
 		return
 	}
 
-	response.SuccessResponse(w, http.StatusOK, films)
+	if withFacets, _ := strconv.ParseBool(queryParams.Get("facets")); withFacets {
+		facets, err := h.filmUsecase.GetFilmFacets(r.Context(), filter)
+		if err != nil {
+			h.logger.Error(err)
+			response.ErrorResponse(w, http.StatusInternalServerError, "Internal server error", h.logger)
+			return
+		}
+		response.FacetedPageResponse(w, http.StatusOK, films, page, facets)
+		return
+	}
+
+	response.PageResponse(w, http.StatusOK, films, page)
+}
+
+// filmFilter reads the filtering query parameters of a film listing.
+func filmFilter(queryParams url.Values) (model.FilmFilter, error) {
+	var filter model.FilmFilter
+
+	for param, dst := range map[string]**int{
+		"min_rating": &filter.MinRating,
+		"max_rating": &filter.MaxRating,
+	} {
+		if v := queryParams.Get(param); v != "" {
+			rating, err := strconv.Atoi(v)
+			if err != nil {
+				return model.FilmFilter{}, err
+			}
+			*dst = &rating
+		}
+	}
+
+	for param, dst := range map[string]**time.Time{
+		"released_after":  &filter.ReleasedAfter,
+		"released_before": &filter.ReleasedBefore,
+	} {
+		if v := queryParams.Get(param); v != "" {
+			date, err := time.Parse(time.DateOnly, v)
+			if err != nil {
+				return model.FilmFilter{}, err
+			}
+			*dst = &date
+		}
+	}
+
+	for _, v := range queryParams["actor_id"] {
+		for _, idParam := range strings.Split(v, ",") {
+			id, err := strconv.ParseUint(idParam, 10, 64)
+			if err != nil {
+				return model.FilmFilter{}, err
+			}
+			filter.ActorIDs = append(filter.ActorIDs, uint(id))
+		}
+	}
+
+	for param, dst := range map[string]*[]uint64{
+		"crew_id":          &filter.CrewIDs,
+		"genre_id":         &filter.GenreIDs,
+		"exclude_genre_id": &filter.ExcludeGenreIDs,
+		"tag_id":           &filter.TagIDs,
+		"exclude_tag_id":   &filter.ExcludeTagIDs,
+	} {
+		for _, v := range queryParams[param] {
+			for _, idParam := range strings.Split(v, ",") {
+				id, err := strconv.ParseUint(idParam, 10, 64)
+				if err != nil {
+					return model.FilmFilter{}, err
+				}
+				*dst = append(*dst, id)
+			}
+		}
+	}
+
+	filter.TitlePrefix = queryParams.Get("title_prefix")
+	filter.CrewRole = queryParams.Get("crew_role")
+	filter.GenreMatch = queryParams.Get("genre_match")
+	filter.TagMatch = queryParams.Get("tag_match")
+
+	return filter, nil
+}
+
+// filmHeader is the CSV header of a film export.
+var filmHeader = []string{
+	"film_id", "title", "description", "release_date", "rating",
+	"community_score", "rating_count", "copies", "available", "actors",
+}
+
+// filmRow is a film export row.
+type filmRow struct {
+	model.ExportFilm
+}
+
+func (f filmRow) Record() []string {
+	actors := make([]string, 0, len(f.Actors))
+	for _, id := range f.Actors {
+		actors = append(actors, strconv.FormatUint(uint64(id), 10))
+	}
+	return []string{
+		strconv.FormatUint(f.ID, 10),
+		f.Title,
+		f.Description,
+		f.ReleaseDate.Format(time.DateOnly),
+		strconv.Itoa(f.Rating),
+		strconv.FormatFloat(f.CommunityScore, 'f', -1, 64),
+		strconv.FormatInt(f.RatingCount, 10),
+		strconv.FormatInt(f.Availability.Copies, 10),
+		strconv.FormatInt(f.Availability.Available, 10),
+		strings.Join(actors, ";"),
+	}
+}
+
+// ExportFilms handles the HTTP GET request to export the catalogue.
+// @Summary Export films
+// @Description Streams every film matching the filters of the film listing as a download, without pagination. Films come in film ID order unless sort_by is given. CSV rows list actor IDs separated by semicolons.
+// @Tags export
+// @Produce text/csv
+// @Produce application/x-ndjson
+// @Produce json
+// @Param format query string false "csv (default), ndjson or json"
+// @Param sort_by query string false "Field to sort by (rating, release_date or title)"
+// @Param sort_order query string false "Sort order ('asc' (default) or 'desc')"
+// @Param min_rating query integer false "Lowest rating, inclusive"
+// @Param max_rating query integer false "Highest rating, inclusive"
+// @Param released_after query string false "Earliest release date, inclusive (YYYY-MM-DD)"
+// @Param released_before query string false "Latest release date, inclusive (YYYY-MM-DD)"
+// @Param actor_id query []integer false "Only films starring all of these actors" collectionFormat(multi)
+// @Param title_prefix query string false "Case-insensitive title prefix"
+// @Param crew_id query []integer false "Only films crewed by all of these people" collectionFormat(multi)
+// @Param crew_role query string false "Role the crew_id people must hold (director, writer, composer, producer, cinematographer)"
+// @Param genre_id query []integer false "Only films in these genres" collectionFormat(multi)
+// @Param genre_match query string false "Whether films need 'any' (default) or 'all' of the genre_id genres"
+// @Param exclude_genre_id query []integer false "Leave out films in any of these genres" collectionFormat(multi)
+// @Param tag_id query []integer false "Only films with these tags" collectionFormat(multi)
+// @Param tag_match query string false "Whether films need 'any' (default) or 'all' of the tag_id tags"
+// @Param exclude_tag_id query []integer false "Leave out films with any of these tags" collectionFormat(multi)
+// @Success 200 {array} model.ExportFilm "Films"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /export/films [get]
+func (h *FilmHandler) ExportFilms(w http.ResponseWriter, r *http.Request) {
+	queryParams := r.URL.Query()
+
+	format := queryParams.Get("format")
+	if format == "" {
+		format = export.CSV
+	}
+	ew, err := export.NewWriter(w, format, filmHeader)
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Bad query param", h.logger)
+		return
+	}
+
+	filter, err := filmFilter(queryParams)
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Bad query param", h.logger)
+		return
+	}
+
+	filter.SortBy = queryParams.Get("sort_by")
+	filter.SortOrder = queryParams.Get("sort_order")
+	if filter.SortOrder == "" {
+		filter.SortOrder = "asc"
+	}
+
+	v := validator.New()
+	v.RegisterStructValidation(model.ValidateFilmFilter, model.FilmFilter{})
+	if filter.SortBy == "" {
+		err = v.StructExcept(filter, "SortBy")
+	} else {
+		err = v.Struct(filter)
+	}
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Invalid request", h.logger)
+		return
+	}
+
+	// Exports stream the whole catalogue, for longer than the server write
+	// timeout allows.
+	if err := httpserver.LiftDeadlines(w); err != nil {
+		h.logger.Error(err)
+	}
+
+	export.Attach(w, "films", format)
+	err = h.filmUsecase.ExportFilms(r.Context(), filter, func(film model.ExportFilm) error {
+		return ew.Write(filmRow{film})
+	})
+	if err == nil {
+		err = ew.Close()
+	}
+	if err != nil {
+		export.Fail(w, ew, err, h.logger)
+	}
+}
+
+// GetFilm handles the HTTP GET request to retrieve a single film with its cast.
+// @Summary Get film
+// @Description Retrieves a film by ID together with its cast, crew, genres and tags.
+// @Tags films
+// @Produce json
+// @Param id path integer true "ID of the film"
+// @Success 200 {object} model.ResponseFilm "Film with cast"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Film not found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /films/{id} [get]
+func (h *FilmHandler) GetFilm(w http.ResponseWriter, r *http.Request) {
+	filmId, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, response.InvalidURLParameter, h.logger)
+		return
+	}
+
+	film, err := h.filmUsecase.GetFilm(r.Context(), filmId)
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusOK, film)
 }
 
 // AddFilm handles the HTTP POST request to add a new film.
@@ -82,6 +375,7 @@ func (h *FilmHandler) GetFilms(w http.ResponseWriter, r *http.Request) {
 // @Param film body model.AddFilmRequest true "Film object to be added"
 // @Success 201 {string} string "ID of the newly added film"
 // @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Actor not found"
 // @Failure 500 {string} string "Internal Server Error"
 // @Router /film/add [post]
 func (h *FilmHandler) AddFilm(w http.ResponseWriter, r *http.Request) {
@@ -101,8 +395,7 @@ func (h *FilmHandler) AddFilm(w http.ResponseWriter, r *http.Request) {
 
 	id, err := h.filmUsecase.AddFilm(r.Context(), film)
 	if err != nil {
-		h.logger.Error(err)
-		response.ErrorResponse(w, http.StatusInternalServerError, "Internal server error", h.logger)
+		h.usecaseError(w, err)
 		return
 	}
 	response.SuccessResponse(w, http.StatusCreated, id)
@@ -114,13 +407,14 @@ func (h *FilmHandler) AddFilm(w http.ResponseWriter, r *http.Request) {
 // @Tags films
 // @Accept json
 // @Produce json
-// @Param film body model.Film true "Film object to be updated"
+// @Param film body model.UpdateFilmRequest true "Film object to be updated"
 // @Success 200 {string} string "ID of the updated film"
 // @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Film or actor not found"
 // @Failure 500 {string} string "Internal Server Error"
 // @Router /film/update [put]
 func (h *FilmHandler) UpdateFilm(w http.ResponseWriter, r *http.Request) {
-	var film model.Film
+	var film model.UpdateFilmRequest
 	if err := easyjson.UnmarshalFromReader(r.Body, &film); err != nil {
 		h.logger.Error(err)
 		response.ErrorResponse(w, http.StatusBadRequest, "Corrupted request body", h.logger)
@@ -136,8 +430,7 @@ func (h *FilmHandler) UpdateFilm(w http.ResponseWriter, r *http.Request) {
 
 	id, err := h.filmUsecase.UpdateFilm(r.Context(), film)
 	if err != nil {
-		h.logger.Error(err)
-		response.ErrorResponse(w, http.StatusInternalServerError, "Internal server error", h.logger)
+		h.usecaseError(w, err)
 		return
 	}
 	response.SuccessResponse(w, http.StatusOK, id)
@@ -176,13 +469,16 @@ func (h *FilmHandler) DeleteFilm(w http.ResponseWriter, r *http.Request) {
 	response.SuccessResponse(w, http.StatusOK, id)
 }
 
-// SearchFilm handles the HTTP GET request to search for films by title.
+// SearchFilm handles the HTTP GET request to search for films by title, description or cast.
 // @Summary Search film
-// @Description Searches for films by title.
+// @Description Searches for films by title, description or cast, tolerating typos in the title.
 // @Tags films
 // @Produce json
 // @Param search query string true "Title to search for"
-// @Success 200 {object} model.Film "Found film"
+// @Param limit query integer false "Page size (default 20, max 100)"
+// @Param offset query integer false "Number of films to skip"
+// @Param cursor query string false "Cursor of the page to fetch"
+// @Success 200 {array} model.Film "Found films"
 // @Failure 400 {string} string "Bad Request"
 // @Failure 500 {string} string "Internal Server Error"
 // @Router /film/search [get]
@@ -196,11 +492,242 @@ func (h *FilmHandler) SearchFilm(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	film, err := h.filmUsecase.SearchFilm(r.Context(), search)
+	params, err := pagination.ParseQuery(queryParams)
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Bad query param", h.logger)
+		return
+	}
+
+	films, page, err := h.filmUsecase.SearchFilm(r.Context(), model.SearchFilter{Search: search, Params: params})
 	if err != nil {
 		h.logger.Error(err)
 		response.ErrorResponse(w, http.StatusInternalServerError, "Internal server error", h.logger)
 		return
 	}
-	response.SuccessResponse(w, http.StatusOK, film)
+	response.PageResponse(w, http.StatusOK, films, page)
+}
+
+// LinkActor handles the HTTP POST request to add an actor to the cast of a film.
+// @Summary Link actor to film
+// @Description Adds an existing actor to the cast of an existing film.
+// @Description The optional body sets the characters played, the billing position and the uncredited/voice/cameo flags.
+// @Tags films
+// @Accept json
+// @Produce json
+// @Param id path integer true "ID of the film"
+// @Param actorId path integer true "ID of the actor"
+// @Param role body model.CastRole false "Role in the film"
+// @Success 201 {string} string "Actor linked"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Film or actor not found"
+// @Failure 409 {string} string "Actor already linked"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /films/{id}/actors/{actorId} [post]
+func (h *FilmHandler) LinkActor(w http.ResponseWriter, r *http.Request) {
+	filmId, actorId, err := filmActorParams(r)
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, response.InvalidURLParameter, h.logger)
+		return
+	}
+
+	role, ok := h.castRole(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.filmUsecase.LinkActor(r.Context(), filmId, actorId, role); err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+	response.SuccessResponse(w, http.StatusCreated, response.NIL())
+}
+
+// UpdateCastRole handles the HTTP PUT request to edit the role of an actor in a film.
+// @Summary Update cast role
+// @Description Replaces the characters, billing position and flags of an actor in the cast of a film.
+// @Tags films
+// @Accept json
+// @Produce json
+// @Param id path integer true "ID of the film"
+// @Param actorId path integer true "ID of the actor"
+// @Param role body model.CastRole true "Role in the film"
+// @Success 200 {string} string "Role updated"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Actor is not linked to film"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /films/{id}/actors/{actorId} [put]
+func (h *FilmHandler) UpdateCastRole(w http.ResponseWriter, r *http.Request) {
+	filmId, actorId, err := filmActorParams(r)
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, response.InvalidURLParameter, h.logger)
+		return
+	}
+
+	role, ok := h.castRole(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.filmUsecase.UpdateCastRole(r.Context(), filmId, actorId, role); err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+	response.SuccessResponse(w, http.StatusOK, response.NIL())
+}
+
+// castRole decodes and validates an optional cast role from the request body.
+func (h *FilmHandler) castRole(w http.ResponseWriter, r *http.Request) (model.CastRole, bool) {
+	var role model.CastRole
+
+	body, err := io.ReadAll(r.Body)
+	if err == nil && len(bytes.TrimSpace(body)) > 0 {
+		err = easyjson.Unmarshal(body, &role)
+	}
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Corrupted request body", h.logger)
+		return model.CastRole{}, false
+	}
+
+	v := validator.New()
+	if err := v.Struct(role); err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Invalid request", h.logger)
+		return model.CastRole{}, false
+	}
+	return role, true
+}
+
+// UnlinkActor handles the HTTP DELETE request to remove an actor from the cast of a film.
+// @Summary Unlink actor from film
+// @Description Removes an actor from the cast of a film.
+// @Tags films
+// @Produce json
+// @Param id path integer true "ID of the film"
+// @Param actorId path integer true "ID of the actor"
+// @Success 200 {string} string "Actor unlinked"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Actor is not linked to film"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /films/{id}/actors/{actorId} [delete]
+func (h *FilmHandler) UnlinkActor(w http.ResponseWriter, r *http.Request) {
+	filmId, actorId, err := filmActorParams(r)
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, response.InvalidURLParameter, h.logger)
+		return
+	}
+
+	if err := h.filmUsecase.UnlinkActor(r.Context(), filmId, actorId); err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+	response.SuccessResponse(w, http.StatusOK, response.NIL())
+}
+
+// LinkCrew handles the HTTP POST request to credit a person on the crew of a film.
+// @Summary Link crew to film
+// @Description Credits a person on the crew of a film in the given role.
+// @Tags films
+// @Produce json
+// @Param id path integer true "ID of the film"
+// @Param personId path integer true "ID of the person"
+// @Param role query string true "Role on the film (director, writer, composer, producer, cinematographer)"
+// @Success 201 {string} string "Person credited"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Film or person not found"
+// @Failure 409 {string} string "Person is already credited in this role"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /films/{id}/crew/{personId} [post]
+func (h *FilmHandler) LinkCrew(w http.ResponseWriter, r *http.Request) {
+	filmId, personId, role, err := filmCrewParams(r)
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, response.InvalidURLParameter, h.logger)
+		return
+	}
+
+	if err := h.filmUsecase.LinkCrew(r.Context(), filmId, personId, role); err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+	response.SuccessResponse(w, http.StatusCreated, response.NIL())
+}
+
+// UnlinkCrew handles the HTTP DELETE request to remove a crew credit from a film.
+// @Summary Unlink crew from film
+// @Description Removes the credit of a person in the given role from a film.
+// @Tags films
+// @Produce json
+// @Param id path integer true "ID of the film"
+// @Param personId path integer true "ID of the person"
+// @Param role query string true "Role on the film (director, writer, composer, producer, cinematographer)"
+// @Success 200 {string} string "Credit removed"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Person is not credited in this role"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /films/{id}/crew/{personId} [delete]
+func (h *FilmHandler) UnlinkCrew(w http.ResponseWriter, r *http.Request) {
+	filmId, personId, role, err := filmCrewParams(r)
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, response.InvalidURLParameter, h.logger)
+		return
+	}
+
+	if err := h.filmUsecase.UnlinkCrew(r.Context(), filmId, personId, role); err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+	response.SuccessResponse(w, http.StatusOK, response.NIL())
+}
+
+func filmCrewParams(r *http.Request) (uint64, uint64, string, error) {
+	filmId, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+	if err != nil {
+		return 0, 0, "", err
+	}
+
+	personId, err := strconv.ParseUint(r.PathValue("personId"), 10, 64)
+	if err != nil {
+		return 0, 0, "", err
+	}
+
+	role := r.URL.Query().Get("role")
+	if err := validator.New().Var(role, "required,oneof=director writer composer producer cinematographer"); err != nil {
+		return 0, 0, "", err
+	}
+	return filmId, personId, role, nil
+}
+
+func filmActorParams(r *http.Request) (uint64, uint, error) {
+	filmId, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	actorId, err := strconv.ParseUint(r.PathValue("actorId"), 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	return filmId, uint(actorId), nil
+}
+
+// usecaseError maps errors returned by the film usecase onto HTTP responses.
+func (h *FilmHandler) usecaseError(w http.ResponseWriter, err error) {
+	var notFound *model.ErrNotFound
+	var conflict *model.ErrConflict
+
+	switch {
+	case errors.As(err, &notFound):
+		response.ErrorResponse(w, http.StatusNotFound, notFound.Error(), h.logger)
+	case errors.As(err, &conflict):
+		response.ErrorResponse(w, http.StatusConflict, conflict.Error(), h.logger)
+	default:
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusInternalServerError, "Internal server error", h.logger)
+	}
 }
@@ -1,14 +1,17 @@
 package http
 
 import (
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	mock_film "films_library/internal/film/mocks"
 	"films_library/internal/model"
 	"films_library/pkg/logger"
+	"films_library/pkg/pagination"
 
 	"github.com/golang/mock/gomock"
 	"gopkg.in/go-playground/assert.v1"
@@ -22,34 +25,164 @@ func TestFilmHandler_GetFilms(t *testing.T) {
 		expectedBody  string
 		mockUsecaseFn func(*mock_film.MockUsecase)
 		queryParams   map[string]string
+		badRequest    bool
 	}{
 		{
 			name:         "Successful call to GetFilms with null query",
 			expectedCode: http.StatusOK,
-			expectedBody: `{"status":200,"body":[{"film_id":1,"title":"Forest Gamp","description":"...","release_date":"0001-01-01T00:00:00Z","rating":10}]}`,
+			expectedBody: `{"status":200,"body":[{"film_id":1,"title":"Forest Gamp","description":"...","release_date":"0001-01-01T00:00:00Z","rating":10,"community_score":0,"rating_count":0}]}`,
 			mockUsecaseFn: func(mockUsecase *mock_film.MockUsecase) {
-				mockUsecase.EXPECT().GetFilms(gomock.Any(), gomock.Any()).Return(MockResponse, nil)
+				mockUsecase.EXPECT().GetFilms(gomock.Any(), gomock.Any()).Return(MockResponse, pagination.Page{}, nil)
 			},
 			queryParams: map[string]string{},
 		},
 		{
 			name:         "Successful rating query",
 			expectedCode: http.StatusOK,
-			expectedBody: `{"status":200,"body":[{"film_id":1,"title":"Forest Gamp","description":"...","release_date":"0001-01-01T00:00:00Z","rating":10}]}`,
+			expectedBody: `{"status":200,"body":[{"film_id":1,"title":"Forest Gamp","description":"...","release_date":"0001-01-01T00:00:00Z","rating":10,"community_score":0,"rating_count":0}]}`,
 			mockUsecaseFn: func(mockUsecase *mock_film.MockUsecase) {
-				mockUsecase.EXPECT().GetFilms(gomock.Any(), gomock.Any()).Return(MockResponse, nil)
+				mockUsecase.EXPECT().GetFilms(gomock.Any(), gomock.Any()).Return(MockResponse, pagination.Page{}, nil)
 			},
 			queryParams: map[string]string{"sort_by": "rating", "sort_order": "desc"},
 		},
 		{
 			name:         "Successful rating query",
 			expectedCode: http.StatusOK,
-			expectedBody: `{"status":200,"body":[{"film_id":1,"title":"Forest Gamp","description":"...","release_date":"0001-01-01T00:00:00Z","rating":10}]}`,
+			expectedBody: `{"status":200,"body":[{"film_id":1,"title":"Forest Gamp","description":"...","release_date":"0001-01-01T00:00:00Z","rating":10,"community_score":0,"rating_count":0}]}`,
 			mockUsecaseFn: func(mockUsecase *mock_film.MockUsecase) {
-				mockUsecase.EXPECT().GetFilms(gomock.Any(), gomock.Any()).Return(MockResponse, nil)
+				mockUsecase.EXPECT().GetFilms(gomock.Any(), gomock.Any()).Return(MockResponse, pagination.Page{}, nil)
 			},
 			queryParams: map[string]string{"sort_by": "rating", "sort_order": "desc"},
 		},
+		{
+			name:         "Page with next cursor",
+			expectedCode: http.StatusOK,
+			expectedBody: `{"status":200,"body":[{"film_id":1,"title":"Forest Gamp","description":"...","release_date":"0001-01-01T00:00:00Z","rating":10,"community_score":0,"rating_count":0}],"page":{"next_cursor":"abc"}}`,
+			mockUsecaseFn: func(mockUsecase *mock_film.MockUsecase) {
+				mockUsecase.EXPECT().GetFilms(gomock.Any(), gomock.Any()).Return(MockResponse, pagination.Page{Next: "abc"}, nil)
+			},
+			queryParams: map[string]string{"limit": "1"},
+		},
+		{
+			name:         "Filtered query",
+			expectedCode: http.StatusOK,
+			expectedBody: `{"status":200,"body":[{"film_id":1,"title":"Forest Gamp","description":"...","release_date":"0001-01-01T00:00:00Z","rating":10,"community_score":0,"rating_count":0}]}`,
+			mockUsecaseFn: func(mockUsecase *mock_film.MockUsecase) {
+				minRating := 7
+				releasedAfter := time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC)
+				mockUsecase.EXPECT().GetFilms(gomock.Any(), model.FilmFilter{
+					SortBy:        "title",
+					SortOrder:     "asc",
+					MinRating:     &minRating,
+					ReleasedAfter: &releasedAfter,
+					ActorIDs:      []uint{3, 4},
+					TitlePrefix:   "For",
+					Params:        pagination.Params{Limit: pagination.DefaultLimit, Sort: "title asc"},
+				}).Return(MockResponse, pagination.Page{}, nil)
+			},
+			queryParams: map[string]string{
+				"sort_by":        "title",
+				"sort_order":     "asc",
+				"min_rating":     "7",
+				"released_after": "1990-01-01",
+				"actor_id":       "3,4",
+				"title_prefix":   "For",
+			},
+		},
+		{
+			name:         "Crew query",
+			expectedCode: http.StatusOK,
+			expectedBody: `{"status":200,"body":[{"film_id":1,"title":"Forest Gamp","description":"...","release_date":"0001-01-01T00:00:00Z","rating":10,"community_score":0,"rating_count":0}]}`,
+			mockUsecaseFn: func(mockUsecase *mock_film.MockUsecase) {
+				mockUsecase.EXPECT().GetFilms(gomock.Any(), model.FilmFilter{
+					SortBy:    "rating",
+					SortOrder: "desc",
+					CrewIDs:   []uint64{5},
+					CrewRole:  model.CrewDirector,
+					Params:    pagination.Params{Limit: pagination.DefaultLimit},
+				}).Return(MockResponse, pagination.Page{}, nil)
+			},
+			queryParams: map[string]string{"crew_id": "5", "crew_role": "director"},
+		},
+		{
+			name:         "Genre query with facets",
+			expectedCode: http.StatusOK,
+			expectedBody: `{"status":200,"body":[{"film_id":1,"title":"Forest Gamp","description":"...","release_date":"0001-01-01T00:00:00Z","rating":10,"community_score":0,"rating_count":0}],"facets":{"genres":[{"genre_id":2,"name":"Drama","films":1}]}}`,
+			mockUsecaseFn: func(mockUsecase *mock_film.MockUsecase) {
+				filter := model.FilmFilter{
+					SortBy:          "rating",
+					SortOrder:       "desc",
+					GenreIDs:        []uint64{2, 3},
+					GenreMatch:      model.MatchAll,
+					ExcludeGenreIDs: []uint64{9},
+					ExcludeTagIDs:   []uint64{1},
+					Params:          pagination.Params{Limit: pagination.DefaultLimit},
+				}
+				mockUsecase.EXPECT().GetFilms(gomock.Any(), filter).Return(MockResponse, pagination.Page{}, nil)
+				mockUsecase.EXPECT().GetFilmFacets(gomock.Any(), filter).Return(model.FilmFacets{
+					Genres: []model.GenreFacet{{GenreID: 2, Name: "Drama", Films: 1}},
+				}, nil)
+			},
+			queryParams: map[string]string{
+				"genre_id":         "2,3",
+				"genre_match":      "all",
+				"exclude_genre_id": "9",
+				"exclude_tag_id":   "1",
+				"facets":           "true",
+			},
+		},
+		{
+			name:          "Unknown genre match",
+			expectedCode:  http.StatusBadRequest,
+			expectedBody:  `{"status":400,"message":"Invalid request"}`,
+			mockUsecaseFn: func(mockUsecase *mock_film.MockUsecase) {},
+			queryParams:   map[string]string{"genre_id": "2", "genre_match": "some"},
+			badRequest:    true,
+		},
+		{
+			name:          "Crew role without crew",
+			expectedCode:  http.StatusBadRequest,
+			expectedBody:  `{"status":400,"message":"Invalid request"}`,
+			mockUsecaseFn: func(mockUsecase *mock_film.MockUsecase) {},
+			queryParams:   map[string]string{"crew_role": "director"},
+			badRequest:    true,
+		},
+		{
+			name:          "Inverted rating range",
+			expectedCode:  http.StatusBadRequest,
+			expectedBody:  `{"status":400,"message":"Invalid request"}`,
+			mockUsecaseFn: func(mockUsecase *mock_film.MockUsecase) {},
+			queryParams:   map[string]string{"min_rating": "8", "max_rating": "3"},
+			badRequest:    true,
+		},
+		{
+			name:          "Malformed release date",
+			expectedCode:  http.StatusBadRequest,
+			expectedBody:  `{"status":400,"message":"Bad query param"}`,
+			mockUsecaseFn: func(mockUsecase *mock_film.MockUsecase) {},
+			queryParams:   map[string]string{"released_before": "yesterday"},
+			badRequest:    true,
+		},
+		{
+			name:          "Invalid limit",
+			expectedCode:  http.StatusBadRequest,
+			expectedBody:  `{"status":400,"message":"Bad query param"}`,
+			mockUsecaseFn: func(mockUsecase *mock_film.MockUsecase) {},
+			queryParams:   map[string]string{"limit": "-5"},
+			badRequest:    true,
+		},
+		{
+			name:          "Cursor of another order",
+			expectedCode:  http.StatusBadRequest,
+			expectedBody:  `{"status":400,"message":"Bad query param"}`,
+			mockUsecaseFn: func(mockUsecase *mock_film.MockUsecase) {},
+			queryParams: map[string]string{
+				"sort_by":    "title",
+				"sort_order": "asc",
+				"cursor":     pagination.Encode(pagination.Cursor{Value: "8", ID: 5, Sort: "rating desc"}),
+			},
+			badRequest: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -58,6 +191,9 @@ func TestFilmHandler_GetFilms(t *testing.T) {
 			defer ctrl.Finish()
 
 			logger := logger.NewMockInterface(ctrl)
+			if tt.badRequest {
+				logger.EXPECT().Error(gomock.Any())
+			}
 			mockUsecase := mock_film.NewMockUsecase(ctrl)
 			tt.mockUsecaseFn(mockUsecase)
 
@@ -82,6 +218,248 @@ func TestFilmHandler_GetFilms(t *testing.T) {
 	}
 }
 
+func TestFilmHandler_ExportFilms(t *testing.T) {
+	film := model.ExportFilm{
+		Film: model.Film{
+			ID:           1,
+			Title:        "Forest Gamp",
+			Description:  "...",
+			ReleaseDate:  time.Date(1994, time.July, 6, 0, 0, 0, 0, time.UTC),
+			Rating:       10,
+			Availability: &model.Availability{Copies: 2, Available: 1},
+		},
+		Actors: []uint{3, 4},
+	}
+	filmJSON := `{"actors":[3,4],"film_id":1,"title":"Forest Gamp","description":"...","release_date":"1994-07-06T00:00:00Z","rating":10,"community_score":0,"rating_count":0,"availability":{"copies":2,"available":1}}`
+	streamFilms := func(mockUsecase *mock_film.MockUsecase, filter model.FilmFilter) {
+		mockUsecase.EXPECT().ExportFilms(gomock.Any(), filter, gomock.Any()).
+			DoAndReturn(func(_ interface{}, _ model.FilmFilter, fn func(model.ExportFilm) error) error {
+				if err := fn(film); err != nil {
+					return err
+				}
+				return fn(film)
+			})
+	}
+
+	tests := []struct {
+		name          string
+		expectedCode  int
+		expectedType  string
+		expectedBody  string
+		mockUsecaseFn func(*mock_film.MockUsecase)
+		queryParams   map[string]string
+		failure       bool
+	}{
+		{
+			name:         "CSV by default",
+			expectedCode: http.StatusOK,
+			expectedType: "text/csv; charset=utf-8",
+			expectedBody: "film_id,title,description,release_date,rating,community_score,rating_count,copies,available,actors\n" +
+				"1,Forest Gamp,...,1994-07-06,10,0,0,2,1,3;4\n" +
+				"1,Forest Gamp,...,1994-07-06,10,0,0,2,1,3;4",
+			mockUsecaseFn: func(mockUsecase *mock_film.MockUsecase) {
+				streamFilms(mockUsecase, model.FilmFilter{SortOrder: "asc"})
+			},
+			queryParams: map[string]string{},
+		},
+		{
+			name:         "Filtered NDJSON",
+			expectedCode: http.StatusOK,
+			expectedType: "application/x-ndjson",
+			expectedBody: filmJSON + "\n" + filmJSON,
+			mockUsecaseFn: func(mockUsecase *mock_film.MockUsecase) {
+				minRating := 7
+				streamFilms(mockUsecase, model.FilmFilter{SortBy: "title", SortOrder: "desc", MinRating: &minRating})
+			},
+			queryParams: map[string]string{"format": "ndjson", "sort_by": "title", "sort_order": "desc", "min_rating": "7"},
+		},
+		{
+			name:         "JSON array",
+			expectedCode: http.StatusOK,
+			expectedType: "application/json; charset=utf-8",
+			expectedBody: "[" + filmJSON + "," + filmJSON + "]",
+			mockUsecaseFn: func(mockUsecase *mock_film.MockUsecase) {
+				streamFilms(mockUsecase, model.FilmFilter{SortOrder: "asc"})
+			},
+			queryParams: map[string]string{"format": "json"},
+		},
+		{
+			name:         "Empty JSON array",
+			expectedCode: http.StatusOK,
+			expectedType: "application/json; charset=utf-8",
+			expectedBody: "[]",
+			mockUsecaseFn: func(mockUsecase *mock_film.MockUsecase) {
+				mockUsecase.EXPECT().ExportFilms(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+			queryParams: map[string]string{"format": "json"},
+		},
+		{
+			name:          "Unknown format",
+			expectedCode:  http.StatusBadRequest,
+			expectedType:  "application/json",
+			expectedBody:  `{"status":400,"message":"Bad query param"}`,
+			mockUsecaseFn: func(mockUsecase *mock_film.MockUsecase) {},
+			queryParams:   map[string]string{"format": "xml"},
+			failure:       true,
+		},
+		{
+			name:         "Failure before the first row",
+			expectedCode: http.StatusInternalServerError,
+			expectedType: "application/json",
+			expectedBody: `{"status":500,"message":"Internal server error"}`,
+			mockUsecaseFn: func(mockUsecase *mock_film.MockUsecase) {
+				mockUsecase.EXPECT().ExportFilms(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("connection refused"))
+			},
+			queryParams: map[string]string{},
+			failure:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			logger := logger.NewMockInterface(ctrl)
+			if tt.failure {
+				logger.EXPECT().Error(gomock.Any())
+			}
+			mockUsecase := mock_film.NewMockUsecase(ctrl)
+			tt.mockUsecaseFn(mockUsecase)
+
+			handler := FilmHandler{filmUsecase: mockUsecase, logger: logger}
+
+			req := httptest.NewRequest("GET", "/export/films", nil)
+			q := req.URL.Query()
+			for key, value := range tt.queryParams {
+				q.Add(key, value)
+			}
+			req.URL.RawQuery = q.Encode()
+
+			recorder := httptest.NewRecorder()
+
+			handler.ExportFilms(recorder, req)
+
+			actual := strings.TrimSpace(recorder.Body.String())
+
+			assert.Equal(t, tt.expectedCode, recorder.Code)
+			assert.Equal(t, tt.expectedType, recorder.Header().Get("Content-Type"))
+			assert.Equal(t, tt.expectedBody, actual)
+			assert.Equal(t, !tt.failure, strings.HasPrefix(recorder.Header().Get("Content-Disposition"), "attachment; filename=films-"))
+		})
+	}
+}
+
+// deadlineRecorder records the connection deadlines handlers set.
+type deadlineRecorder struct {
+	*httptest.ResponseRecorder
+	readDeadline  *time.Time
+	writeDeadline *time.Time
+}
+
+func (d *deadlineRecorder) SetReadDeadline(deadline time.Time) error {
+	d.readDeadline = &deadline
+	return nil
+}
+
+func (d *deadlineRecorder) SetWriteDeadline(deadline time.Time) error {
+	d.writeDeadline = &deadline
+	return nil
+}
+
+func TestFilmHandler_ExportFilmsLiftsWriteDeadline(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mock_film.NewMockUsecase(ctrl)
+	mockUsecase.EXPECT().ExportFilms(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	handler := FilmHandler{filmUsecase: mockUsecase, logger: logger.NewMockInterface(ctrl)}
+
+	recorder := &deadlineRecorder{ResponseRecorder: httptest.NewRecorder()}
+	handler.ExportFilms(recorder, httptest.NewRequest("GET", "/export/films", nil))
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.NotEqual(t, nil, recorder.writeDeadline)
+	assert.Equal(t, true, recorder.writeDeadline.IsZero())
+}
+
+func TestFilmHandler_GetFilm(t *testing.T) {
+	billing := 1
+	MockResponse := model.ResponseFilm{
+		Film:   model.Film{ID: 1, Title: "Forest Gamp", Description: "...", Rating: 10},
+		Actors: []model.ActorObj{{Id: 2, Name: "Tom Hanks", CastRole: model.CastRole{Characters: []string{"Forrest Gump"}, Billing: &billing}}},
+		Crew:   []model.CrewObj{{PersonID: 3, Name: "Robert Zemeckis", Role: model.CrewDirector}},
+		Genres: []model.Term{{ID: 4, Name: "Drama"}},
+		Tags:   []model.Term{},
+
+		Collections: []model.CollectionObj{{ID: 5, Title: "Oscar winners"}},
+	}
+	tests := []struct {
+		name          string
+		id            string
+		expectedCode  int
+		expectedBody  string
+		mockUsecaseFn func(*mock_film.MockUsecase)
+		mockLoggerFn  func(*logger.MockInterface)
+	}{
+		{
+			name:         "Successful call to GetFilm",
+			id:           "1",
+			expectedCode: http.StatusOK,
+			expectedBody: `{"status":200,"body":{"actors":[{"actor_id":2,"name":"Tom Hanks","characters":["Forrest Gump"],"billing":1}],"crew":[{"person_id":3,"name":"Robert Zemeckis","role":"director"}],"genres":[{"id":4,"name":"Drama"}],"tags":[],"collections":[{"collection_id":5,"title":"Oscar winners"}],"film_id":1,"title":"Forest Gamp","description":"...","release_date":"0001-01-01T00:00:00Z","rating":10,"community_score":0,"rating_count":0}}`,
+			mockUsecaseFn: func(mockUsecase *mock_film.MockUsecase) {
+				mockUsecase.EXPECT().GetFilm(gomock.Any(), uint64(1)).Return(MockResponse, nil)
+			},
+			mockLoggerFn: func(mockLogger *logger.MockInterface) {},
+		},
+		{
+			name:         "Film not found",
+			id:           "7",
+			expectedCode: http.StatusNotFound,
+			expectedBody: `{"status":404,"message":"film not found"}`,
+			mockUsecaseFn: func(mockUsecase *mock_film.MockUsecase) {
+				mockUsecase.EXPECT().GetFilm(gomock.Any(), uint64(7)).Return(model.ResponseFilm{}, &model.ErrNotFound{Message: "film not found"})
+			},
+			mockLoggerFn: func(mockLogger *logger.MockInterface) {},
+		},
+		{
+			name:          "Invalid id",
+			id:            "abc",
+			expectedCode:  http.StatusBadRequest,
+			expectedBody:  `{"status":400,"message":"invalid url parameter"}`,
+			mockUsecaseFn: func(mockUsecase *mock_film.MockUsecase) {},
+			mockLoggerFn: func(mockLogger *logger.MockInterface) {
+				mockLogger.EXPECT().Error(gomock.Any())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			logger := logger.NewMockInterface(ctrl)
+			tt.mockLoggerFn(logger)
+			mockUsecase := mock_film.NewMockUsecase(ctrl)
+			tt.mockUsecaseFn(mockUsecase)
+
+			handler := FilmHandler{filmUsecase: mockUsecase, logger: logger}
+
+			req := httptest.NewRequest("GET", "/films/"+tt.id, nil)
+			req.SetPathValue("id", tt.id)
+			recorder := httptest.NewRecorder()
+
+			handler.GetFilm(recorder, req)
+
+			actual := strings.TrimSpace(recorder.Body.String())
+
+			assert.Equal(t, tt.expectedCode, recorder.Code)
+			assert.Equal(t, tt.expectedBody, actual)
+		})
+	}
+}
+
 func TestFilmHandler_AddFilm(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -133,3 +511,72 @@ func TestFilmHandler_AddFilm(t *testing.T) {
 		})
 	}
 }
+
+func TestFilmHandler_UpdateCastRole(t *testing.T) {
+	billing := 2
+	tests := []struct {
+		name          string
+		body          string
+		expectedCode  int
+		expectedBody  string
+		mockUsecaseFn func(*mock_film.MockUsecase)
+		badRequest    bool
+	}{
+		{
+			name:         "Successful update",
+			body:         `{"characters":["Neo","Thomas Anderson"],"billing":2,"voice":true}`,
+			expectedCode: http.StatusOK,
+			expectedBody: `{"status":200,"body":{}}`,
+			mockUsecaseFn: func(mockUsecase *mock_film.MockUsecase) {
+				mockUsecase.EXPECT().UpdateCastRole(gomock.Any(), uint64(1), uint(2), model.CastRole{
+					Characters: []string{"Neo", "Thomas Anderson"},
+					Billing:    &billing,
+					Voice:      true,
+				}).Return(nil)
+			},
+		},
+		{
+			name:         "Actor not in cast",
+			body:         `{"characters":["Neo"]}`,
+			expectedCode: http.StatusNotFound,
+			expectedBody: `{"status":404,"message":"actor is not linked to film"}`,
+			mockUsecaseFn: func(mockUsecase *mock_film.MockUsecase) {
+				mockUsecase.EXPECT().UpdateCastRole(gomock.Any(), uint64(1), uint(2), gomock.Any()).Return(&model.ErrNotFound{Message: "actor is not linked to film"})
+			},
+		},
+		{
+			name:          "Billing out of range",
+			body:          `{"billing":0}`,
+			expectedCode:  http.StatusBadRequest,
+			expectedBody:  `{"status":400,"message":"Invalid request"}`,
+			mockUsecaseFn: func(mockUsecase *mock_film.MockUsecase) {},
+			badRequest:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			logger := logger.NewMockInterface(ctrl)
+			if tt.badRequest {
+				logger.EXPECT().Error(gomock.Any())
+			}
+			mockUsecase := mock_film.NewMockUsecase(ctrl)
+			tt.mockUsecaseFn(mockUsecase)
+
+			handler := FilmHandler{filmUsecase: mockUsecase, logger: logger}
+
+			req := httptest.NewRequest("PUT", "/films/1/actors/2", strings.NewReader(tt.body))
+			req.SetPathValue("id", "1")
+			req.SetPathValue("actorId", "2")
+			recorder := httptest.NewRecorder()
+
+			handler.UpdateCastRole(recorder, req)
+
+			assert.Equal(t, tt.expectedCode, recorder.Code)
+			assert.Equal(t, tt.expectedBody, strings.TrimSpace(recorder.Body.String()))
+		})
+	}
+}
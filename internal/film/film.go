@@ -3,22 +3,44 @@ package film
 import (
 	"context"
 	"films_library/internal/model"
+	"films_library/pkg/pagination"
 )
 
 type Usecase interface {
-	GetFilms(ctx context.Context, filter model.FilmFilter) ([]model.Film, error)
+	GetFilms(ctx context.Context, filter model.FilmFilter) ([]model.Film, pagination.Page, error)
+	GetFilmFacets(ctx context.Context, filter model.FilmFilter) (model.FilmFacets, error)
 	AddFilm(ctx context.Context, film model.AddFilmRequest) (uint64, error)
-	UpdateFilm(ctx context.Context, film model.Film) (uint64, error)
+	UpdateFilm(ctx context.Context, film model.UpdateFilmRequest) (uint64, error)
 	DeleteFilm(ctx context.Context, id uint64) (uint64, error)
-	GetFilm(ctx context.Context, id uint64) (model.Film, error)
-	SearchFilm(ctx context.Context, search string) ([]model.Film, error)
+	GetFilm(ctx context.Context, id uint64) (model.ResponseFilm, error)
+	SearchFilm(ctx context.Context, filter model.SearchFilter) ([]model.Film, pagination.Page, error)
+	ExportFilms(ctx context.Context, filter model.FilmFilter, fn func(model.ExportFilm) error) error
+
+	LinkActor(ctx context.Context, filmID uint64, actorID uint, role model.CastRole) error
+	UpdateCastRole(ctx context.Context, filmID uint64, actorID uint, role model.CastRole) error
+	UnlinkActor(ctx context.Context, filmID uint64, actorID uint) error
+	LinkCrew(ctx context.Context, filmID, personID uint64, role string) error
+	UnlinkCrew(ctx context.Context, filmID, personID uint64, role string) error
 }
 
 type Repository interface {
 	GetFilms(ctx context.Context, filter model.FilmFilter) ([]model.Film, error)
+	CountFilms(ctx context.Context, filter model.FilmFilter) (int64, error)
+	GetFilmFacets(ctx context.Context, filter model.FilmFilter) (model.FilmFacets, error)
 	GetFilm(ctx context.Context, id uint64) (model.Film, error)
+	GetFilmActors(ctx context.Context, id uint64) ([]model.ActorObj, error)
+	GetFilmCrew(ctx context.Context, id uint64) ([]model.CrewObj, error)
+	GetFilmTerms(ctx context.Context, id uint64) ([]model.Term, []model.Term, error)
+	GetFilmCollections(ctx context.Context, id uint64) ([]model.CollectionObj, error)
 	AddFilm(ctx context.Context, film model.AddFilmRequest) (uint64, error)
-	UpdateFilm(ctx context.Context, film model.Film) (uint64, error)
+	UpdateFilm(ctx context.Context, film model.UpdateFilmRequest) (uint64, error)
 	DeleteFilm(ctx context.Context, id uint64) (uint64, error)
-	SearchFilm(ctx context.Context, search string) ([]model.Film, error)
+	SearchFilm(ctx context.Context, filter model.SearchFilter) ([]model.Film, error)
+	ExportFilms(ctx context.Context, filter model.FilmFilter, fn func(model.ExportFilm) error) error
+
+	LinkActor(ctx context.Context, filmID uint64, actorID uint, role model.CastRole) error
+	UpdateCastRole(ctx context.Context, filmID uint64, actorID uint, role model.CastRole) error
+	UnlinkActor(ctx context.Context, filmID uint64, actorID uint) error
+	LinkCrew(ctx context.Context, filmID, personID uint64, role string) error
+	UnlinkCrew(ctx context.Context, filmID, personID uint64, role string) error
 }
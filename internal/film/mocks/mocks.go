@@ -7,6 +7,7 @@ package mock_film
 import (
 	context "context"
 	model "films_library/internal/model"
+	pagination "films_library/pkg/pagination"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -65,11 +66,25 @@ func (mr *MockUsecaseMockRecorder) DeleteFilm(ctx, id interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFilm", reflect.TypeOf((*MockUsecase)(nil).DeleteFilm), ctx, id)
 }
 
+// ExportFilms mocks base method.
+func (m *MockUsecase) ExportFilms(ctx context.Context, filter model.FilmFilter, fn func(model.ExportFilm) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportFilms", ctx, filter, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportFilms indicates an expected call of ExportFilms.
+func (mr *MockUsecaseMockRecorder) ExportFilms(ctx, filter, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportFilms", reflect.TypeOf((*MockUsecase)(nil).ExportFilms), ctx, filter, fn)
+}
+
 // GetFilm mocks base method.
-func (m *MockUsecase) GetFilm(ctx context.Context, id uint64) (model.Film, error) {
+func (m *MockUsecase) GetFilm(ctx context.Context, id uint64) (model.ResponseFilm, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetFilm", ctx, id)
-	ret0, _ := ret[0].(model.Film)
+	ret0, _ := ret[0].(model.ResponseFilm)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -80,13 +95,29 @@ func (mr *MockUsecaseMockRecorder) GetFilm(ctx, id interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilm", reflect.TypeOf((*MockUsecase)(nil).GetFilm), ctx, id)
 }
 
+// GetFilmFacets mocks base method.
+func (m *MockUsecase) GetFilmFacets(ctx context.Context, filter model.FilmFilter) (model.FilmFacets, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFilmFacets", ctx, filter)
+	ret0, _ := ret[0].(model.FilmFacets)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFilmFacets indicates an expected call of GetFilmFacets.
+func (mr *MockUsecaseMockRecorder) GetFilmFacets(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilmFacets", reflect.TypeOf((*MockUsecase)(nil).GetFilmFacets), ctx, filter)
+}
+
 // GetFilms mocks base method.
-func (m *MockUsecase) GetFilms(ctx context.Context, filter model.FilmFilter) ([]model.Film, error) {
+func (m *MockUsecase) GetFilms(ctx context.Context, filter model.FilmFilter) ([]model.Film, pagination.Page, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetFilms", ctx, filter)
 	ret0, _ := ret[0].([]model.Film)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(pagination.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // GetFilms indicates an expected call of GetFilms.
@@ -95,23 +126,94 @@ func (mr *MockUsecaseMockRecorder) GetFilms(ctx, filter interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilms", reflect.TypeOf((*MockUsecase)(nil).GetFilms), ctx, filter)
 }
 
+// LinkActor mocks base method.
+func (m *MockUsecase) LinkActor(ctx context.Context, filmID uint64, actorID uint, role model.CastRole) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LinkActor", ctx, filmID, actorID, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LinkActor indicates an expected call of LinkActor.
+func (mr *MockUsecaseMockRecorder) LinkActor(ctx, filmID, actorID, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkActor", reflect.TypeOf((*MockUsecase)(nil).LinkActor), ctx, filmID, actorID, role)
+}
+
+// LinkCrew mocks base method.
+func (m *MockUsecase) LinkCrew(ctx context.Context, filmID, personID uint64, role string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LinkCrew", ctx, filmID, personID, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LinkCrew indicates an expected call of LinkCrew.
+func (mr *MockUsecaseMockRecorder) LinkCrew(ctx, filmID, personID, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkCrew", reflect.TypeOf((*MockUsecase)(nil).LinkCrew), ctx, filmID, personID, role)
+}
+
 // SearchFilm mocks base method.
-func (m *MockUsecase) SearchFilm(ctx context.Context, search string) ([]model.Film, error) {
+func (m *MockUsecase) SearchFilm(ctx context.Context, filter model.SearchFilter) ([]model.Film, pagination.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchFilm", ctx, search)
+	ret := m.ctrl.Call(m, "SearchFilm", ctx, filter)
 	ret0, _ := ret[0].([]model.Film)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(pagination.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // SearchFilm indicates an expected call of SearchFilm.
-func (mr *MockUsecaseMockRecorder) SearchFilm(ctx, search interface{}) *gomock.Call {
+func (mr *MockUsecaseMockRecorder) SearchFilm(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchFilm", reflect.TypeOf((*MockUsecase)(nil).SearchFilm), ctx, filter)
+}
+
+// UnlinkActor mocks base method.
+func (m *MockUsecase) UnlinkActor(ctx context.Context, filmID uint64, actorID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlinkActor", ctx, filmID, actorID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnlinkActor indicates an expected call of UnlinkActor.
+func (mr *MockUsecaseMockRecorder) UnlinkActor(ctx, filmID, actorID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchFilm", reflect.TypeOf((*MockUsecase)(nil).SearchFilm), ctx, search)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlinkActor", reflect.TypeOf((*MockUsecase)(nil).UnlinkActor), ctx, filmID, actorID)
+}
+
+// UnlinkCrew mocks base method.
+func (m *MockUsecase) UnlinkCrew(ctx context.Context, filmID, personID uint64, role string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlinkCrew", ctx, filmID, personID, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnlinkCrew indicates an expected call of UnlinkCrew.
+func (mr *MockUsecaseMockRecorder) UnlinkCrew(ctx, filmID, personID, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlinkCrew", reflect.TypeOf((*MockUsecase)(nil).UnlinkCrew), ctx, filmID, personID, role)
+}
+
+// UpdateCastRole mocks base method.
+func (m *MockUsecase) UpdateCastRole(ctx context.Context, filmID uint64, actorID uint, role model.CastRole) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCastRole", ctx, filmID, actorID, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCastRole indicates an expected call of UpdateCastRole.
+func (mr *MockUsecaseMockRecorder) UpdateCastRole(ctx, filmID, actorID, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCastRole", reflect.TypeOf((*MockUsecase)(nil).UpdateCastRole), ctx, filmID, actorID, role)
 }
 
 // UpdateFilm mocks base method.
-func (m *MockUsecase) UpdateFilm(ctx context.Context, film model.Film) (uint64, error) {
+func (m *MockUsecase) UpdateFilm(ctx context.Context, film model.UpdateFilmRequest) (uint64, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpdateFilm", ctx, film)
 	ret0, _ := ret[0].(uint64)
@@ -163,6 +265,21 @@ func (mr *MockRepositoryMockRecorder) AddFilm(ctx, film interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFilm", reflect.TypeOf((*MockRepository)(nil).AddFilm), ctx, film)
 }
 
+// CountFilms mocks base method.
+func (m *MockRepository) CountFilms(ctx context.Context, filter model.FilmFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountFilms", ctx, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountFilms indicates an expected call of CountFilms.
+func (mr *MockRepositoryMockRecorder) CountFilms(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountFilms", reflect.TypeOf((*MockRepository)(nil).CountFilms), ctx, filter)
+}
+
 // DeleteFilm mocks base method.
 func (m *MockRepository) DeleteFilm(ctx context.Context, id uint64) (uint64, error) {
 	m.ctrl.T.Helper()
@@ -178,6 +295,20 @@ func (mr *MockRepositoryMockRecorder) DeleteFilm(ctx, id interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFilm", reflect.TypeOf((*MockRepository)(nil).DeleteFilm), ctx, id)
 }
 
+// ExportFilms mocks base method.
+func (m *MockRepository) ExportFilms(ctx context.Context, filter model.FilmFilter, fn func(model.ExportFilm) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportFilms", ctx, filter, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportFilms indicates an expected call of ExportFilms.
+func (mr *MockRepositoryMockRecorder) ExportFilms(ctx, filter, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportFilms", reflect.TypeOf((*MockRepository)(nil).ExportFilms), ctx, filter, fn)
+}
+
 // GetFilm mocks base method.
 func (m *MockRepository) GetFilm(ctx context.Context, id uint64) (model.Film, error) {
 	m.ctrl.T.Helper()
@@ -193,6 +324,82 @@ func (mr *MockRepositoryMockRecorder) GetFilm(ctx, id interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilm", reflect.TypeOf((*MockRepository)(nil).GetFilm), ctx, id)
 }
 
+// GetFilmActors mocks base method.
+func (m *MockRepository) GetFilmActors(ctx context.Context, id uint64) ([]model.ActorObj, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFilmActors", ctx, id)
+	ret0, _ := ret[0].([]model.ActorObj)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFilmActors indicates an expected call of GetFilmActors.
+func (mr *MockRepositoryMockRecorder) GetFilmActors(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilmActors", reflect.TypeOf((*MockRepository)(nil).GetFilmActors), ctx, id)
+}
+
+// GetFilmCollections mocks base method.
+func (m *MockRepository) GetFilmCollections(ctx context.Context, id uint64) ([]model.CollectionObj, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFilmCollections", ctx, id)
+	ret0, _ := ret[0].([]model.CollectionObj)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFilmCollections indicates an expected call of GetFilmCollections.
+func (mr *MockRepositoryMockRecorder) GetFilmCollections(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilmCollections", reflect.TypeOf((*MockRepository)(nil).GetFilmCollections), ctx, id)
+}
+
+// GetFilmCrew mocks base method.
+func (m *MockRepository) GetFilmCrew(ctx context.Context, id uint64) ([]model.CrewObj, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFilmCrew", ctx, id)
+	ret0, _ := ret[0].([]model.CrewObj)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFilmCrew indicates an expected call of GetFilmCrew.
+func (mr *MockRepositoryMockRecorder) GetFilmCrew(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilmCrew", reflect.TypeOf((*MockRepository)(nil).GetFilmCrew), ctx, id)
+}
+
+// GetFilmFacets mocks base method.
+func (m *MockRepository) GetFilmFacets(ctx context.Context, filter model.FilmFilter) (model.FilmFacets, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFilmFacets", ctx, filter)
+	ret0, _ := ret[0].(model.FilmFacets)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFilmFacets indicates an expected call of GetFilmFacets.
+func (mr *MockRepositoryMockRecorder) GetFilmFacets(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilmFacets", reflect.TypeOf((*MockRepository)(nil).GetFilmFacets), ctx, filter)
+}
+
+// GetFilmTerms mocks base method.
+func (m *MockRepository) GetFilmTerms(ctx context.Context, id uint64) ([]model.Term, []model.Term, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFilmTerms", ctx, id)
+	ret0, _ := ret[0].([]model.Term)
+	ret1, _ := ret[1].([]model.Term)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetFilmTerms indicates an expected call of GetFilmTerms.
+func (mr *MockRepositoryMockRecorder) GetFilmTerms(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilmTerms", reflect.TypeOf((*MockRepository)(nil).GetFilmTerms), ctx, id)
+}
+
 // GetFilms mocks base method.
 func (m *MockRepository) GetFilms(ctx context.Context, filter model.FilmFilter) ([]model.Film, error) {
 	m.ctrl.T.Helper()
@@ -208,23 +415,93 @@ func (mr *MockRepositoryMockRecorder) GetFilms(ctx, filter interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilms", reflect.TypeOf((*MockRepository)(nil).GetFilms), ctx, filter)
 }
 
+// LinkActor mocks base method.
+func (m *MockRepository) LinkActor(ctx context.Context, filmID uint64, actorID uint, role model.CastRole) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LinkActor", ctx, filmID, actorID, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LinkActor indicates an expected call of LinkActor.
+func (mr *MockRepositoryMockRecorder) LinkActor(ctx, filmID, actorID, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkActor", reflect.TypeOf((*MockRepository)(nil).LinkActor), ctx, filmID, actorID, role)
+}
+
+// LinkCrew mocks base method.
+func (m *MockRepository) LinkCrew(ctx context.Context, filmID, personID uint64, role string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LinkCrew", ctx, filmID, personID, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LinkCrew indicates an expected call of LinkCrew.
+func (mr *MockRepositoryMockRecorder) LinkCrew(ctx, filmID, personID, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkCrew", reflect.TypeOf((*MockRepository)(nil).LinkCrew), ctx, filmID, personID, role)
+}
+
 // SearchFilm mocks base method.
-func (m *MockRepository) SearchFilm(ctx context.Context, search string) ([]model.Film, error) {
+func (m *MockRepository) SearchFilm(ctx context.Context, filter model.SearchFilter) ([]model.Film, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchFilm", ctx, search)
+	ret := m.ctrl.Call(m, "SearchFilm", ctx, filter)
 	ret0, _ := ret[0].([]model.Film)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SearchFilm indicates an expected call of SearchFilm.
-func (mr *MockRepositoryMockRecorder) SearchFilm(ctx, search interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) SearchFilm(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchFilm", reflect.TypeOf((*MockRepository)(nil).SearchFilm), ctx, filter)
+}
+
+// UnlinkActor mocks base method.
+func (m *MockRepository) UnlinkActor(ctx context.Context, filmID uint64, actorID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlinkActor", ctx, filmID, actorID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnlinkActor indicates an expected call of UnlinkActor.
+func (mr *MockRepositoryMockRecorder) UnlinkActor(ctx, filmID, actorID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlinkActor", reflect.TypeOf((*MockRepository)(nil).UnlinkActor), ctx, filmID, actorID)
+}
+
+// UnlinkCrew mocks base method.
+func (m *MockRepository) UnlinkCrew(ctx context.Context, filmID, personID uint64, role string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlinkCrew", ctx, filmID, personID, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnlinkCrew indicates an expected call of UnlinkCrew.
+func (mr *MockRepositoryMockRecorder) UnlinkCrew(ctx, filmID, personID, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlinkCrew", reflect.TypeOf((*MockRepository)(nil).UnlinkCrew), ctx, filmID, personID, role)
+}
+
+// UpdateCastRole mocks base method.
+func (m *MockRepository) UpdateCastRole(ctx context.Context, filmID uint64, actorID uint, role model.CastRole) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCastRole", ctx, filmID, actorID, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCastRole indicates an expected call of UpdateCastRole.
+func (mr *MockRepositoryMockRecorder) UpdateCastRole(ctx, filmID, actorID, role interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchFilm", reflect.TypeOf((*MockRepository)(nil).SearchFilm), ctx, search)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCastRole", reflect.TypeOf((*MockRepository)(nil).UpdateCastRole), ctx, filmID, actorID, role)
 }
 
 // UpdateFilm mocks base method.
-func (m *MockRepository) UpdateFilm(ctx context.Context, film model.Film) (uint64, error) {
+func (m *MockRepository) UpdateFilm(ctx context.Context, film model.UpdateFilmRequest) (uint64, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpdateFilm", ctx, film)
 	ret0, _ := ret[0].(uint64)
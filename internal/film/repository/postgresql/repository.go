@@ -2,11 +2,40 @@ package postgresql
 
 import (
 	"context"
+	"errors"
 	"films_library/internal/model"
+	"films_library/pkg/pagination"
 	"films_library/pkg/postgres"
 	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	foreignKeyViolation = "23503"
+	uniqueViolation     = "23505"
+
+	filmForeignKey     = "film_actor_film_id_fkey"
+	crewFilmForeignKey = "film_crew_film_id_fkey"
 )
 
+const filmColumns = `f.film_id, f.title, f."description", COALESCE(f.release_date, '0001-01-01'), COALESCE(f.rating, -1), f.rating_count, f.rating_sum, a.copies, a.available,
+        COALESCE((SELECT jsonb_object_agg(x.provider, x.external_id) FROM film_external_id x WHERE x.film_id = f.film_id), '{}'),
+        (SELECT p.etag FROM film_poster p WHERE p.film_id = f.film_id)`
+
+// availabilityJoin counts the copies of each film in circulation, as
+// a.copies, and those of them neither on loan nor set aside for a hold, as
+// a.available.
+const availabilityJoin = ` CROSS JOIN LATERAL (
+            SELECT count(*) AS copies,
+                count(*) FILTER (WHERE
+                    NOT EXISTS (SELECT 1 FROM loan l WHERE l.copy_id = c.copy_id AND l.returned_at IS NULL)
+                    AND NOT EXISTS (SELECT 1 FROM hold h WHERE h.copy_id = c.copy_id AND h.status = 'ready')) AS available
+            FROM copy c WHERE c.film_id = f.film_id AND c.status = 'active') a`
+
 type Repository struct {
 	db postgres.DBConn
 }
@@ -15,77 +44,379 @@ func NewRepository(db postgres.DBConn) *Repository {
 	return &Repository{db}
 }
 
-func (r *Repository) GetFilms(ctx context.Context, filter model.FilmFilter) ([]model.Film, error) {
-	sqlQuery := `SELECT film_id, title, "description", release_date, rating FROM film`
+// sortColumns are the columns film listings can be sorted by. Films without
+// a rating or release date sort as rated -1 or released on 0001-01-01, the
+// values their rows are read with.
+var sortColumns = map[string]pagination.Order{
+	"rating":       {Column: "COALESCE(f.rating, -1)", Cast: "int"},
+	"release_date": {Column: "COALESCE(f.release_date, '0001-01-01')", Cast: "date"},
+	"title":        {Column: "f.title", Cast: "text"},
+}
+
+// query accumulates WHERE conditions together with their positional arguments.
+type query struct {
+	where []string
+	args  []interface{}
+}
 
-	if filter.SortBy != "" {
-		sqlQuery += " ORDER BY " + filter.SortBy
-		if filter.SortOrder == "desc" {
-			sqlQuery += " DESC"
+func (q *query) arg(v interface{}) string {
+	q.args = append(q.args, v)
+	return fmt.Sprintf("$%d", len(q.args))
+}
+
+func (q *query) String() string {
+	if len(q.where) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(q.where, " AND ")
+}
+
+// keyset adds the cursor condition and ORDER BY/LIMIT/OFFSET clauses for
+// listing film rows ordered by sortBy, if set, and film_id.
+func (q *query) keyset(sortBy string, desc bool, p pagination.Params) string {
+	order := sortColumns[sortBy]
+	order.Key, order.Desc = "f.film_id", desc
+
+	condition, clauses := p.Keyset(order, &q.args)
+	if condition != "" {
+		q.where = append(q.where, condition)
+	}
+	return q.String() + clauses
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// filmConditions turns the filter fields of a film listing into WHERE conditions.
+func filmConditions(q *query, filter model.FilmFilter) {
+	if filter.MinRating != nil {
+		q.where = append(q.where, "f.rating >= "+q.arg(*filter.MinRating))
+	}
+	if filter.MaxRating != nil {
+		q.where = append(q.where, "f.rating <= "+q.arg(*filter.MaxRating))
+	}
+	if filter.ReleasedAfter != nil {
+		q.where = append(q.where, "f.release_date >= "+q.arg(*filter.ReleasedAfter))
+	}
+	if filter.ReleasedBefore != nil {
+		q.where = append(q.where, "f.release_date <= "+q.arg(*filter.ReleasedBefore))
+	}
+	if filter.TitlePrefix != "" {
+		q.where = append(q.where, "f.title ILIKE "+q.arg(likeEscaper.Replace(filter.TitlePrefix))+" || '%'")
+	}
+	if len(filter.ActorIDs) > 0 {
+		// Films starring every one of the requested actors.
+		ids := q.arg(filter.ActorIDs)
+		q.where = append(q.where, fmt.Sprintf(`f.film_id IN (
+            SELECT film_id FROM film_actor
+            WHERE actor_id = ANY(%[1]s::bigint[])
+            GROUP BY film_id
+            HAVING count(DISTINCT actor_id) = (SELECT count(DISTINCT id) FROM unnest(%[1]s::bigint[]) AS id))`, ids))
+	}
+	if len(filter.CrewIDs) > 0 {
+		// Films crewed by every one of the requested people, in the given role if any.
+		ids := q.arg(filter.CrewIDs)
+		role := "true"
+		if filter.CrewRole != "" {
+			role = `"role" = ` + q.arg(filter.CrewRole)
+		}
+		q.where = append(q.where, fmt.Sprintf(`f.film_id IN (
+            SELECT film_id FROM film_crew
+            WHERE person_id = ANY(%[1]s::bigint[]) AND %[2]s
+            GROUP BY film_id
+            HAVING count(DISTINCT person_id) = (SELECT count(DISTINCT id) FROM unnest(%[1]s::bigint[]) AS id))`, ids, role))
+	}
+	if len(filter.IDs) > 0 {
+		q.where = append(q.where, "f.film_id = ANY("+q.arg(filter.IDs)+"::bigint[])")
+	}
+	termConditions(q, "film_genre", "genre_id", filter.GenreIDs, filter.GenreMatch, filter.ExcludeGenreIDs)
+	termConditions(q, "film_tag", "tag_id", filter.TagIDs, filter.TagMatch, filter.ExcludeTagIDs)
+}
+
+// termConditions keeps films linked through table to any or all of ids and
+// drops those linked to any of exclude.
+func termConditions(q *query, table, column string, ids []uint64, match string, exclude []uint64) {
+	if len(ids) > 0 {
+		arg := q.arg(ids)
+		if match == model.MatchAll {
+			q.where = append(q.where, fmt.Sprintf(`f.film_id IN (
+            SELECT film_id FROM %[1]s
+            WHERE %[2]s = ANY(%[3]s::bigint[])
+            GROUP BY film_id
+            HAVING count(DISTINCT %[2]s) = (SELECT count(DISTINCT id) FROM unnest(%[3]s::bigint[]) AS id))`, table, column, arg))
 		} else {
-			sqlQuery += " ASC"
+			q.where = append(q.where, fmt.Sprintf(`f.film_id IN (SELECT film_id FROM %s WHERE %s = ANY(%s::bigint[]))`, table, column, arg))
 		}
 	}
+	if len(exclude) > 0 {
+		q.where = append(q.where, fmt.Sprintf(`f.film_id NOT IN (SELECT film_id FROM %s WHERE %s = ANY(%s::bigint[]))`, table, column, q.arg(exclude)))
+	}
+}
+
+func (r *Repository) GetFilms(ctx context.Context, filter model.FilmFilter) ([]model.Film, error) {
+	sqlQuery := `SELECT ` + filmColumns + ` FROM film f` + availabilityJoin
+
+	var q query
+	filmConditions(&q, filter)
+
+	sqlQuery += q.keyset(filter.SortBy, filter.SortOrder == "desc", filter.Params)
+
+	return r.queryFilms(ctx, sqlQuery, filter.Backward(), q.args...)
+}
+
+func (r *Repository) CountFilms(ctx context.Context, filter model.FilmFilter) (int64, error) {
+	sqlQuery := `SELECT count(*) FROM film f`
+
+	var q query
+	filmConditions(&q, filter)
+	sqlQuery += q.String()
+
+	var total int64
+	if err := r.db.QueryRow(ctx, sqlQuery, q.args...).Scan(&total); err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+// ExportFilms streams the films matching filter to fn in listing order,
+// ignoring pagination. It stops at the first error fn returns.
+func (r *Repository) ExportFilms(ctx context.Context, filter model.FilmFilter, fn func(model.ExportFilm) error) error {
+	sqlQuery := `SELECT ` + filmColumns + `,
+            ARRAY(SELECT fa.actor_id FROM film_actor fa WHERE fa.film_id = f.film_id ORDER BY fa.billing NULLS LAST, fa.actor_id)
+        FROM film f` + availabilityJoin
+
+	var q query
+	filmConditions(&q, filter)
 
-	rows, err := r.db.Query(ctx, sqlQuery)
+	sqlQuery += q.keyset(filter.SortBy, filter.SortOrder == "desc", pagination.Params{})
+
+	rows, err := r.db.Query(ctx, sqlQuery, q.args...)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	defer rows.Close()
 
-	var films []model.Film
 	for rows.Next() {
-		var film model.Film
+		var (
+			film         model.ExportFilm
+			availability model.Availability
+			externalIDs  model.ExternalIDs
+			posterETag   *string
+		)
 		if err := rows.Scan(
 			&film.ID,
 			&film.Title,
 			&film.Description,
 			&film.ReleaseDate,
 			&film.Rating,
+			&film.RatingCount,
+			&film.RatingSum,
+			&availability.Copies,
+			&availability.Available,
+			&externalIDs,
+			&posterETag,
+			&film.Actors,
 		); err != nil {
+			return err
+		}
+		film.Availability = &availability
+		film.ExternalIDs = &externalIDs
+		film.Poster = poster(film.ID, posterETag)
+
+		if err := fn(film); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// GetFilmFacets counts the films matching filter in each genre, most films
+// first. Genres without matching films are left out.
+func (r *Repository) GetFilmFacets(ctx context.Context, filter model.FilmFilter) (model.FilmFacets, error) {
+	sqlQuery := `
+        SELECT g.genre_id, g."name", count(*)
+        FROM film f
+        JOIN film_genre fg ON fg.film_id = f.film_id
+        JOIN genre g ON g.genre_id = fg.genre_id`
+
+	var q query
+	filmConditions(&q, filter)
+	sqlQuery += q.String() + ` GROUP BY g.genre_id, g."name" ORDER BY count(*) DESC, g."name"`
+
+	rows, err := r.db.Query(ctx, sqlQuery, q.args...)
+	if err != nil {
+		return model.FilmFacets{}, err
+	}
+	defer rows.Close()
+
+	facets := model.FilmFacets{Genres: []model.GenreFacet{}}
+	for rows.Next() {
+		var facet model.GenreFacet
+		if err := rows.Scan(&facet.GenreID, &facet.Name, &facet.Films); err != nil {
+			return model.FilmFacets{}, err
+		}
+		facets.Genres = append(facets.Genres, facet)
+	}
+	if err := rows.Err(); err != nil {
+		return model.FilmFacets{}, err
+	}
+	return facets, nil
+}
+
+// queryFilms scans film rows; rows read backwards are returned in listing order.
+func (r *Repository) queryFilms(ctx context.Context, sqlQuery string, backward bool, args ...interface{}) ([]model.Film, error) {
+	rows, err := r.db.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var films []model.Film
+	for rows.Next() {
+		film, err := scanFilm(rows)
+		if err != nil {
 			return nil, err
 		}
-		fmt.Println(film)
 		films = append(films, film)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if backward {
+		slices.Reverse(films)
+	}
 	return films, nil
 }
 
+// scanFilm reads a row of filmColumns.
+func scanFilm(row pgx.Row) (model.Film, error) {
+	var film model.Film
+	var availability model.Availability
+	var externalIDs model.ExternalIDs
+	var posterETag *string
+	err := row.Scan(
+		&film.ID,
+		&film.Title,
+		&film.Description,
+		&film.ReleaseDate,
+		&film.Rating,
+		&film.RatingCount,
+		&film.RatingSum,
+		&availability.Copies,
+		&availability.Available,
+		&externalIDs,
+		&posterETag,
+	)
+	film.Availability = &availability
+	film.ExternalIDs = &externalIDs
+	film.Poster = poster(film.ID, posterETag)
+	return film, err
+}
+
+// poster returns the URLs of the poster of a film, if it has one.
+func poster(id uint64, etag *string) *model.ImageURLs {
+	if etag == nil {
+		return nil
+	}
+	urls := model.NewImageURLs(model.EntityFilm, id, *etag)
+	return &urls
+}
+
 func (r *Repository) GetFilm(ctx context.Context, id uint64) (model.Film, error) {
-	sqlQuery := `SELECT (film_id, title, "description", release_date, rating) FROM film WHERE film_id=$1`
+	sqlQuery := `SELECT ` + filmColumns + ` FROM film f` + availabilityJoin + ` WHERE f.film_id=$1`
 
-	row := r.db.QueryRow(ctx, sqlQuery, id)
-	var film model.Film
-	err := row.Scan(&film.ID, &film.Title, &film.Description, &film.ReleaseDate, &film.Rating)
+	film, err := scanFilm(r.db.QueryRow(ctx, sqlQuery, id))
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Film{}, &model.ErrNotFound{Message: "film not found"}
+		}
 		return model.Film{}, err
 	}
 	return film, nil
 }
 
+func (r *Repository) GetFilmActors(ctx context.Context, id uint64) ([]model.ActorObj, error) {
+	sqlQuery := `
+        SELECT a.actor_id, a.name, fa.characters, fa.billing, fa.uncredited, fa.voice, fa.cameo
+        FROM film_actor fa
+        JOIN actor a ON a.actor_id = fa.actor_id
+        WHERE fa.film_id = $1
+        ORDER BY fa.billing NULLS LAST, a.name
+    `
+
+	rows, err := r.db.Query(ctx, sqlQuery, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	actors := []model.ActorObj{}
+	for rows.Next() {
+		var actor model.ActorObj
+		if err := rows.Scan(
+			&actor.Id,
+			&actor.Name,
+			&actor.Characters,
+			&actor.Billing,
+			&actor.Uncredited,
+			&actor.Voice,
+			&actor.Cameo,
+		); err != nil {
+			return nil, err
+		}
+		actors = append(actors, actor)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return actors, nil
+}
+
 func (r *Repository) AddFilm(ctx context.Context, film model.AddFilmRequest) (uint64, error) {
 	sqlQuery := `INSERT INTO film (title, "description", release_date, rating) VALUES ($1, $2, $3, $4) RETURNING film_id`
 	var id uint64
-	err := r.db.QueryRow(ctx, sqlQuery, film.Title, film.Description, film.ReleaseDate, film.Rating).Scan(&id)
+	err := r.db.BeginTxFunc(ctx, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		if err := tx.QueryRow(ctx, sqlQuery, film.Title, film.Description, film.ReleaseDate, film.Rating).Scan(&id); err != nil {
+			return err
+		}
+		return insertFilmActors(ctx, tx, id, film.Actors)
+	})
 	if err != nil {
-		return 0, err
+		return 0, filmActorError(err)
 	}
 	return id, nil
 }
 
-func (r *Repository) UpdateFilm(ctx context.Context, film model.Film) (uint64, error) {
+func (r *Repository) UpdateFilm(ctx context.Context, film model.UpdateFilmRequest) (uint64, error) {
 	sqlQuery := `UPDATE film SET title=$1, "description"=$2, release_date=$3, rating=$4 WHERE film_id=$5 RETURNING film_id`
 
 	var filmId uint64
 
-	if err := r.db.QueryRow(ctx, sqlQuery,
-		film.Title,
-		film.Description,
-		film.ReleaseDate,
-		film.Rating,
-		film.ID,
-	).Scan(&filmId); err != nil {
-		return 0, err
+	err := r.db.BeginTxFunc(ctx, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		if err := tx.QueryRow(ctx, sqlQuery,
+			film.Title,
+			film.Description,
+			film.ReleaseDate,
+			film.Rating,
+			film.ID,
+		).Scan(&filmId); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return &model.ErrNotFound{Message: "film not found"}
+			}
+			return err
+		}
+
+		// A nil cast leaves the current one untouched, an empty one clears it.
+		if film.Actors == nil {
+			return nil
+		}
+		if _, err := tx.Exec(ctx, `DELETE FROM film_actor WHERE film_id=$1`, filmId); err != nil {
+			return err
+		}
+		return insertFilmActors(ctx, tx, filmId, film.Actors)
+	})
+	if err != nil {
+		return 0, filmActorError(err)
 	}
 
 	return filmId, nil
@@ -102,35 +433,227 @@ func (r *Repository) DeleteFilm(ctx context.Context, id uint64) (uint64, error)
 
 }
 
-func (r *Repository) SearchFilm(ctx context.Context, search string) ([]model.Film, error) {
+func (r *Repository) SearchFilm(ctx context.Context, filter model.SearchFilter) ([]model.Film, error) {
+	sqlQuery := `SELECT ` + filmColumns + ` FROM film f` + availabilityJoin
+
+	// search_vector covers the title, the description and the cast; the
+	// trigram match catches misspelled titles.
+	var q query
+	search := q.arg(filter.Search)
+	q.where = append(q.where, fmt.Sprintf(`(f.search_vector @@ (websearch_to_tsquery('english', %[1]s) || websearch_to_tsquery('simple', %[1]s))
+            OR %[1]s <%% f.title)`, search))
+	sqlQuery += q.keyset("", false, filter.Params)
+
+	return r.queryFilms(ctx, sqlQuery, filter.Backward(), q.args...)
+}
+
+func (r *Repository) LinkActor(ctx context.Context, filmID uint64, actorID uint, role model.CastRole) error {
+	sqlQuery := `INSERT INTO film_actor (film_id, actor_id, characters, billing, uncredited, voice, cameo)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+	if _, err := r.db.Exec(ctx, sqlQuery, filmID, actorID, characters(role), role.Billing, role.Uncredited, role.Voice, role.Cameo); err != nil {
+		return filmActorError(err)
+	}
+	return nil
+}
+
+func (r *Repository) UpdateCastRole(ctx context.Context, filmID uint64, actorID uint, role model.CastRole) error {
+	sqlQuery := `UPDATE film_actor SET characters=$3, billing=$4, uncredited=$5, voice=$6, cameo=$7
+	WHERE film_id=$1 AND actor_id=$2`
+
+	res, err := r.db.Exec(ctx, sqlQuery, filmID, actorID, characters(role), role.Billing, role.Uncredited, role.Voice, role.Cameo)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return &model.ErrNotFound{Message: "actor is not linked to film"}
+	}
+	return nil
+}
+
+// characters keeps the NOT NULL characters column from receiving a nil slice.
+func characters(role model.CastRole) []string {
+	if role.Characters == nil {
+		return []string{}
+	}
+	return role.Characters
+}
+
+func (r *Repository) UnlinkActor(ctx context.Context, filmID uint64, actorID uint) error {
+	sqlQuery := `DELETE FROM film_actor WHERE film_id=$1 AND actor_id=$2`
+
+	res, err := r.db.Exec(ctx, sqlQuery, filmID, actorID)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return &model.ErrNotFound{Message: "actor is not linked to film"}
+	}
+	return nil
+}
+
+func insertFilmActors(ctx context.Context, tx pgx.Tx, filmID uint64, actors []uint) error {
+	if len(actors) == 0 {
+		return nil
+	}
+
 	sqlQuery := `
-        SELECT DISTINCT f.film_id, f.title, f.description, f.release_date, f.rating
-        FROM film f
-        JOIN film_actor fa ON f.film_id = fa.film_id
-        JOIN actor a ON fa.actor_id = a.actor_id
-        WHERE f.title LIKE '%' || $1 || '%'
-        OR a.name LIKE '%' || $1 || '%'
+        INSERT INTO film_actor (film_id, actor_id)
+        SELECT DISTINCT $1::bigint, ids.actor_id
+        FROM unnest($2::bigint[]) AS ids(actor_id)
+    `
+	_, err := tx.Exec(ctx, sqlQuery, filmID, actors)
+	return err
+}
+
+// filmActorError turns constraint violations on film_actor into model errors.
+func filmActorError(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+
+	switch pgErr.Code {
+	case foreignKeyViolation:
+		if pgErr.ConstraintName == filmForeignKey {
+			return &model.ErrNotFound{Message: "film not found"}
+		}
+		return &model.ErrNotFound{Message: "actor not found"}
+	case uniqueViolation:
+		return &model.ErrConflict{Message: "actor is already linked to film"}
+	}
+	return err
+}
+
+func (r *Repository) GetFilmCrew(ctx context.Context, id uint64) ([]model.CrewObj, error) {
+	sqlQuery := `
+        SELECT p.person_id, p."name", fc."role"
+        FROM film_crew fc
+        JOIN person p ON p.person_id = fc.person_id
+        WHERE fc.film_id = $1
+        ORDER BY array_position(ARRAY['director', 'writer', 'producer', 'composer', 'cinematographer'], fc."role"), p."name"
     `
 
-	rows, err := r.db.Query(ctx, sqlQuery, search)
+	rows, err := r.db.Query(ctx, sqlQuery, id)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var films []model.Film
+	crew := []model.CrewObj{}
 	for rows.Next() {
-		var film model.Film
-		if err := rows.Scan(
-			&film.ID,
-			&film.Title,
-			&film.Description,
-			&film.ReleaseDate,
-			&film.Rating,
-		); err != nil {
+		var member model.CrewObj
+		if err := rows.Scan(&member.PersonID, &member.Name, &member.Role); err != nil {
 			return nil, err
 		}
-		films = append(films, film)
+		crew = append(crew, member)
 	}
-	return films, nil
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return crew, nil
+}
+
+// GetFilmTerms returns the genres and the tags of a film, each sorted by name.
+func (r *Repository) GetFilmTerms(ctx context.Context, id uint64) ([]model.Term, []model.Term, error) {
+	sqlQuery := `
+        SELECT 'genre', g.genre_id, g."name" FROM film_genre fg JOIN genre g ON g.genre_id = fg.genre_id WHERE fg.film_id = $1
+        UNION ALL
+        SELECT 'tag', t.tag_id, t."name" FROM film_tag ft JOIN tag t ON t.tag_id = ft.tag_id WHERE ft.film_id = $1
+        ORDER BY 3
+    `
+
+	rows, err := r.db.Query(ctx, sqlQuery, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	genres, tags := []model.Term{}, []model.Term{}
+	for rows.Next() {
+		var taxonomy string
+		var term model.Term
+		if err := rows.Scan(&taxonomy, &term.ID, &term.Name); err != nil {
+			return nil, nil, err
+		}
+		if taxonomy == model.TaxonomyGenre {
+			genres = append(genres, term)
+		} else {
+			tags = append(tags, term)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+	return genres, tags, nil
+}
+
+// GetFilmCollections lists the public collections a film belongs to.
+func (r *Repository) GetFilmCollections(ctx context.Context, id uint64) ([]model.CollectionObj, error) {
+	sqlQuery := `
+        SELECT c.collection_id, c.title
+        FROM collection_film cf
+        JOIN collection c ON c.collection_id = cf.collection_id
+        WHERE cf.film_id = $1 AND c.visibility = $2
+        ORDER BY c.title, c.collection_id
+    `
+
+	rows, err := r.db.Query(ctx, sqlQuery, id, model.VisibilityPublic)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	collections := []model.CollectionObj{}
+	for rows.Next() {
+		var c model.CollectionObj
+		if err := rows.Scan(&c.ID, &c.Title); err != nil {
+			return nil, err
+		}
+		collections = append(collections, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return collections, nil
+}
+
+func (r *Repository) LinkCrew(ctx context.Context, filmID, personID uint64, role string) error {
+	sqlQuery := `INSERT INTO film_crew (film_id, person_id, "role") VALUES ($1, $2, $3)`
+
+	if _, err := r.db.Exec(ctx, sqlQuery, filmID, personID, role); err != nil {
+		return filmCrewError(err)
+	}
+	return nil
+}
+
+func (r *Repository) UnlinkCrew(ctx context.Context, filmID, personID uint64, role string) error {
+	sqlQuery := `DELETE FROM film_crew WHERE film_id=$1 AND person_id=$2 AND "role"=$3`
+
+	res, err := r.db.Exec(ctx, sqlQuery, filmID, personID, role)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return &model.ErrNotFound{Message: "person is not credited on film in this role"}
+	}
+	return nil
+}
+
+func filmCrewError(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+
+	switch pgErr.Code {
+	case foreignKeyViolation:
+		if pgErr.ConstraintName == crewFilmForeignKey {
+			return &model.ErrNotFound{Message: "film not found"}
+		}
+		return &model.ErrNotFound{Message: "person not found"}
+	case uniqueViolation:
+		return &model.ErrConflict{Message: "person is already credited on film in this role"}
+	}
+	return err
 }
@@ -4,21 +4,24 @@ import (
 	"context"
 	"errors"
 	"films_library/internal/model"
+	"films_library/pkg/pagination"
+	"regexp"
 	"testing"
 	"time"
 
+	"github.com/jackc/pgconn"
 	"github.com/pashagolub/pgxmock"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestUpdateFilm(t *testing.T) {
-	film := model.Film{
+	film := model.UpdateFilmRequest{Film: model.Film{
 		Title:       "Updated Title",
 		ReleaseDate: time.Now(),
 		ID:          1,
 		Description: "...",
 		Rating:      8,
-	}
+	}}
 
 	tests := []struct {
 		name       string
@@ -47,10 +50,16 @@ func TestUpdateFilm(t *testing.T) {
 
 			repo := NewRepository(mock)
 
-			mock.ExpectQuery(`UPDATE film SET title=$1, "description"=$2, release_date=$3, rating=$4 WHERE film_id=$5 RETURNING film_id`).
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(`UPDATE film SET title=$1, "description"=$2, release_date=$3, rating=$4 WHERE film_id=$5 RETURNING film_id`)).
 				WithArgs(film.Title, film.Description, film.ReleaseDate, film.Rating, film.ID).
 				WillReturnRows(test.returnRows).
 				WillReturnError(test.errRows)
+			if test.errRows != nil {
+				mock.ExpectRollback()
+			} else {
+				mock.ExpectCommit()
+			}
 
 			updatedFilmID, err := repo.UpdateFilm(context.Background(), film)
 
@@ -67,3 +76,196 @@ func TestUpdateFilm(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateFilmReplacesCast(t *testing.T) {
+	film := model.UpdateFilmRequest{
+		Film: model.Film{
+			Title:       "Updated Title",
+			ReleaseDate: time.Now(),
+			ID:          1,
+			Description: "...",
+			Rating:      8,
+		},
+		Actors: []uint{3, 4},
+	}
+
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mock.Close()
+
+	repo := NewRepository(mock)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`UPDATE film SET title=$1`)).
+		WithArgs(film.Title, film.Description, film.ReleaseDate, film.Rating, film.ID).
+		WillReturnRows(pgxmock.NewRows([]string{"film_id"}).AddRow(film.ID))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM film_actor WHERE film_id=$1`)).
+		WithArgs(film.ID).
+		WillReturnResult(pgxmock.NewResult("DELETE", 1))
+	mock.ExpectExec(`INSERT INTO film_actor`).
+		WithArgs(film.ID, film.Actors).
+		WillReturnResult(pgxmock.NewResult("INSERT", 2))
+	mock.ExpectCommit()
+
+	updatedFilmID, err := repo.UpdateFilm(context.Background(), film)
+	assert.NoError(t, err)
+	assert.Equal(t, film.ID, updatedFilmID)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestLinkActor(t *testing.T) {
+	tests := []struct {
+		name        string
+		execErr     error
+		expectedErr error
+	}{
+		{
+			name: "Success",
+		},
+		{
+			name:        "Unknown film",
+			execErr:     &pgconn.PgError{Code: "23503", ConstraintName: "film_actor_film_id_fkey"},
+			expectedErr: &model.ErrNotFound{Message: "film not found"},
+		},
+		{
+			name:        "Unknown actor",
+			execErr:     &pgconn.PgError{Code: "23503", ConstraintName: "film_actor_actor_id_fkey"},
+			expectedErr: &model.ErrNotFound{Message: "actor not found"},
+		},
+		{
+			name:        "Already linked",
+			execErr:     &pgconn.PgError{Code: "23505"},
+			expectedErr: &model.ErrConflict{Message: "actor is already linked to film"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer mock.Close()
+
+			repo := NewRepository(mock)
+
+			exec := mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO film_actor (film_id, actor_id, characters, billing, uncredited, voice, cameo)`)).
+				WithArgs(uint64(1), uint(2), []string{}, (*int)(nil), false, false, false)
+			if test.execErr != nil {
+				exec.WillReturnError(test.execErr)
+			} else {
+				exec.WillReturnResult(pgxmock.NewResult("INSERT", 1))
+			}
+
+			err = repo.LinkActor(context.Background(), 1, 2, model.CastRole{})
+			assert.Equal(t, test.expectedErr, err)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestGetFilmsKeyset(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mock.Close()
+
+	repo := NewRepository(mock)
+
+	filter := model.FilmFilter{
+		SortBy:    "rating",
+		SortOrder: "desc",
+		Params: pagination.Params{
+			Limit:  2,
+			Cursor: &pagination.Cursor{Value: "8", ID: 5, Backward: true},
+		},
+	}
+
+	etag := "9f86d081884c7d65"
+	mock.ExpectQuery(regexp.QuoteMeta(`WHERE (COALESCE(f.rating, -1), f.film_id) > ($1::int, $2) ORDER BY COALESCE(f.rating, -1) ASC, f.film_id ASC LIMIT $3`)).
+		WithArgs("8", uint64(5), 3).
+		WillReturnRows(pgxmock.NewRows([]string{"film_id", "title", "description", "release_date", "rating", "rating_count", "rating_sum", "copies", "available", "external_ids", "poster"}).
+			AddRow(uint64(6), "B", "", time.Time{}, 9, int64(0), int64(0), int64(0), int64(0), model.ExternalIDs{}, (*string)(nil)).
+			AddRow(uint64(7), "A", "", time.Time{}, 10, int64(2), int64(17), int64(3), int64(1), model.ExternalIDs{IMDb: "tt0133093"}, &etag))
+
+	films, err := repo.GetFilms(context.Background(), filter)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint64{7, 6}, []uint64{films[0].ID, films[1].ID})
+	assert.Equal(t, &model.Availability{Copies: 3, Available: 1}, films[0].Availability)
+	assert.Equal(t, &model.ExternalIDs{IMDb: "tt0133093"}, films[0].ExternalIDs)
+	assert.Equal(t, "/films/7/poster?size=w185&v=9f86d081884c7d65", films[0].Poster.W185)
+	assert.Nil(t, films[1].Poster)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestCountFilmsFiltered(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mock.Close()
+
+	repo := NewRepository(mock)
+
+	minRating, maxRating := 5, 9
+	filter := model.FilmFilter{
+		MinRating:   &minRating,
+		MaxRating:   &maxRating,
+		ActorIDs:    []uint{1, 2},
+		TitlePrefix: "100%",
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM film f WHERE f.rating >= $1 AND f.rating <= $2 AND f.title ILIKE $3 || '%' AND f.film_id IN (`)).
+		WithArgs(minRating, maxRating, `100\%`, filter.ActorIDs).
+		WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(int64(4)))
+
+	total, err := repo.CountFilms(context.Background(), filter)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), total)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestGetFilmFacets(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mock.Close()
+
+	repo := NewRepository(mock)
+
+	filter := model.FilmFilter{
+		TagIDs:          []uint64{3},
+		ExcludeGenreIDs: []uint64{7},
+		Params:          pagination.Params{Limit: 20},
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta(`WHERE f.film_id NOT IN (SELECT film_id FROM film_genre WHERE genre_id = ANY($1::bigint[])) AND f.film_id IN (SELECT film_id FROM film_tag WHERE tag_id = ANY($2::bigint[])) GROUP BY g.genre_id`)).
+		WithArgs(filter.ExcludeGenreIDs, filter.TagIDs).
+		WillReturnRows(pgxmock.NewRows([]string{"genre_id", "name", "count"}).
+			AddRow(uint64(1), "Drama", int64(4)).
+			AddRow(uint64(2), "Comedy", int64(1)))
+
+	facets, err := repo.GetFilmFacets(context.Background(), filter)
+	assert.NoError(t, err)
+	assert.Equal(t, []model.GenreFacet{{GenreID: 1, Name: "Drama", Films: 4}, {GenreID: 2, Name: "Comedy", Films: 1}}, facets.Genres)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
@@ -2,30 +2,63 @@ package usecase
 
 import (
 	"context"
+	"strconv"
+	"time"
 
+	"films_library/internal/actor"
 	"films_library/internal/film"
 	"films_library/internal/model"
 	"films_library/pkg/logger"
+	"films_library/pkg/pagination"
 )
 
 type FilmUsecase struct {
 	FilmRepository film.Repository
+	ActorUsecase   actor.Usecase
+	prior          model.ScorePrior
 	logger         logger.Interface
 }
 
-func NewFilmUsecase(fr film.Repository, l logger.Interface) *FilmUsecase {
-	return &FilmUsecase{fr, l}
+func NewFilmUsecase(fr film.Repository, au actor.Usecase, prior model.ScorePrior, l logger.Interface) *FilmUsecase {
+	return &FilmUsecase{fr, au, prior, l}
 }
 
-func (fu *FilmUsecase) GetFilms(ctx context.Context, filter model.FilmFilter) ([]model.Film, error) {
+func (fu *FilmUsecase) GetFilms(ctx context.Context, filter model.FilmFilter) ([]model.Film, pagination.Page, error) {
 	films, err := fu.FilmRepository.GetFilms(ctx, filter)
 	if err != nil {
-		return []model.Film{}, err
+		return []model.Film{}, pagination.Page{}, err
 	}
-	return films, nil
+
+	films, page := pagination.Paginate(films, filter.Params, func(film model.Film) pagination.Cursor {
+		return pagination.Cursor{Value: sortKey(film, filter.SortBy), ID: film.ID}
+	})
+	fu.score(films)
+
+	if filter.WithTotal {
+		total, err := fu.FilmRepository.CountFilms(ctx, filter)
+		if err != nil {
+			return []model.Film{}, pagination.Page{}, err
+		}
+		page.Total = &total
+	}
+	return films, page, nil
+}
+
+// GetFilmFacets counts the films matching filter per genre. When genres are
+// matched on any of them, the genre selection itself is left out so the
+// counts show what widening it would add.
+func (fu *FilmUsecase) GetFilmFacets(ctx context.Context, filter model.FilmFilter) (model.FilmFacets, error) {
+	if filter.GenreMatch != model.MatchAll {
+		filter.GenreIDs = nil
+	}
+	return fu.FilmRepository.GetFilmFacets(ctx, filter)
 }
 
 func (fu *FilmUsecase) AddFilm(ctx context.Context, film model.AddFilmRequest) (uint64, error) {
+	if err := fu.checkActors(ctx, film.Actors); err != nil {
+		return 0, err
+	}
+
 	id, err := fu.FilmRepository.AddFilm(ctx, film)
 	if err != nil {
 		return 0, err
@@ -33,7 +66,11 @@ func (fu *FilmUsecase) AddFilm(ctx context.Context, film model.AddFilmRequest) (
 	return id, nil
 }
 
-func (fu *FilmUsecase) UpdateFilm(ctx context.Context, film model.Film) (uint64, error) {
+func (fu *FilmUsecase) UpdateFilm(ctx context.Context, film model.UpdateFilmRequest) (uint64, error) {
+	if err := fu.checkActors(ctx, film.Actors); err != nil {
+		return 0, err
+	}
+
 	id, err := fu.FilmRepository.UpdateFilm(ctx, film)
 	if err != nil {
 		return 0, err
@@ -49,18 +86,115 @@ func (fu *FilmUsecase) DeleteFilm(ctx context.Context, id uint64) (uint64, error
 	return id, nil
 }
 
-func (fu *FilmUsecase) GetFilm(ctx context.Context, id uint64) (model.Film, error) {
+func (fu *FilmUsecase) GetFilm(ctx context.Context, id uint64) (model.ResponseFilm, error) {
 	film, err := fu.FilmRepository.GetFilm(ctx, id)
 	if err != nil {
-		return model.Film{}, err
+		return model.ResponseFilm{}, err
+	}
+	film.CommunityScore = fu.prior.Score(film.RatingSum, film.RatingCount)
+
+	actors, err := fu.FilmRepository.GetFilmActors(ctx, id)
+	if err != nil {
+		return model.ResponseFilm{}, err
 	}
-	return film, nil
+
+	crew, err := fu.FilmRepository.GetFilmCrew(ctx, id)
+	if err != nil {
+		return model.ResponseFilm{}, err
+	}
+
+	genres, tags, err := fu.FilmRepository.GetFilmTerms(ctx, id)
+	if err != nil {
+		return model.ResponseFilm{}, err
+	}
+
+	collections, err := fu.FilmRepository.GetFilmCollections(ctx, id)
+	if err != nil {
+		return model.ResponseFilm{}, err
+	}
+
+	return model.ResponseFilm{
+		Film:        film,
+		Actors:      actors,
+		Crew:        crew,
+		Genres:      genres,
+		Tags:        tags,
+		Collections: collections,
+	}, nil
 }
 
-func (fu *FilmUsecase) SearchFilm(ctx context.Context, search string) ([]model.Film, error) {
-	films, err := fu.FilmRepository.SearchFilm(ctx, search)
+func (fu *FilmUsecase) SearchFilm(ctx context.Context, filter model.SearchFilter) ([]model.Film, pagination.Page, error) {
+	films, err := fu.FilmRepository.SearchFilm(ctx, filter)
 	if err != nil {
-		return []model.Film{}, err
+		return []model.Film{}, pagination.Page{}, err
 	}
-	return films, nil
+
+	films, page := pagination.Paginate(films, filter.Params, func(film model.Film) pagination.Cursor {
+		return pagination.Cursor{ID: film.ID}
+	})
+	fu.score(films)
+	return films, page, nil
+}
+
+func (fu *FilmUsecase) ExportFilms(ctx context.Context, filter model.FilmFilter, fn func(model.ExportFilm) error) error {
+	return fu.FilmRepository.ExportFilms(ctx, filter, func(film model.ExportFilm) error {
+		film.CommunityScore = fu.prior.Score(film.RatingSum, film.RatingCount)
+		return fn(film)
+	})
+}
+
+func (fu *FilmUsecase) LinkActor(ctx context.Context, filmID uint64, actorID uint, role model.CastRole) error {
+	return fu.FilmRepository.LinkActor(ctx, filmID, actorID, role)
+}
+
+func (fu *FilmUsecase) UpdateCastRole(ctx context.Context, filmID uint64, actorID uint, role model.CastRole) error {
+	return fu.FilmRepository.UpdateCastRole(ctx, filmID, actorID, role)
+}
+
+func (fu *FilmUsecase) UnlinkActor(ctx context.Context, filmID uint64, actorID uint) error {
+	return fu.FilmRepository.UnlinkActor(ctx, filmID, actorID)
+}
+
+// score fills in the community score of films from their rating aggregates.
+func (fu *FilmUsecase) score(films []model.Film) {
+	for i := range films {
+		films[i].CommunityScore = fu.prior.Score(films[i].RatingSum, films[i].RatingCount)
+	}
+}
+
+// sortKey renders the column a listing is sorted by in the form the
+// repository casts cursor values from.
+func sortKey(film model.Film, sortBy string) string {
+	switch sortBy {
+	case "rating":
+		return strconv.Itoa(film.Rating)
+	case "release_date":
+		return film.ReleaseDate.Format(time.DateOnly)
+	case "title":
+		return film.Title
+	}
+	return ""
+}
+
+func (fu *FilmUsecase) checkActors(ctx context.Context, actors []uint) error {
+	if len(actors) == 0 {
+		return nil
+	}
+
+	exist, err := fu.ActorUsecase.CheckActors(ctx, actors)
+	if err != nil {
+		return err
+	}
+	if !exist {
+		return &model.ErrNotFound{Message: "actor not found"}
+	}
+	return nil
+}
+
+func (fu *FilmUsecase) LinkCrew(ctx context.Context, filmID, personID uint64, role string) error {
+	return fu.FilmRepository.LinkCrew(ctx, filmID, personID, role)
+}
+
+func (fu *FilmUsecase) UnlinkCrew(ctx context.Context, filmID, personID uint64, role string) error {
+	return fu.FilmRepository.UnlinkCrew(ctx, filmID, personID, role)
 }
@@ -5,8 +5,11 @@ import (
 	"errors"
 	"films_library/internal/model"
 	"films_library/pkg/logger"
+	"films_library/pkg/pagination"
+	"reflect"
 	"testing"
 
+	mock_actor "films_library/internal/actor/mocks"
 	mock_film "films_library/internal/film/mocks"
 
 	"github.com/golang/mock/gomock"
@@ -18,7 +21,8 @@ func TestFilmUsecase_GetFilms(t *testing.T) {
 
 	logger := logger.NewMockInterface(ctrl)
 	mockRepo := mock_film.NewMockRepository(ctrl)
-	mockUsecase := NewFilmUsecase(mockRepo, logger)
+	mockActorUsecase := mock_actor.NewMockUsecase(ctrl)
+	mockUsecase := NewFilmUsecase(mockRepo, mockActorUsecase, model.ScorePrior{}, logger)
 
 	ctx := context.Background()
 
@@ -46,7 +50,7 @@ func TestFilmUsecase_GetFilms(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			mockRepo.EXPECT().GetFilms(ctx, tc.filter).Return(tc.expectedFilms, tc.expectedError)
 
-			films, err := mockUsecase.GetFilms(ctx, tc.filter)
+			films, _, err := mockUsecase.GetFilms(ctx, tc.filter)
 			if err != tc.expectedError {
 				t.Errorf("Unexpected error: expected %v, got %v", tc.expectedError, err)
 			}
@@ -64,13 +68,96 @@ func TestFilmUsecase_GetFilms(t *testing.T) {
 	}
 }
 
+func TestFilmUsecase_GetFilmsPage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := logger.NewMockInterface(ctrl)
+	mockRepo := mock_film.NewMockRepository(ctrl)
+	mockActorUsecase := mock_actor.NewMockUsecase(ctrl)
+	mockUsecase := NewFilmUsecase(mockRepo, mockActorUsecase, model.ScorePrior{}, logger)
+
+	ctx := context.Background()
+
+	filter := model.FilmFilter{
+		SortBy:    "rating",
+		SortOrder: "desc",
+		Params:    pagination.Params{Limit: 2, WithTotal: true},
+	}
+	rows := []model.Film{{ID: 1, Rating: 9}, {ID: 2, Rating: 8}, {ID: 3, Rating: 7}}
+
+	mockRepo.EXPECT().GetFilms(ctx, filter).Return(rows, nil)
+	mockRepo.EXPECT().CountFilms(ctx, filter).Return(int64(3), nil)
+
+	films, page, err := mockUsecase.GetFilms(ctx, filter)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(films) != 2 {
+		t.Fatalf("Expected 2 films, got %d", len(films))
+	}
+
+	next, err := pagination.Decode(page.Next)
+	if err != nil {
+		t.Fatalf("Unexpected cursor error: %v", err)
+	}
+	if next != (pagination.Cursor{Value: "8", ID: 2}) {
+		t.Errorf("Expected cursor after film 2, got %v", next)
+	}
+
+	if page.Prev != "" {
+		t.Errorf("Expected no previous page, got %q", page.Prev)
+	}
+
+	if page.Total == nil || *page.Total != 3 {
+		t.Errorf("Expected total 3, got %v", page.Total)
+	}
+}
+
+func TestFilmUsecase_GetFilmsScore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := logger.NewMockInterface(ctrl)
+	mockRepo := mock_film.NewMockRepository(ctrl)
+	mockActorUsecase := mock_actor.NewMockUsecase(ctrl)
+	mockUsecase := NewFilmUsecase(mockRepo, mockActorUsecase, model.ScorePrior{Mean: 6, Weight: 10}, logger)
+
+	ctx := context.Background()
+
+	filter := model.FilmFilter{SortBy: "rating", SortOrder: "desc"}
+	rows := []model.Film{
+		{ID: 1, Rating: 9, RatingCount: 10, RatingSum: 100},
+		{ID: 2, Rating: 8},
+		{ID: 3, Rating: 7, RatingCount: 3, RatingSum: 3},
+	}
+
+	mockRepo.EXPECT().GetFilms(ctx, filter).Return(rows, nil)
+
+	films, _, err := mockUsecase.GetFilms(ctx, filter)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// Ten ratings of ten pull a film halfway from the prior, an unrated film
+	// sits on it.
+	expected := []float64{8, 6, 4.85}
+	for i, film := range films {
+		if film.CommunityScore != expected[i] {
+			t.Errorf("Expected film %d to score %v, got %v", film.ID, expected[i], film.CommunityScore)
+		}
+	}
+}
+
 func TestFilmUsecase_AddFilm(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	logger := logger.NewMockInterface(ctrl)
 	mockRepo := mock_film.NewMockRepository(ctrl)
-	mockUsecase := NewFilmUsecase(mockRepo, logger)
+	mockActorUsecase := mock_actor.NewMockUsecase(ctrl)
+	mockUsecase := NewFilmUsecase(mockRepo, mockActorUsecase, model.ScorePrior{}, logger)
 
 	ctx := context.Background()
 
@@ -110,31 +197,90 @@ func TestFilmUsecase_AddFilm(t *testing.T) {
 	}
 }
 
+func TestFilmUsecase_AddFilmWithActors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := logger.NewMockInterface(ctrl)
+	mockRepo := mock_film.NewMockRepository(ctrl)
+	mockActorUsecase := mock_actor.NewMockUsecase(ctrl)
+	mockUsecase := NewFilmUsecase(mockRepo, mockActorUsecase, model.ScorePrior{}, logger)
+
+	ctx := context.Background()
+
+	testCases := []struct {
+		name          string
+		filmToAdd     model.AddFilmRequest
+		actorsExist   bool
+		actorsError   error
+		expectedID    uint64
+		expectedError error
+	}{
+		{
+			name:        "All actors exist",
+			filmToAdd:   model.AddFilmRequest{Title: "Test Film", Actors: []uint{1, 2}},
+			actorsExist: true,
+			expectedID:  1,
+		},
+		{
+			name:          "Unknown actor",
+			filmToAdd:     model.AddFilmRequest{Title: "Test Film", Actors: []uint{1, 42}},
+			actorsExist:   false,
+			expectedError: &model.ErrNotFound{Message: "actor not found"},
+		},
+		{
+			name:          "Error checking actors",
+			filmToAdd:     model.AddFilmRequest{Title: "Test Film", Actors: []uint{1}},
+			actorsError:   errors.New("repository error"),
+			expectedError: errors.New("repository error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockActorUsecase.EXPECT().CheckActors(ctx, tc.filmToAdd.Actors).Return(tc.actorsExist, tc.actorsError)
+			if tc.actorsExist {
+				mockRepo.EXPECT().AddFilm(ctx, tc.filmToAdd).Return(tc.expectedID, nil)
+			}
+
+			id, err := mockUsecase.AddFilm(ctx, tc.filmToAdd)
+			if (err == nil) != (tc.expectedError == nil) || err != nil && err.Error() != tc.expectedError.Error() {
+				t.Errorf("Unexpected error: expected %v, got %v", tc.expectedError, err)
+			}
+
+			if id != tc.expectedID {
+				t.Errorf("Expected ID %d, got %d", tc.expectedID, id)
+			}
+		})
+	}
+}
+
 func TestFilmUsecase_UpdateFilm(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	logger := logger.NewMockInterface(ctrl)
 	mockRepo := mock_film.NewMockRepository(ctrl)
-	mockUsecase := NewFilmUsecase(mockRepo, logger)
+	mockActorUsecase := mock_actor.NewMockUsecase(ctrl)
+	mockUsecase := NewFilmUsecase(mockRepo, mockActorUsecase, model.ScorePrior{}, logger)
 
 	ctx := context.Background()
 
 	testCases := []struct {
 		name          string
-		filmToUpdate  model.Film
+		filmToUpdate  model.UpdateFilmRequest
 		expectedID    uint64
 		expectedError error
 	}{
 		{
 			name:          "Valid film",
-			filmToUpdate:  model.Film{ID: 1, Title: "Updated Film"},
+			filmToUpdate:  model.UpdateFilmRequest{Film: model.Film{ID: 1, Title: "Updated Film"}},
 			expectedID:    1,
 			expectedError: nil,
 		},
 		{
 			name:          "Error from repository",
-			filmToUpdate:  model.Film{ID: 2, Title: "Invalid Film"},
+			filmToUpdate:  model.UpdateFilmRequest{Film: model.Film{ID: 2, Title: "Invalid Film"}},
 			expectedID:    0,
 			expectedError: errors.New("repository error"),
 		},
@@ -162,7 +308,8 @@ func TestFilmUsecase_DeleteFilm(t *testing.T) {
 
 	logger := logger.NewMockInterface(ctrl)
 	mockRepo := mock_film.NewMockRepository(ctrl)
-	mockUsecase := NewFilmUsecase(mockRepo, logger)
+	mockActorUsecase := mock_actor.NewMockUsecase(ctrl)
+	mockUsecase := NewFilmUsecase(mockRepo, mockActorUsecase, model.ScorePrior{}, logger)
 
 	ctx := context.Background()
 
@@ -209,40 +356,99 @@ func TestFilmUsecase_GetFilm(t *testing.T) {
 
 	logger := logger.NewMockInterface(ctrl)
 	mockRepo := mock_film.NewMockRepository(ctrl)
-	mockUsecase := NewFilmUsecase(mockRepo, logger)
+	mockActorUsecase := mock_actor.NewMockUsecase(ctrl)
+	mockUsecase := NewFilmUsecase(mockRepo, mockActorUsecase, model.ScorePrior{}, logger)
 
 	ctx := context.Background()
 
 	testCases := []struct {
-		name          string
-		filmID        uint64
-		expectedFilm  model.Film
-		expectedError error
+		name           string
+		filmID         uint64
+		film           model.Film
+		filmError      error
+		actors         []model.ActorObj
+		actorsError    error
+		crew           []model.CrewObj
+		crewError      error
+		genres         []model.Term
+		tags           []model.Term
+		collections    []model.CollectionObj
+		expectedFilm   model.ResponseFilm
+		expectedError  error
+		expectedActors bool
+		expectedCrew   bool
+		expectedTerms  bool
 	}{
 		{
-			name:          "Valid film ID",
-			filmID:        1,
-			expectedFilm:  model.Film{ID: 1, Title: "Test Film"},
-			expectedError: nil,
+			name:        "Valid film ID",
+			filmID:      1,
+			film:        model.Film{ID: 1, Title: "Test Film"},
+			actors:      []model.ActorObj{{Id: 1, Name: "John Doe"}},
+			crew:        []model.CrewObj{{PersonID: 5, Name: "Jane Roe", Role: model.CrewDirector}},
+			genres:      []model.Term{{ID: 2, Name: "Drama"}},
+			tags:        []model.Term{},
+			collections: []model.CollectionObj{{ID: 6, Title: "Criterion picks"}},
+			expectedFilm: model.ResponseFilm{
+				Film:        model.Film{ID: 1, Title: "Test Film"},
+				Actors:      []model.ActorObj{{Id: 1, Name: "John Doe"}},
+				Crew:        []model.CrewObj{{PersonID: 5, Name: "Jane Roe", Role: model.CrewDirector}},
+				Genres:      []model.Term{{ID: 2, Name: "Drama"}},
+				Tags:        []model.Term{},
+				Collections: []model.CollectionObj{{ID: 6, Title: "Criterion picks"}},
+			},
+			expectedActors: true,
+			expectedCrew:   true,
+			expectedTerms:  true,
 		},
 		{
 			name:          "Error from repository",
 			filmID:        2,
-			expectedFilm:  model.Film{},
+			film:          model.Film{},
+			filmError:     errors.New("repository error"),
+			expectedFilm:  model.ResponseFilm{},
 			expectedError: errors.New("repository error"),
 		},
+		{
+			name:           "Error loading cast",
+			filmID:         3,
+			film:           model.Film{ID: 3, Title: "Test Film"},
+			actorsError:    errors.New("cast error"),
+			expectedFilm:   model.ResponseFilm{},
+			expectedError:  errors.New("cast error"),
+			expectedActors: true,
+		},
+		{
+			name:           "Error loading crew",
+			filmID:         4,
+			film:           model.Film{ID: 4, Title: "Test Film"},
+			crewError:      errors.New("crew error"),
+			expectedFilm:   model.ResponseFilm{},
+			expectedError:  errors.New("crew error"),
+			expectedActors: true,
+			expectedCrew:   true,
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			mockRepo.EXPECT().GetFilm(ctx, tc.filmID).Return(tc.expectedFilm, tc.expectedError)
+			mockRepo.EXPECT().GetFilm(ctx, tc.filmID).Return(tc.film, tc.filmError)
+			if tc.expectedActors {
+				mockRepo.EXPECT().GetFilmActors(ctx, tc.filmID).Return(tc.actors, tc.actorsError)
+			}
+			if tc.expectedCrew {
+				mockRepo.EXPECT().GetFilmCrew(ctx, tc.filmID).Return(tc.crew, tc.crewError)
+			}
+			if tc.expectedTerms {
+				mockRepo.EXPECT().GetFilmTerms(ctx, tc.filmID).Return(tc.genres, tc.tags, nil)
+				mockRepo.EXPECT().GetFilmCollections(ctx, tc.filmID).Return(tc.collections, nil)
+			}
 
 			film, err := mockUsecase.GetFilm(ctx, tc.filmID)
-			if err != tc.expectedError {
+			if (err == nil) != (tc.expectedError == nil) || err != nil && err.Error() != tc.expectedError.Error() {
 				t.Errorf("Unexpected error: expected %v, got %v", tc.expectedError, err)
 			}
 
-			if film != tc.expectedFilm {
+			if !reflect.DeepEqual(film, tc.expectedFilm) {
 				t.Errorf("Expected film %v, got %v", tc.expectedFilm, film)
 			}
 		})
@@ -255,25 +461,26 @@ func TestFilmUsecase_SearchFilm(t *testing.T) {
 
 	logger := logger.NewMockInterface(ctrl)
 	mockRepo := mock_film.NewMockRepository(ctrl)
-	mockUsecase := NewFilmUsecase(mockRepo, logger)
+	mockActorUsecase := mock_actor.NewMockUsecase(ctrl)
+	mockUsecase := NewFilmUsecase(mockRepo, mockActorUsecase, model.ScorePrior{}, logger)
 
 	ctx := context.Background()
 
 	testCases := []struct {
 		name          string
-		search        string
+		search        model.SearchFilter
 		expectedFilms []model.Film
 		expectedError error
 	}{
 		{
 			name:          "Valid search term",
-			search:        "Action",
+			search:        model.SearchFilter{Search: "Action"},
 			expectedFilms: []model.Film{{ID: 1, Title: "Action Film 1"}, {ID: 2, Title: "Action Film 2"}},
 			expectedError: nil,
 		},
 		{
 			name:          "Error from repository",
-			search:        "Comedy",
+			search:        model.SearchFilter{Search: "Comedy"},
 			expectedFilms: nil,
 			expectedError: errors.New("repository error"),
 		},
@@ -283,7 +490,7 @@ func TestFilmUsecase_SearchFilm(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			mockRepo.EXPECT().SearchFilm(ctx, tc.search).Return(tc.expectedFilms, tc.expectedError)
 
-			films, err := mockUsecase.SearchFilm(ctx, tc.search)
+			films, _, err := mockUsecase.SearchFilm(ctx, tc.search)
 			if err != tc.expectedError {
 				t.Errorf("Unexpected error: expected %v, got %v", tc.expectedError, err)
 			}
@@ -300,3 +507,47 @@ func TestFilmUsecase_SearchFilm(t *testing.T) {
 		})
 	}
 }
+
+func TestFilmUsecase_GetFilmFacets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := logger.NewMockInterface(ctrl)
+	mockRepo := mock_film.NewMockRepository(ctrl)
+	mockActorUsecase := mock_actor.NewMockUsecase(ctrl)
+	mockUsecase := NewFilmUsecase(mockRepo, mockActorUsecase, model.ScorePrior{}, logger)
+
+	ctx := context.Background()
+	facets := model.FilmFacets{Genres: []model.GenreFacet{{GenreID: 1, Name: "Drama", Films: 3}}}
+
+	testCases := []struct {
+		name         string
+		filter       model.FilmFilter
+		expectedRepo model.FilmFilter
+	}{
+		{
+			name:         "Any genre leaves the selection out",
+			filter:       model.FilmFilter{GenreIDs: []uint64{1, 2}, TagIDs: []uint64{4}},
+			expectedRepo: model.FilmFilter{TagIDs: []uint64{4}},
+		},
+		{
+			name:         "All genres keep the selection",
+			filter:       model.FilmFilter{GenreIDs: []uint64{1, 2}, GenreMatch: model.MatchAll},
+			expectedRepo: model.FilmFilter{GenreIDs: []uint64{1, 2}, GenreMatch: model.MatchAll},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo.EXPECT().GetFilmFacets(ctx, tc.expectedRepo).Return(facets, nil)
+
+			got, err := mockUsecase.GetFilmFacets(ctx, tc.filter)
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, facets) {
+				t.Errorf("Expected facets %v, got %v", facets, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,288 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"films_library/internal/auth"
+	"films_library/internal/hold"
+	"films_library/internal/model"
+	"films_library/pkg/logger"
+	"films_library/pkg/pagination"
+	"films_library/pkg/response"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type HoldHandler struct {
+	holdUsecase hold.Usecase
+	logger      logger.Interface
+}
+
+// HoldPermissions is the permission each hold route requires.
+var HoldPermissions = auth.Permissions{
+	"GET /holds":             auth.HoldManage,
+	"GET /holds/{id}":        auth.HoldRead,
+	"DELETE /holds/{id}":     auth.HoldWrite,
+	"GET /films/{id}/holds":  auth.HoldManage,
+	"POST /films/{id}/holds": auth.HoldWrite,
+	"GET /me/holds":          auth.HoldRead,
+}
+
+func NewHoldHandler(mux *http.ServeMux, hu hold.Usecase, l logger.Interface) {
+	r := &HoldHandler{hu, l}
+
+	mux.HandleFunc("GET /holds", r.GetHolds)
+	mux.HandleFunc("GET /holds/{id}", r.GetHold)
+	mux.HandleFunc("DELETE /holds/{id}", r.CancelHold)
+	mux.HandleFunc("GET /films/{id}/holds", r.GetFilmHolds)
+	mux.HandleFunc("POST /films/{id}/holds", r.PlaceHold)
+	mux.HandleFunc("GET /me/holds", r.GetMyHolds)
+}
+
+// GetHolds handles the HTTP GET request to retrieve a list of holds.
+// @Summary Get holds
+// @Description Retrieves a page of holds, oldest first.
+// @Tags holds
+// @Produce json
+// @Param status query string false "Only holds in this state (waiting, ready, collected, expired, cancelled)"
+// @Param film_id query integer false "Only holds on this film"
+// @Param user_id query integer false "Only holds of this user"
+// @Param limit query integer false "Page size (default 20, max 100)"
+// @Param offset query integer false "Number of holds to skip"
+// @Param cursor query string false "Cursor of the page to fetch"
+// @Param total query boolean false "Include the total number of holds"
+// @Success 200 {array} model.Hold "List of holds"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /holds [get]
+func (h *HoldHandler) GetHolds(w http.ResponseWriter, r *http.Request) {
+	var filter model.HoldFilter
+	for param, dst := range map[string]*uint64{"film_id": &filter.FilmID, "user_id": &filter.UserID} {
+		s := r.URL.Query().Get(param)
+		if s == "" {
+			continue
+		}
+		id, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			h.logger.Error(err)
+			response.ErrorResponse(w, http.StatusBadRequest, "Bad query param", h.logger)
+			return
+		}
+		*dst = id
+	}
+
+	h.getHolds(w, r, filter)
+}
+
+// GetFilmHolds handles the HTTP GET request to retrieve the hold queue of a film.
+// @Summary Get film holds
+// @Description Retrieves a page of the holds on a film in queue order.
+// @Tags holds
+// @Produce json
+// @Param id path integer true "ID of the film"
+// @Param status query string false "Only holds in this state (waiting, ready, collected, expired, cancelled)"
+// @Param limit query integer false "Page size (default 20, max 100)"
+// @Param offset query integer false "Number of holds to skip"
+// @Param cursor query string false "Cursor of the page to fetch"
+// @Param total query boolean false "Include the total number of holds"
+// @Success 200 {array} model.Hold "List of holds"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /films/{id}/holds [get]
+func (h *HoldHandler) GetFilmHolds(w http.ResponseWriter, r *http.Request) {
+	filmId, ok := h.id(w, r)
+	if !ok {
+		return
+	}
+
+	h.getHolds(w, r, model.HoldFilter{FilmID: filmId})
+}
+
+// GetMyHolds handles the HTTP GET request to retrieve the holds of the caller.
+// @Summary Get my holds
+// @Description Retrieves a page of the holds of the caller, oldest first. Waiting holds carry their queue position.
+// @Tags holds
+// @Produce json
+// @Param status query string false "Only holds in this state (waiting, ready, collected, expired, cancelled)"
+// @Param limit query integer false "Page size (default 20, max 100)"
+// @Param offset query integer false "Number of holds to skip"
+// @Param cursor query string false "Cursor of the page to fetch"
+// @Param total query boolean false "Include the total number of holds"
+// @Success 200 {array} model.Hold "List of holds"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /me/holds [get]
+func (h *HoldHandler) GetMyHolds(w http.ResponseWriter, r *http.Request) {
+	user, ok := auth.UserFromContext(r.Context())
+	if !ok {
+		response.ErrorResponse(w, http.StatusUnauthorized, "unauthorized", h.logger)
+		return
+	}
+
+	h.getHolds(w, r, model.HoldFilter{UserID: user.ID})
+}
+
+// getHolds serves a page of holds matching filter and the query.
+func (h *HoldHandler) getHolds(w http.ResponseWriter, r *http.Request, filter model.HoldFilter) {
+	queryParams := r.URL.Query()
+
+	params, err := pagination.ParseQuery(queryParams)
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Bad query param", h.logger)
+		return
+	}
+	filter.Status, filter.Params = queryParams.Get("status"), params
+
+	v := validator.New()
+	if err := v.Struct(filter); err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Invalid request", h.logger)
+		return
+	}
+
+	holds, page, err := h.holdUsecase.GetHolds(r.Context(), filter)
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.PageResponse(w, http.StatusOK, holds, page)
+}
+
+// GetHold handles the HTTP GET request to retrieve a hold.
+// @Summary Get hold
+// @Description Retrieves a hold of the caller, with its queue position while it waits. Managers see any hold.
+// @Tags holds
+// @Produce json
+// @Param id path integer true "ID of the hold"
+// @Success 200 {object} model.Hold "Hold"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 404 {string} string "Hold not found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /holds/{id} [get]
+func (h *HoldHandler) GetHold(w http.ResponseWriter, r *http.Request) {
+	user, ok := auth.UserFromContext(r.Context())
+	if !ok {
+		response.ErrorResponse(w, http.StatusUnauthorized, "unauthorized", h.logger)
+		return
+	}
+
+	id, ok := h.id(w, r)
+	if !ok {
+		return
+	}
+
+	hd, err := h.holdUsecase.GetHold(r.Context(), user, id, manager(r, user))
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusOK, hd)
+}
+
+// PlaceHold handles the HTTP POST request to place a hold on a film.
+// @Summary Place hold
+// @Description Queues the caller for the next copy of a film. A free copy is set aside at once.
+// @Tags holds
+// @Produce json
+// @Param id path integer true "ID of the film"
+// @Success 201 {object} model.Hold "New hold"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 404 {string} string "Film not found"
+// @Failure 409 {string} string "Film is already held by the caller or has no lendable copies"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /films/{id}/holds [post]
+func (h *HoldHandler) PlaceHold(w http.ResponseWriter, r *http.Request) {
+	user, ok := auth.UserFromContext(r.Context())
+	if !ok {
+		response.ErrorResponse(w, http.StatusUnauthorized, "unauthorized", h.logger)
+		return
+	}
+
+	filmId, ok := h.id(w, r)
+	if !ok {
+		return
+	}
+
+	hd, err := h.holdUsecase.PlaceHold(r.Context(), user, filmId)
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusCreated, hd)
+}
+
+// CancelHold handles the HTTP DELETE request to cancel a hold.
+// @Summary Cancel hold
+// @Description Cancels a waiting or ready hold of the caller. A copy set aside for it passes to the next hold. Managers cancel any hold.
+// @Tags holds
+// @Produce json
+// @Param id path integer true "ID of the hold"
+// @Success 200 {object} model.Hold "Cancelled hold"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 404 {string} string "Hold not found"
+// @Failure 409 {string} string "Hold is already closed"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /holds/{id} [delete]
+func (h *HoldHandler) CancelHold(w http.ResponseWriter, r *http.Request) {
+	user, ok := auth.UserFromContext(r.Context())
+	if !ok {
+		response.ErrorResponse(w, http.StatusUnauthorized, "unauthorized", h.logger)
+		return
+	}
+
+	id, ok := h.id(w, r)
+	if !ok {
+		return
+	}
+
+	hd, err := h.holdUsecase.CancelHold(r.Context(), user, id, manager(r, user))
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusOK, hd)
+}
+
+// manager reports whether the caller may see and cancel holds of other users.
+func manager(r *http.Request, user model.User) bool {
+	credential, _ := auth.CredentialFromContext(r.Context())
+	return auth.Allowed(user.Role, auth.HoldManage) && credential.Allows(auth.HoldManage)
+}
+
+// id reads the hold or film ID from the path.
+func (h *HoldHandler) id(w http.ResponseWriter, r *http.Request) (uint64, bool) {
+	id, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, response.InvalidURLParameter, h.logger)
+		return 0, false
+	}
+	return id, true
+}
+
+// usecaseError maps errors returned by the hold usecase onto HTTP responses.
+func (h *HoldHandler) usecaseError(w http.ResponseWriter, err error) {
+	var notFound *model.ErrNotFound
+	var conflict *model.ErrConflict
+
+	switch {
+	case errors.As(err, &notFound):
+		response.ErrorResponse(w, http.StatusNotFound, notFound.Error(), h.logger)
+	case errors.As(err, &conflict):
+		response.ErrorResponse(w, http.StatusConflict, conflict.Error(), h.logger)
+	default:
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusInternalServerError, "Internal server error", h.logger)
+	}
+}
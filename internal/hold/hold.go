@@ -0,0 +1,39 @@
+package hold
+
+import (
+	"context"
+	"time"
+
+	"films_library/internal/model"
+	"films_library/pkg/pagination"
+)
+
+type (
+	// Usecase keeps the hold queues of films. Users see and cancel their own
+	// holds; managers any of them.
+	Usecase interface {
+		GetHolds(ctx context.Context, filter model.HoldFilter) ([]model.Hold, pagination.Page, error)
+		GetHold(ctx context.Context, user model.User, id uint64, manager bool) (model.Hold, error)
+		PlaceHold(ctx context.Context, user model.User, filmID uint64) (model.Hold, error)
+		CancelHold(ctx context.Context, user model.User, id uint64, manager bool) (model.Hold, error)
+
+		// CopyReturned sets a copy back on the shelf aside for the next
+		// hold on its film, if any.
+		CopyReturned(ctx context.Context, copyID uint64) error
+		// ExpireHolds expires the ready holds past their pickup window and
+		// passes their copies on. It is run periodically in the background.
+		ExpireHolds(ctx context.Context) error
+	}
+
+	// Repository sets copies aside for the first waiting hold on their film
+	// whenever one becomes free, for pickup within the given window.
+	Repository interface {
+		GetHolds(ctx context.Context, filter model.HoldFilter) ([]model.Hold, error)
+		CountHolds(ctx context.Context, filter model.HoldFilter) (int64, error)
+		GetHold(ctx context.Context, id uint64) (model.Hold, error)
+		PlaceHold(ctx context.Context, userID, filmID uint64, pickup time.Duration) (model.Hold, error)
+		CancelHold(ctx context.Context, id uint64, pickup time.Duration) (model.Hold, error)
+		AssignCopy(ctx context.Context, copyID uint64, pickup time.Duration) error
+		ExpireHolds(ctx context.Context, pickup time.Duration) (int64, error)
+	}
+)
@@ -0,0 +1,254 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/hold/hold.go
+
+// Package mock_hold is a generated GoMock package.
+package mock_hold
+
+import (
+	context "context"
+	model "films_library/internal/model"
+	pagination "films_library/pkg/pagination"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUsecase is a mock of Usecase interface.
+type MockUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseMockRecorder
+}
+
+// MockUsecaseMockRecorder is the mock recorder for MockUsecase.
+type MockUsecaseMockRecorder struct {
+	mock *MockUsecase
+}
+
+// NewMockUsecase creates a new mock instance.
+func NewMockUsecase(ctrl *gomock.Controller) *MockUsecase {
+	mock := &MockUsecase{ctrl: ctrl}
+	mock.recorder = &MockUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
+	return m.recorder
+}
+
+// CancelHold mocks base method.
+func (m *MockUsecase) CancelHold(ctx context.Context, user model.User, id uint64, manager bool) (model.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelHold", ctx, user, id, manager)
+	ret0, _ := ret[0].(model.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelHold indicates an expected call of CancelHold.
+func (mr *MockUsecaseMockRecorder) CancelHold(ctx, user, id, manager interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelHold", reflect.TypeOf((*MockUsecase)(nil).CancelHold), ctx, user, id, manager)
+}
+
+// CopyReturned mocks base method.
+func (m *MockUsecase) CopyReturned(ctx context.Context, copyID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyReturned", ctx, copyID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CopyReturned indicates an expected call of CopyReturned.
+func (mr *MockUsecaseMockRecorder) CopyReturned(ctx, copyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyReturned", reflect.TypeOf((*MockUsecase)(nil).CopyReturned), ctx, copyID)
+}
+
+// ExpireHolds mocks base method.
+func (m *MockUsecase) ExpireHolds(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireHolds", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExpireHolds indicates an expected call of ExpireHolds.
+func (mr *MockUsecaseMockRecorder) ExpireHolds(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireHolds", reflect.TypeOf((*MockUsecase)(nil).ExpireHolds), ctx)
+}
+
+// GetHold mocks base method.
+func (m *MockUsecase) GetHold(ctx context.Context, user model.User, id uint64, manager bool) (model.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHold", ctx, user, id, manager)
+	ret0, _ := ret[0].(model.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHold indicates an expected call of GetHold.
+func (mr *MockUsecaseMockRecorder) GetHold(ctx, user, id, manager interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHold", reflect.TypeOf((*MockUsecase)(nil).GetHold), ctx, user, id, manager)
+}
+
+// GetHolds mocks base method.
+func (m *MockUsecase) GetHolds(ctx context.Context, filter model.HoldFilter) ([]model.Hold, pagination.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHolds", ctx, filter)
+	ret0, _ := ret[0].([]model.Hold)
+	ret1, _ := ret[1].(pagination.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetHolds indicates an expected call of GetHolds.
+func (mr *MockUsecaseMockRecorder) GetHolds(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHolds", reflect.TypeOf((*MockUsecase)(nil).GetHolds), ctx, filter)
+}
+
+// PlaceHold mocks base method.
+func (m *MockUsecase) PlaceHold(ctx context.Context, user model.User, filmID uint64) (model.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaceHold", ctx, user, filmID)
+	ret0, _ := ret[0].(model.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlaceHold indicates an expected call of PlaceHold.
+func (mr *MockUsecaseMockRecorder) PlaceHold(ctx, user, filmID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaceHold", reflect.TypeOf((*MockUsecase)(nil).PlaceHold), ctx, user, filmID)
+}
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AssignCopy mocks base method.
+func (m *MockRepository) AssignCopy(ctx context.Context, copyID uint64, pickup time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignCopy", ctx, copyID, pickup)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssignCopy indicates an expected call of AssignCopy.
+func (mr *MockRepositoryMockRecorder) AssignCopy(ctx, copyID, pickup interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignCopy", reflect.TypeOf((*MockRepository)(nil).AssignCopy), ctx, copyID, pickup)
+}
+
+// CancelHold mocks base method.
+func (m *MockRepository) CancelHold(ctx context.Context, id uint64, pickup time.Duration) (model.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelHold", ctx, id, pickup)
+	ret0, _ := ret[0].(model.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelHold indicates an expected call of CancelHold.
+func (mr *MockRepositoryMockRecorder) CancelHold(ctx, id, pickup interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelHold", reflect.TypeOf((*MockRepository)(nil).CancelHold), ctx, id, pickup)
+}
+
+// CountHolds mocks base method.
+func (m *MockRepository) CountHolds(ctx context.Context, filter model.HoldFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountHolds", ctx, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountHolds indicates an expected call of CountHolds.
+func (mr *MockRepositoryMockRecorder) CountHolds(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountHolds", reflect.TypeOf((*MockRepository)(nil).CountHolds), ctx, filter)
+}
+
+// ExpireHolds mocks base method.
+func (m *MockRepository) ExpireHolds(ctx context.Context, pickup time.Duration) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireHolds", ctx, pickup)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireHolds indicates an expected call of ExpireHolds.
+func (mr *MockRepositoryMockRecorder) ExpireHolds(ctx, pickup interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireHolds", reflect.TypeOf((*MockRepository)(nil).ExpireHolds), ctx, pickup)
+}
+
+// GetHold mocks base method.
+func (m *MockRepository) GetHold(ctx context.Context, id uint64) (model.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHold", ctx, id)
+	ret0, _ := ret[0].(model.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHold indicates an expected call of GetHold.
+func (mr *MockRepositoryMockRecorder) GetHold(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHold", reflect.TypeOf((*MockRepository)(nil).GetHold), ctx, id)
+}
+
+// GetHolds mocks base method.
+func (m *MockRepository) GetHolds(ctx context.Context, filter model.HoldFilter) ([]model.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHolds", ctx, filter)
+	ret0, _ := ret[0].([]model.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHolds indicates an expected call of GetHolds.
+func (mr *MockRepositoryMockRecorder) GetHolds(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHolds", reflect.TypeOf((*MockRepository)(nil).GetHolds), ctx, filter)
+}
+
+// PlaceHold mocks base method.
+func (m *MockRepository) PlaceHold(ctx context.Context, userID, filmID uint64, pickup time.Duration) (model.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaceHold", ctx, userID, filmID, pickup)
+	ret0, _ := ret[0].(model.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlaceHold indicates an expected call of PlaceHold.
+func (mr *MockRepositoryMockRecorder) PlaceHold(ctx, userID, filmID, pickup interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaceHold", reflect.TypeOf((*MockRepository)(nil).PlaceHold), ctx, userID, filmID, pickup)
+}
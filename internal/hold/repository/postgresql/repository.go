@@ -0,0 +1,378 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"films_library/internal/model"
+	"films_library/pkg/pagination"
+	"films_library/pkg/postgres"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	foreignKeyViolation = "23503"
+	uniqueViolation     = "23505"
+
+	holdFilmForeignKey = "hold_film_id_fkey"
+	holdOpenIndex      = "hold_open_idx"
+	holdCopyIndex      = "hold_copy_idx"
+)
+
+// holdColumns counts the position of waiting holds in the queue of their film.
+const holdColumns = `h.hold_id, h.film_id, f.title, h.user_id, u.username, h.status,
+        CASE WHEN h.status = 'waiting' THEN (
+            SELECT count(*) FROM hold w
+            WHERE w.film_id = h.film_id AND w.status = 'waiting' AND w.hold_id <= h.hold_id)
+        ELSE 0 END,
+        h.copy_id, h.placed_at, h.ready_at, h.expires_at`
+
+const holdJoins = ` FROM hold h
+        JOIN film f ON f.film_id = h.film_id
+        JOIN users u ON u.user_id = h.user_id`
+
+// freeCopy is the condition on copy c of being in circulation, neither on
+// loan nor set aside for a hold.
+const freeCopy = `c.status = 'active'
+        AND NOT EXISTS (SELECT 1 FROM loan l WHERE l.copy_id = c.copy_id AND l.returned_at IS NULL)
+        AND NOT EXISTS (SELECT 1 FROM hold r WHERE r.copy_id = c.copy_id AND r.status = 'ready')`
+
+type Repository struct {
+	db postgres.DBConn
+}
+
+func NewRepository(db postgres.DBConn) *Repository {
+	return &Repository{db}
+}
+
+// GetHolds lists holds in the order they were placed.
+func (r *Repository) GetHolds(ctx context.Context, filter model.HoldFilter) ([]model.Hold, error) {
+	sqlQuery := `SELECT ` + holdColumns + holdJoins
+
+	backward := filter.Backward()
+
+	args, where := holdConditions(filter)
+	condition, clauses := filter.Keyset(pagination.Order{Key: "h.hold_id"}, &args)
+	if condition != "" {
+		where = append(where, condition)
+	}
+	for i, condition := range where {
+		if i == 0 {
+			sqlQuery += " WHERE " + condition
+		} else {
+			sqlQuery += " AND " + condition
+		}
+	}
+	sqlQuery += clauses
+
+	rows, err := r.db.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var holds []model.Hold
+	for rows.Next() {
+		h, err := scanHold(rows)
+		if err != nil {
+			return nil, err
+		}
+		holds = append(holds, h)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if backward {
+		slices.Reverse(holds)
+	}
+	return holds, nil
+}
+
+func (r *Repository) CountHolds(ctx context.Context, filter model.HoldFilter) (int64, error) {
+	sqlQuery := `SELECT count(*) FROM hold h`
+
+	args, where := holdConditions(filter)
+	for i, condition := range where {
+		if i == 0 {
+			sqlQuery += " WHERE " + condition
+		} else {
+			sqlQuery += " AND " + condition
+		}
+	}
+
+	var total int64
+	if err := r.db.QueryRow(ctx, sqlQuery, args...).Scan(&total); err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+func holdConditions(filter model.HoldFilter) ([]interface{}, []string) {
+	var args []interface{}
+	var where []string
+	if filter.FilmID != 0 {
+		args = append(args, filter.FilmID)
+		where = append(where, fmt.Sprintf("h.film_id = $%d", len(args)))
+	}
+	if filter.UserID != 0 {
+		args = append(args, filter.UserID)
+		where = append(where, fmt.Sprintf("h.user_id = $%d", len(args)))
+	}
+	if filter.Status != "" {
+		args = append(args, filter.Status)
+		where = append(where, fmt.Sprintf("h.status = $%d", len(args)))
+	}
+	return args, where
+}
+
+func (r *Repository) GetHold(ctx context.Context, id uint64) (model.Hold, error) {
+	return getHold(ctx, r.db, id)
+}
+
+// PlaceHold puts a user at the end of the queue of a film with lendable
+// copies. A free copy is set aside for the hold right away.
+func (r *Repository) PlaceHold(ctx context.Context, userID, filmID uint64, pickup time.Duration) (model.Hold, error) {
+	var placed model.Hold
+	err := r.db.BeginTxFunc(ctx, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		var id uint64
+		err := tx.QueryRow(ctx, `INSERT INTO hold (film_id, user_id) VALUES ($1, $2) RETURNING hold_id`, filmID, userID).Scan(&id)
+		if err != nil {
+			return holdError(err)
+		}
+
+		var copies int64
+		err = tx.QueryRow(ctx, `SELECT count(*) FROM copy WHERE film_id=$1 AND status='active'`, filmID).Scan(&copies)
+		if err != nil {
+			return err
+		}
+		if copies == 0 {
+			return &model.ErrConflict{Message: "film has no lendable copies"}
+		}
+
+		if err := assignFreeCopies(ctx, tx, filmID, pickup); err != nil {
+			return err
+		}
+
+		placed, err = getHold(ctx, tx, id)
+		return err
+	})
+	if err != nil {
+		return model.Hold{}, err
+	}
+	return placed, nil
+}
+
+// CancelHold closes an open hold. The copy set aside for it, if any, goes to
+// the next hold.
+func (r *Repository) CancelHold(ctx context.Context, id uint64, pickup time.Duration) (model.Hold, error) {
+	var cancelled model.Hold
+	err := r.db.BeginTxFunc(ctx, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		// The copy set aside for the hold is locked before the hold, as
+		// Checkout does.
+		var copyID *uint64
+		err := tx.QueryRow(ctx, `SELECT copy_id FROM hold WHERE hold_id=$1`, id).Scan(&copyID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return &model.ErrNotFound{Message: "hold not found"}
+			}
+			return err
+		}
+		if copyID != nil {
+			if err := lockCopy(ctx, tx, *copyID); err != nil {
+				return err
+			}
+		}
+
+		var status string
+		err = tx.QueryRow(ctx, `SELECT status, copy_id FROM hold WHERE hold_id=$1 FOR UPDATE`, id).Scan(&status, &copyID)
+		if err != nil {
+			return err
+		}
+		if status != model.HoldWaiting && status != model.HoldReady {
+			return &model.ErrConflict{Message: "hold is already closed"}
+		}
+
+		if _, err := tx.Exec(ctx, `UPDATE hold SET status='cancelled', closed_at=now() WHERE hold_id=$1`, id); err != nil {
+			return err
+		}
+		if status == model.HoldReady && copyID != nil {
+			if err := assignCopy(ctx, tx, *copyID, pickup); err != nil {
+				return err
+			}
+		}
+
+		cancelled, err = getHold(ctx, tx, id)
+		return err
+	})
+	if err != nil {
+		return model.Hold{}, err
+	}
+	return cancelled, nil
+}
+
+func (r *Repository) AssignCopy(ctx context.Context, copyID uint64, pickup time.Duration) error {
+	return r.db.BeginTxFunc(ctx, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		return assignCopy(ctx, tx, copyID, pickup)
+	})
+}
+
+// ExpireHolds expires the ready holds past their pickup window and returns
+// how many expired. Their copies, and any other free copy of a film with a
+// queue, go to the next holds.
+func (r *Repository) ExpireHolds(ctx context.Context, pickup time.Duration) (int64, error) {
+	var expired int64
+	err := r.db.BeginTxFunc(ctx, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		// The copies are locked before their holds, as Checkout does.
+		_, err := tx.Exec(ctx, `
+            SELECT 1 FROM copy
+            WHERE copy_id IN (SELECT copy_id FROM hold WHERE status='ready' AND expires_at < now())
+            ORDER BY copy_id
+            FOR UPDATE`)
+		if err != nil {
+			return err
+		}
+
+		res, err := tx.Exec(ctx, `UPDATE hold SET status='expired', closed_at=now() WHERE status='ready' AND expires_at < now()`)
+		if err != nil {
+			return err
+		}
+		expired = res.RowsAffected()
+
+		return assignFreeCopies(ctx, tx, 0, pickup)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return expired, nil
+}
+
+// assignCopy sets a free copy aside for the first waiting hold on its film.
+// Nothing happens when the copy is not free or nobody waits for the film. The
+// copy is locked first, as Checkout does, so that it is not lent out while
+// it is set aside.
+func assignCopy(ctx context.Context, tx pgx.Tx, copyID uint64, pickup time.Duration) error {
+	if err := lockCopy(ctx, tx, copyID); err != nil {
+		return err
+	}
+
+	sqlQuery := `
+        UPDATE hold SET status='ready', copy_id=$1, ready_at=now(), expires_at=now() + $2 * interval '1 second'
+        WHERE hold_id = (
+            SELECT h.hold_id FROM hold h
+            JOIN copy c ON c.film_id = h.film_id
+            WHERE c.copy_id = $1 AND h.status = 'waiting' AND ` + freeCopy + `
+            ORDER BY h.hold_id LIMIT 1
+            FOR UPDATE OF h SKIP LOCKED)`
+
+	if _, err := tx.Exec(ctx, sqlQuery, copyID, int64(pickup.Seconds())); err != nil {
+		return holdError(err)
+	}
+	return nil
+}
+
+// lockCopy locks a copy until the end of the transaction. Missing copies are
+// not an error.
+func lockCopy(ctx context.Context, tx pgx.Tx, copyID uint64) error {
+	_, err := tx.Exec(ctx, `SELECT 1 FROM copy WHERE copy_id=$1 FOR UPDATE`, copyID)
+	return err
+}
+
+// assignFreeCopies sets the free copies of films with waiting holds aside
+// for them, of the film filmID only when it is set.
+func assignFreeCopies(ctx context.Context, tx pgx.Tx, filmID uint64, pickup time.Duration) error {
+	sqlQuery := `
+        SELECT c.copy_id FROM copy c
+        WHERE ($1 = 0 OR c.film_id = $1) AND ` + freeCopy + `
+            AND EXISTS (SELECT 1 FROM hold h WHERE h.film_id = c.film_id AND h.status = 'waiting')
+        ORDER BY c.copy_id`
+
+	rows, err := tx.Query(ctx, sqlQuery, filmID)
+	if err != nil {
+		return err
+	}
+	var copyIDs []uint64
+	for rows.Next() {
+		var copyID uint64
+		if err := rows.Scan(&copyID); err != nil {
+			rows.Close()
+			return err
+		}
+		copyIDs = append(copyIDs, copyID)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, copyID := range copyIDs {
+		if err := assignCopy(ctx, tx, copyID, pickup); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// querier is what getHold needs of a pool or a transaction.
+type querier interface {
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+}
+
+func getHold(ctx context.Context, q querier, id uint64) (model.Hold, error) {
+	sqlQuery := `SELECT ` + holdColumns + holdJoins + ` WHERE h.hold_id=$1`
+
+	h, err := scanHold(q.QueryRow(ctx, sqlQuery, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Hold{}, &model.ErrNotFound{Message: "hold not found"}
+		}
+		return model.Hold{}, err
+	}
+	return h, nil
+}
+
+func scanHold(row pgx.Row) (model.Hold, error) {
+	var h model.Hold
+	err := row.Scan(
+		&h.ID,
+		&h.FilmID,
+		&h.Title,
+		&h.UserID,
+		&h.Username,
+		&h.Status,
+		&h.Position,
+		&h.CopyID,
+		&h.PlacedAt,
+		&h.ReadyAt,
+		&h.ExpiresAt,
+	)
+	return h, err
+}
+
+func holdError(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+
+	switch pgErr.Code {
+	case foreignKeyViolation:
+		if pgErr.ConstraintName == holdFilmForeignKey {
+			return &model.ErrNotFound{Message: "film not found"}
+		}
+		return &model.ErrNotFound{Message: "user not found"}
+	case uniqueViolation:
+		switch pgErr.ConstraintName {
+		case holdOpenIndex:
+			return &model.ErrConflict{Message: "film is already held by user"}
+		case holdCopyIndex:
+			return &model.ErrConflict{Message: "copy is already set aside for another hold"}
+		}
+	}
+	return err
+}
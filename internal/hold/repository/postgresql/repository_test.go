@@ -0,0 +1,97 @@
+package postgresql
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"films_library/internal/model"
+
+	"github.com/jackc/pgconn"
+	"github.com/pashagolub/pgxmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlaceHoldNeedsLendableCopies(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mock.Close()
+
+	repo := NewRepository(mock)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO hold (film_id, user_id) VALUES ($1, $2) RETURNING hold_id`)).
+		WithArgs(uint64(1), uint64(3)).
+		WillReturnRows(pgxmock.NewRows([]string{"hold_id"}).AddRow(uint64(5)))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM copy WHERE film_id=$1 AND status='active'`)).
+		WithArgs(uint64(1)).
+		WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(int64(0)))
+	// pgxmock's BeginTxFunc rolls back once on the error and again in its
+	// deferred cleanup.
+	mock.ExpectRollback()
+	mock.ExpectRollback()
+
+	_, err = repo.PlaceHold(context.Background(), 3, 1, 72*time.Hour)
+	assert.Equal(t, &model.ErrConflict{Message: "film has no lendable copies"}, err)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestCancelHoldRejectsClosedHolds(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mock.Close()
+
+	repo := NewRepository(mock)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT copy_id FROM hold WHERE hold_id=$1`)).
+		WithArgs(uint64(5)).
+		WillReturnRows(pgxmock.NewRows([]string{"copy_id"}).AddRow(nil))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT status, copy_id FROM hold WHERE hold_id=$1 FOR UPDATE`)).
+		WithArgs(uint64(5)).
+		WillReturnRows(pgxmock.NewRows([]string{"status", "copy_id"}).AddRow(model.HoldCollected, nil))
+	mock.ExpectRollback()
+	mock.ExpectRollback()
+
+	_, err = repo.CancelHold(context.Background(), 5, 72*time.Hour)
+	assert.Equal(t, &model.ErrConflict{Message: "hold is already closed"}, err)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestAssignCopyLocksCopyFirst(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mock.Close()
+
+	repo := NewRepository(mock)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`SELECT 1 FROM copy WHERE copy_id=$1 FOR UPDATE`)).
+		WithArgs(uint64(4)).
+		WillReturnResult(pgxmock.NewResult("SELECT", 1))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE hold SET status='ready', copy_id=$1`)).
+		WithArgs(uint64(4), int64(3600)).
+		WillReturnError(&pgconn.PgError{Code: uniqueViolation, ConstraintName: holdCopyIndex})
+	mock.ExpectRollback()
+	mock.ExpectRollback()
+
+	err = repo.AssignCopy(context.Background(), 4, time.Hour)
+	assert.Equal(t, &model.ErrConflict{Message: "copy is already set aside for another hold"}, err)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
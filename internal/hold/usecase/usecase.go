@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"films_library/internal/hold"
+	"films_library/internal/model"
+	"films_library/pkg/logger"
+	"films_library/pkg/pagination"
+)
+
+type HoldUsecase struct {
+	holdRepo hold.Repository
+	pickup   time.Duration
+	logger   logger.Interface
+}
+
+func NewHoldUsecase(hr hold.Repository, pickup time.Duration, l logger.Interface) *HoldUsecase {
+	return &HoldUsecase{hr, pickup, l}
+}
+
+func (hu *HoldUsecase) GetHolds(ctx context.Context, filter model.HoldFilter) ([]model.Hold, pagination.Page, error) {
+	holds, err := hu.holdRepo.GetHolds(ctx, filter)
+	if err != nil {
+		return []model.Hold{}, pagination.Page{}, err
+	}
+
+	holds, page := pagination.Paginate(holds, filter.Params, func(h model.Hold) pagination.Cursor {
+		return pagination.Cursor{ID: h.ID}
+	})
+
+	if filter.WithTotal {
+		total, err := hu.holdRepo.CountHolds(ctx, filter)
+		if err != nil {
+			return []model.Hold{}, pagination.Page{}, err
+		}
+		page.Total = &total
+	}
+	return holds, page, nil
+}
+
+func (hu *HoldUsecase) GetHold(ctx context.Context, user model.User, id uint64, manager bool) (model.Hold, error) {
+	return hu.visible(ctx, user, id, manager)
+}
+
+func (hu *HoldUsecase) PlaceHold(ctx context.Context, user model.User, filmID uint64) (model.Hold, error) {
+	return hu.holdRepo.PlaceHold(ctx, user.ID, filmID, hu.pickup)
+}
+
+func (hu *HoldUsecase) CancelHold(ctx context.Context, user model.User, id uint64, manager bool) (model.Hold, error) {
+	if _, err := hu.visible(ctx, user, id, manager); err != nil {
+		return model.Hold{}, err
+	}
+	return hu.holdRepo.CancelHold(ctx, id, hu.pickup)
+}
+
+func (hu *HoldUsecase) CopyReturned(ctx context.Context, copyID uint64) error {
+	return hu.holdRepo.AssignCopy(ctx, copyID, hu.pickup)
+}
+
+func (hu *HoldUsecase) ExpireHolds(ctx context.Context) error {
+	expired, err := hu.holdRepo.ExpireHolds(ctx, hu.pickup)
+	if err != nil {
+		return err
+	}
+	if expired > 0 {
+		hu.logger.Info(fmt.Sprintf("holds - ExpireHolds: %d holds expired", expired))
+	}
+	return nil
+}
+
+// visible returns a hold of user, or any hold for managers. Holds of other
+// users are reported as missing.
+func (hu *HoldUsecase) visible(ctx context.Context, user model.User, id uint64, manager bool) (model.Hold, error) {
+	h, err := hu.holdRepo.GetHold(ctx, id)
+	if err != nil {
+		return model.Hold{}, err
+	}
+	if h.UserID != user.ID && !manager {
+		return model.Hold{}, &model.ErrNotFound{Message: "hold not found"}
+	}
+	return h, nil
+}
@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mock_hold "films_library/internal/hold/mocks"
+	"films_library/internal/model"
+	"films_library/pkg/logger"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHoldUsecase_CancelHold(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	loggerMock := logger.NewMockInterface(ctrl)
+	holdRepo := mock_hold.NewMockRepository(ctrl)
+	usecase := NewHoldUsecase(holdRepo, 72*time.Hour, loggerMock)
+
+	ctx := context.Background()
+	stored := model.Hold{ID: 5, FilmID: 1, UserID: 3, Status: model.HoldWaiting, Position: 2}
+
+	testCases := []struct {
+		name          string
+		user          model.User
+		manager       bool
+		expectCancel  bool
+		expectedError error
+	}{
+		{
+			name:         "Holder",
+			user:         model.User{ID: 3},
+			expectCancel: true,
+		},
+		{
+			name:         "Manager cancels any hold",
+			user:         model.User{ID: 9},
+			manager:      true,
+			expectCancel: true,
+		},
+		{
+			name:          "Another user does not see the hold",
+			user:          model.User{ID: 4},
+			expectedError: &model.ErrNotFound{Message: "hold not found"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			holdRepo.EXPECT().GetHold(ctx, stored.ID).Return(stored, nil)
+			if tc.expectCancel {
+				cancelled := stored
+				cancelled.Status, cancelled.Position = model.HoldCancelled, 0
+				holdRepo.EXPECT().CancelHold(ctx, stored.ID, 72*time.Hour).Return(cancelled, nil)
+			}
+
+			_, err := usecase.CancelHold(ctx, tc.user, stored.ID, tc.manager)
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
+
+func TestHoldUsecase_ExpireHolds(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	loggerMock := logger.NewMockInterface(ctrl)
+	holdRepo := mock_hold.NewMockRepository(ctrl)
+	usecase := NewHoldUsecase(holdRepo, time.Hour, loggerMock)
+
+	ctx := context.Background()
+	holdRepo.EXPECT().ExpireHolds(ctx, time.Hour).Return(int64(2), nil)
+	loggerMock.EXPECT().Info("holds - ExpireHolds: 2 holds expired")
+
+	assert.NoError(t, usecase.ExpireHolds(ctx))
+}
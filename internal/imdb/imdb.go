@@ -0,0 +1,36 @@
+package imdb
+
+import (
+	"context"
+	"io/fs"
+
+	"films_library/internal/model"
+)
+
+type (
+	// Usecase imports films, actors and their credits from the IMDb
+	// non-commercial datasets.
+	Usecase interface {
+		// Import reads the dataset files in fsys, gzipped or not. Films and
+		// actors are matched on their IMDb IDs, so imports can be rerun; an
+		// import that stopped resumes where it left off unless restart is set.
+		Import(ctx context.Context, fsys fs.FS, filter model.IMDbFilter, restart bool) ([]model.IMDbProgress, error)
+	}
+
+	Repository interface {
+		// GetCheckpoint returns the checkpoint of dataset, or a zero one.
+		GetCheckpoint(ctx context.Context, dataset string) (model.IMDbCheckpoint, error)
+		SaveCheckpoint(ctx context.Context, cp model.IMDbCheckpoint) error
+
+		// UpsertFilms, UpsertActors and LinkCast store a batch and the
+		// checkpoint after it in one transaction.
+		UpsertFilms(ctx context.Context, films []model.IMDbTitle, cp model.IMDbCheckpoint) error
+		UpsertActors(ctx context.Context, actors []model.IMDbName, cp model.IMDbCheckpoint) error
+		LinkCast(ctx context.Context, credits []model.IMDbCredit, cp model.IMDbCheckpoint) error
+
+		// FilmIDs and ActorIDs map the numbers of IMDb IDs, 133093 for
+		// tt0133093, to the films and actors with IMDb IDs.
+		FilmIDs(ctx context.Context) (map[uint32]uint64, error)
+		ActorIDs(ctx context.Context) (map[uint32]uint64, error)
+	}
+)
@@ -0,0 +1,176 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/imdb/imdb.go
+
+// Package mock_imdb is a generated GoMock package.
+package mock_imdb
+
+import (
+	context "context"
+	model "films_library/internal/model"
+	fs "io/fs"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUsecase is a mock of Usecase interface.
+type MockUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseMockRecorder
+}
+
+// MockUsecaseMockRecorder is the mock recorder for MockUsecase.
+type MockUsecaseMockRecorder struct {
+	mock *MockUsecase
+}
+
+// NewMockUsecase creates a new mock instance.
+func NewMockUsecase(ctrl *gomock.Controller) *MockUsecase {
+	mock := &MockUsecase{ctrl: ctrl}
+	mock.recorder = &MockUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Import mocks base method.
+func (m *MockUsecase) Import(ctx context.Context, fsys fs.FS, filter model.IMDbFilter, restart bool) ([]model.IMDbProgress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", ctx, fsys, filter, restart)
+	ret0, _ := ret[0].([]model.IMDbProgress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockUsecaseMockRecorder) Import(ctx, fsys, filter, restart interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockUsecase)(nil).Import), ctx, fsys, filter, restart)
+}
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ActorIDs mocks base method.
+func (m *MockRepository) ActorIDs(ctx context.Context) (map[uint32]uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActorIDs", ctx)
+	ret0, _ := ret[0].(map[uint32]uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActorIDs indicates an expected call of ActorIDs.
+func (mr *MockRepositoryMockRecorder) ActorIDs(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActorIDs", reflect.TypeOf((*MockRepository)(nil).ActorIDs), ctx)
+}
+
+// FilmIDs mocks base method.
+func (m *MockRepository) FilmIDs(ctx context.Context) (map[uint32]uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilmIDs", ctx)
+	ret0, _ := ret[0].(map[uint32]uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilmIDs indicates an expected call of FilmIDs.
+func (mr *MockRepositoryMockRecorder) FilmIDs(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilmIDs", reflect.TypeOf((*MockRepository)(nil).FilmIDs), ctx)
+}
+
+// GetCheckpoint mocks base method.
+func (m *MockRepository) GetCheckpoint(ctx context.Context, dataset string) (model.IMDbCheckpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCheckpoint", ctx, dataset)
+	ret0, _ := ret[0].(model.IMDbCheckpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCheckpoint indicates an expected call of GetCheckpoint.
+func (mr *MockRepositoryMockRecorder) GetCheckpoint(ctx, dataset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCheckpoint", reflect.TypeOf((*MockRepository)(nil).GetCheckpoint), ctx, dataset)
+}
+
+// LinkCast mocks base method.
+func (m *MockRepository) LinkCast(ctx context.Context, credits []model.IMDbCredit, cp model.IMDbCheckpoint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LinkCast", ctx, credits, cp)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LinkCast indicates an expected call of LinkCast.
+func (mr *MockRepositoryMockRecorder) LinkCast(ctx, credits, cp interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkCast", reflect.TypeOf((*MockRepository)(nil).LinkCast), ctx, credits, cp)
+}
+
+// SaveCheckpoint mocks base method.
+func (m *MockRepository) SaveCheckpoint(ctx context.Context, cp model.IMDbCheckpoint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveCheckpoint", ctx, cp)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveCheckpoint indicates an expected call of SaveCheckpoint.
+func (mr *MockRepositoryMockRecorder) SaveCheckpoint(ctx, cp interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveCheckpoint", reflect.TypeOf((*MockRepository)(nil).SaveCheckpoint), ctx, cp)
+}
+
+// UpsertActors mocks base method.
+func (m *MockRepository) UpsertActors(ctx context.Context, actors []model.IMDbName, cp model.IMDbCheckpoint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertActors", ctx, actors, cp)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertActors indicates an expected call of UpsertActors.
+func (mr *MockRepositoryMockRecorder) UpsertActors(ctx, actors, cp interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertActors", reflect.TypeOf((*MockRepository)(nil).UpsertActors), ctx, actors, cp)
+}
+
+// UpsertFilms mocks base method.
+func (m *MockRepository) UpsertFilms(ctx context.Context, films []model.IMDbTitle, cp model.IMDbCheckpoint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertFilms", ctx, films, cp)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertFilms indicates an expected call of UpsertFilms.
+func (mr *MockRepositoryMockRecorder) UpsertFilms(ctx, films, cp interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertFilms", reflect.TypeOf((*MockRepository)(nil).UpsertFilms), ctx, films, cp)
+}
@@ -0,0 +1,215 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"films_library/internal/model"
+	"films_library/pkg/postgres"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+)
+
+// characterSeparator joins the characters of a credit into one array
+// element, as unnest cannot take arrays of arrays of different lengths.
+const characterSeparator = "\x1f"
+
+type Repository struct {
+	db postgres.DBConn
+}
+
+func NewRepository(db postgres.DBConn) *Repository {
+	return &Repository{db}
+}
+
+// execer runs statements on the pool or in a transaction.
+type execer interface {
+	Exec(ctx context.Context, sql string, arguments ...interface{}) (pgconn.CommandTag, error)
+}
+
+func (r *Repository) GetCheckpoint(ctx context.Context, dataset string) (model.IMDbCheckpoint, error) {
+	sqlQuery := `SELECT dataset, source, filters, lines_done, done FROM imdb_import WHERE dataset=$1`
+
+	var cp model.IMDbCheckpoint
+	err := r.db.QueryRow(ctx, sqlQuery, dataset).Scan(&cp.Dataset, &cp.Source, &cp.Filters, &cp.LinesDone, &cp.Done)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.IMDbCheckpoint{Dataset: dataset}, nil
+	}
+	return cp, err
+}
+
+func (r *Repository) SaveCheckpoint(ctx context.Context, cp model.IMDbCheckpoint) error {
+	return saveCheckpoint(ctx, r.db, cp)
+}
+
+func saveCheckpoint(ctx context.Context, db execer, cp model.IMDbCheckpoint) error {
+	sqlQuery := `
+        INSERT INTO imdb_import (dataset, source, filters, lines_done, done, updated_at)
+        VALUES ($1, $2, $3, $4, $5, now())
+        ON CONFLICT (dataset) DO UPDATE SET
+            source = EXCLUDED.source,
+            filters = EXCLUDED.filters,
+            lines_done = EXCLUDED.lines_done,
+            done = EXCLUDED.done,
+            updated_at = now()`
+
+	_, err := db.Exec(ctx, sqlQuery, cp.Dataset, cp.Source, cp.Filters, cp.LinesDone, cp.Done)
+	return err
+}
+
+// UpsertFilms adds new films with their IMDb IDs and updates those imported
+// before, leaving unchanged rows alone.
+func (r *Repository) UpsertFilms(ctx context.Context, films []model.IMDbTitle, cp model.IMDbCheckpoint) error {
+	sqlQuery := `
+        WITH t AS (
+            SELECT t.*, x.film_id
+            FROM unnest($1::text[], $2::text[], $3::date[], $4::int[]) AS t(imdb_id, title, release_date, rating)
+            LEFT JOIN film_external_id x ON x.provider = 'imdb' AND x.external_id = t.imdb_id
+        ), added AS (
+            SELECT nextval(pg_get_serial_sequence('film', 'film_id')) AS film_id, imdb_id, title, release_date, rating
+            FROM t WHERE film_id IS NULL
+        ), films AS (
+            INSERT INTO film (film_id, title, "description", release_date, rating)
+            SELECT film_id, title, '', release_date, rating FROM added
+        ), ids AS (
+            INSERT INTO film_external_id (film_id, provider, external_id)
+            SELECT film_id, 'imdb', imdb_id FROM added
+        )
+        UPDATE film f SET
+            title = t.title,
+            release_date = t.release_date,
+            rating = t.rating
+        FROM t
+        WHERE f.film_id = t.film_id
+            AND (f.title, f.release_date, f.rating) IS DISTINCT FROM (t.title, t.release_date, t.rating)`
+
+	ids := make([]string, 0, len(films))
+	titles := make([]string, 0, len(films))
+	dates := make([]interface{}, 0, len(films))
+	ratings := make([]*int, 0, len(films))
+	for _, film := range films {
+		ids = append(ids, film.ID)
+		titles = append(titles, film.Title)
+		dates = append(dates, film.ReleaseDate)
+		ratings = append(ratings, film.Rating)
+	}
+
+	return r.db.BeginTxFunc(ctx, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		if len(films) > 0 {
+			if _, err := tx.Exec(ctx, sqlQuery, ids, titles, dates, ratings); err != nil {
+				return err
+			}
+		}
+		return saveCheckpoint(ctx, tx, cp)
+	})
+}
+
+// UpsertActors adds new actors with their IMDb IDs and updates those
+// imported before. Actors already in the library keep their sex.
+func (r *Repository) UpsertActors(ctx context.Context, actors []model.IMDbName, cp model.IMDbCheckpoint) error {
+	sqlQuery := `
+        WITH t AS (
+            SELECT t.*, x.actor_id
+            FROM unnest($1::text[], $2::text[], $3::text[], $4::date[]) AS t(imdb_id, "name", sex, birth_date)
+            LEFT JOIN actor_external_id x ON x.provider = 'imdb' AND x.external_id = t.imdb_id
+        ), added AS (
+            SELECT nextval(pg_get_serial_sequence('actor', 'actor_id')) AS actor_id, imdb_id, "name", sex, birth_date
+            FROM t WHERE actor_id IS NULL
+        ), actors AS (
+            INSERT INTO actor (actor_id, "name", sex, birth_date)
+            SELECT actor_id, "name", sex, birth_date FROM added
+        ), ids AS (
+            INSERT INTO actor_external_id (actor_id, provider, external_id)
+            SELECT actor_id, 'imdb', imdb_id FROM added
+        )
+        UPDATE actor a SET
+            "name" = t."name",
+            birth_date = t.birth_date
+        FROM t
+        WHERE a.actor_id = t.actor_id
+            AND (a."name", a.birth_date) IS DISTINCT FROM (t."name", t.birth_date)`
+
+	ids := make([]string, 0, len(actors))
+	names := make([]string, 0, len(actors))
+	sexes := make([]string, 0, len(actors))
+	birthDates := make([]interface{}, 0, len(actors))
+	for _, actor := range actors {
+		ids = append(ids, actor.ID)
+		names = append(names, actor.Name)
+		sexes = append(sexes, actor.Sex)
+		if actor.BirthDate != nil {
+			birthDates = append(birthDates, *actor.BirthDate)
+		} else {
+			birthDates = append(birthDates, nil)
+		}
+	}
+
+	return r.db.BeginTxFunc(ctx, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		if len(actors) > 0 {
+			if _, err := tx.Exec(ctx, sqlQuery, ids, names, sexes, birthDates); err != nil {
+				return err
+			}
+		}
+		return saveCheckpoint(ctx, tx, cp)
+	})
+}
+
+// LinkCast adds credits the films do not have yet.
+func (r *Repository) LinkCast(ctx context.Context, credits []model.IMDbCredit, cp model.IMDbCheckpoint) error {
+	sqlQuery := `
+        INSERT INTO film_actor (film_id, actor_id, billing, characters)
+        SELECT film_id, actor_id, NULLIF(billing, 0), COALESCE(string_to_array(NULLIF(characters, ''), E'\x1f'), '{}')
+        FROM unnest($1::bigint[], $2::bigint[], $3::int[], $4::text[]) AS c(film_id, actor_id, billing, characters)
+        ON CONFLICT (film_id, actor_id) DO NOTHING`
+
+	filmIDs := make([]uint64, 0, len(credits))
+	actorIDs := make([]uint64, 0, len(credits))
+	billings := make([]int, 0, len(credits))
+	characters := make([]string, 0, len(credits))
+	for _, credit := range credits {
+		filmIDs = append(filmIDs, credit.FilmID)
+		actorIDs = append(actorIDs, credit.ActorID)
+		billings = append(billings, credit.Billing)
+		characters = append(characters, strings.Join(credit.Characters, characterSeparator))
+	}
+
+	return r.db.BeginTxFunc(ctx, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		if len(credits) > 0 {
+			if _, err := tx.Exec(ctx, sqlQuery, filmIDs, actorIDs, billings, characters); err != nil {
+				return err
+			}
+		}
+		return saveCheckpoint(ctx, tx, cp)
+	})
+}
+
+func (r *Repository) FilmIDs(ctx context.Context) (map[uint32]uint64, error) {
+	return r.imdbIDs(ctx, `SELECT substr(external_id, 3)::int, film_id FROM film_external_id WHERE provider = 'imdb'`)
+}
+
+func (r *Repository) ActorIDs(ctx context.Context) (map[uint32]uint64, error) {
+	return r.imdbIDs(ctx, `SELECT substr(external_id, 3)::int, actor_id FROM actor_external_id WHERE provider = 'imdb'`)
+}
+
+func (r *Repository) imdbIDs(ctx context.Context, sqlQuery string) (map[uint32]uint64, error) {
+	rows, err := r.db.Query(ctx, sqlQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := make(map[uint32]uint64)
+	for rows.Next() {
+		var (
+			number uint32
+			id     uint64
+		)
+		if err := rows.Scan(&number, &id); err != nil {
+			return nil, err
+		}
+		ids[number] = id
+	}
+	return ids, rows.Err()
+}
@@ -0,0 +1,59 @@
+package postgresql
+
+import (
+	"context"
+	"testing"
+
+	"films_library/internal/model"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/pashagolub/pgxmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetCheckpointDefaultsToZero(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mock.Close()
+
+	repo := NewRepository(mock)
+
+	mock.ExpectQuery(`SELECT dataset, source, filters, lines_done, done FROM imdb_import`).
+		WithArgs(model.IMDbTitles).
+		WillReturnError(pgx.ErrNoRows)
+
+	cp, err := repo.GetCheckpoint(context.Background(), model.IMDbTitles)
+	assert.NoError(t, err)
+	assert.Equal(t, model.IMDbCheckpoint{Dataset: model.IMDbTitles}, cp)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestLinkCastSavesCheckpoint(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mock.Close()
+
+	repo := NewRepository(mock)
+
+	credits := []model.IMDbCredit{
+		{FilmID: 1, ActorID: 10, Billing: 1, Characters: []string{"Neo", "Thomas Anderson"}},
+		{FilmID: 1, ActorID: 11},
+	}
+	cp := model.IMDbCheckpoint{Dataset: model.IMDbPrincipals, Source: "title.principals.tsv.gz", Filters: "types=movie", LinesDone: 40}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT INTO film_actor`).
+		WithArgs([]uint64{1, 1}, []uint64{10, 11}, []int{1, 0}, []string{"Neo\x1fThomas Anderson", ""}).
+		WillReturnResult(pgxmock.NewResult("INSERT", 2))
+	mock.ExpectExec(`INSERT INTO imdb_import`).
+		WithArgs(cp.Dataset, cp.Source, cp.Filters, cp.LinesDone, false).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectCommit()
+
+	assert.NoError(t, repo.LinkCast(context.Background(), credits, cp))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
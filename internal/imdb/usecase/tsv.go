@@ -0,0 +1,129 @@
+package usecase
+
+import (
+	"bufio"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"strings"
+)
+
+// maxLine is the longest dataset line read.
+const maxLine = 1 << 20
+
+// dataset reads an IMDb dataset file: tab-separated, with a header row and
+// \N for missing values.
+type dataset struct {
+	name    string
+	source  string
+	columns map[string]int
+	closers []io.Closer
+	lines   *bufio.Scanner
+	line    int64
+	fields  []string
+}
+
+// openDataset opens name.tsv.gz, or name.tsv, in fsys and checks that it has
+// the given columns. It returns an error wrapping fs.ErrNotExist if there is
+// neither file.
+func openDataset(fsys fs.FS, name string, columns ...string) (*dataset, error) {
+	d := &dataset{name: name}
+
+	var r io.Reader
+	f, err := fsys.Open(name + ".tsv.gz")
+	if err == nil {
+		d.closers = append(d.closers, f)
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			d.Close()
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		d.closers = append(d.closers, gz)
+		r = gz
+	} else if errors.Is(err, fs.ErrNotExist) {
+		if f, err = fsys.Open(name + ".tsv"); err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		d.closers = append(d.closers, f)
+		r = f
+	} else {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		d.Close()
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	d.source = sourceOf(info)
+
+	d.lines = bufio.NewScanner(r)
+	d.lines.Buffer(make([]byte, 0, 64*1024), maxLine)
+	if !d.lines.Scan() {
+		d.Close()
+		if err := d.lines.Err(); err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		return nil, fmt.Errorf("%s: missing header", name)
+	}
+
+	header := strings.Split(d.lines.Text(), "\t")
+	d.columns = make(map[string]int, len(header))
+	for i, column := range header {
+		d.columns[column] = i
+	}
+	for _, column := range columns {
+		if _, ok := d.columns[column]; !ok {
+			d.Close()
+			return nil, fmt.Errorf("%s: missing column %q", name, column)
+		}
+	}
+	return d, nil
+}
+
+// sourceOf identifies a dataset file in checkpoints, so that a replaced file
+// is imported from the start.
+func sourceOf(info fs.FileInfo) string {
+	return fmt.Sprintf("%s size=%d modified=%d", info.Name(), info.Size(), info.ModTime().Unix())
+}
+
+// Next advances to the next line. Lines are numbered from 1 after the
+// header.
+func (d *dataset) Next() bool {
+	d.fields = nil
+	if !d.lines.Scan() {
+		return false
+	}
+	d.line++
+	return true
+}
+
+// Field returns a column of the current line, or "" if it is missing. Lines
+// are only split once a field is read, so skipping lines is cheap.
+func (d *dataset) Field(column string) string {
+	if d.fields == nil {
+		d.fields = strings.Split(d.lines.Text(), "\t")
+	}
+	i := d.columns[column]
+	if i >= len(d.fields) || d.fields[i] == `\N` {
+		return ""
+	}
+	return d.fields[i]
+}
+
+func (d *dataset) Err() error {
+	if err := d.lines.Err(); err != nil {
+		return fmt.Errorf("%s line %d: %w", d.name, d.line+1, err)
+	}
+	return nil
+}
+
+func (d *dataset) Close() error {
+	var err error
+	for i := len(d.closers) - 1; i >= 0; i-- {
+		err = errors.Join(err, d.closers[i].Close())
+	}
+	return err
+}
@@ -0,0 +1,361 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"films_library/internal/imdb"
+	"films_library/internal/model"
+	"films_library/pkg/logger"
+)
+
+// Principals credited in these categories are imported as actors.
+var castCategories = map[string]string{
+	"actor":   "M",
+	"actress": "W",
+}
+
+type IMDbUsecase struct {
+	imdbRepo  imdb.Repository
+	batchSize int
+	logger    logger.Interface
+}
+
+// NewIMDbUsecase returns a usecase storing rows batchSize at a time, or all
+// in one batch if batchSize is 0.
+func NewIMDbUsecase(ir imdb.Repository, batchSize int, l logger.Interface) *IMDbUsecase {
+	return &IMDbUsecase{ir, batchSize, l}
+}
+
+// rating is the IMDb rating of a title, rounded, and its number of votes.
+type rating struct {
+	rating int8
+	votes  int32
+}
+
+// Import imports titles, then the actors credited in them, then their cast.
+// Once a dataset is read again, those after it are read again in full, as
+// the films or actors they were matched against may have changed.
+func (iu *IMDbUsecase) Import(ctx context.Context, fsys fs.FS, filter model.IMDbFilter, restart bool) ([]model.IMDbProgress, error) {
+	ratings, err := readRatings(fsys, filter)
+	if err != nil {
+		return nil, fmt.Errorf("imdb - Import - readRatings: %w", err)
+	}
+
+	var report []model.IMDbProgress
+	progress, err := iu.importTitles(ctx, fsys, filter, ratings, restart)
+	report = append(report, progress)
+	if err != nil {
+		return report, fmt.Errorf("imdb - Import - importTitles: %w", err)
+	}
+	restart = restart || !progress.Skipped
+
+	films, err := iu.imdbRepo.FilmIDs(ctx)
+	if err != nil {
+		return report, fmt.Errorf("imdb - Import - FilmIDs: %w", err)
+	}
+
+	progress, err = iu.importNames(ctx, fsys, filter, films, restart)
+	report = append(report, progress)
+	if err != nil {
+		return report, fmt.Errorf("imdb - Import - importNames: %w", err)
+	}
+	restart = restart || !progress.Skipped
+
+	actors, err := iu.imdbRepo.ActorIDs(ctx)
+	if err != nil {
+		return report, fmt.Errorf("imdb - Import - ActorIDs: %w", err)
+	}
+
+	progress, err = iu.importCast(ctx, fsys, filter, films, actors, restart)
+	report = append(report, progress)
+	if err != nil {
+		return report, fmt.Errorf("imdb - Import - importCast: %w", err)
+	}
+	return report, nil
+}
+
+// readRatings reads title.ratings if it is there. It is needed to filter by
+// votes only.
+func readRatings(fsys fs.FS, filter model.IMDbFilter) (map[uint32]rating, error) {
+	d, err := openDataset(fsys, model.IMDbRatings, "tconst", "averageRating", "numVotes")
+	if errors.Is(err, fs.ErrNotExist) && filter.MinVotes == 0 {
+		return map[uint32]rating{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer d.Close()
+
+	ratings := make(map[uint32]rating)
+	for d.Next() {
+		id, ok := imdbNumber(d.Field("tconst"), "tt")
+		if !ok {
+			continue
+		}
+		average, _ := strconv.ParseFloat(d.Field("averageRating"), 64)
+		votes, _ := strconv.ParseInt(d.Field("numVotes"), 10, 32)
+		ratings[id] = rating{int8(min(max(math.Round(average), 0), 10)), int32(votes)}
+	}
+	return ratings, d.Err()
+}
+
+func (iu *IMDbUsecase) importTitles(ctx context.Context, fsys fs.FS, filter model.IMDbFilter, ratings map[uint32]rating, restart bool) (model.IMDbProgress, error) {
+	d, err := openDataset(fsys, model.IMDbTitles, "tconst", "titleType", "primaryTitle", "isAdult", "startYear")
+	if err != nil {
+		return model.IMDbProgress{Dataset: model.IMDbTitles}, err
+	}
+	defer d.Close()
+
+	cp, err := iu.resume(ctx, d, filter, restart)
+	if err != nil || cp.Done {
+		return model.IMDbProgress{Dataset: d.name, Skipped: cp.Done}, err
+	}
+
+	var films []model.IMDbTitle
+	return iu.batches(d, cp,
+		func() bool {
+			if len(filter.TitleTypes) > 0 && !slices.Contains(filter.TitleTypes, d.Field("titleType")) {
+				return false
+			}
+			if !filter.Adult && d.Field("isAdult") == "1" {
+				return false
+			}
+			year, err := strconv.Atoi(d.Field("startYear"))
+			if err != nil || year < filter.MinYear {
+				return false
+			}
+			id, ok := imdbNumber(d.Field("tconst"), "tt")
+			if !ok {
+				return false
+			}
+			r, rated := ratings[id]
+			if int(r.votes) < filter.MinVotes {
+				return false
+			}
+			title := truncate(d.Field("primaryTitle"), 150)
+			if title == "" {
+				return false
+			}
+
+			film := model.IMDbTitle{
+				ID:          d.Field("tconst"),
+				Title:       title,
+				ReleaseDate: time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC),
+			}
+			if rated {
+				rating := int(r.rating)
+				film.Rating = &rating
+			}
+			films = append(films, film)
+			return true
+		},
+		func(cp model.IMDbCheckpoint) error {
+			err := iu.imdbRepo.UpsertFilms(ctx, films, cp)
+			films = films[:0]
+			return err
+		},
+	)
+}
+
+// importNames imports the people credited as actors in the imported films.
+func (iu *IMDbUsecase) importNames(ctx context.Context, fsys fs.FS, filter model.IMDbFilter, films map[uint32]uint64, restart bool) (model.IMDbProgress, error) {
+	d, err := openDataset(fsys, model.IMDbNames, "nconst", "primaryName", "birthYear")
+	if err != nil {
+		return model.IMDbProgress{Dataset: model.IMDbNames}, err
+	}
+	defer d.Close()
+
+	cp, err := iu.resume(ctx, d, filter, restart)
+	if err != nil || cp.Done {
+		return model.IMDbProgress{Dataset: d.name, Skipped: cp.Done}, err
+	}
+
+	cast, err := castOf(fsys, films)
+	if err != nil {
+		return model.IMDbProgress{Dataset: d.name}, err
+	}
+
+	var actors []model.IMDbName
+	return iu.batches(d, cp,
+		func() bool {
+			id, ok := imdbNumber(d.Field("nconst"), "nm")
+			if !ok {
+				return false
+			}
+			sex, ok := cast[id]
+			if !ok {
+				return false
+			}
+			name := truncate(d.Field("primaryName"), 100)
+			if name == "" {
+				return false
+			}
+
+			actor := model.IMDbName{ID: d.Field("nconst"), Name: name, Sex: sex}
+			if year, err := strconv.Atoi(d.Field("birthYear")); err == nil && year > 0 {
+				birthDate := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+				actor.BirthDate = &birthDate
+			}
+			actors = append(actors, actor)
+			return true
+		},
+		func(cp model.IMDbCheckpoint) error {
+			err := iu.imdbRepo.UpsertActors(ctx, actors, cp)
+			actors = actors[:0]
+			return err
+		},
+	)
+}
+
+// castOf returns the sex of the actors credited in films, by the number of
+// their IMDb ID.
+func castOf(fsys fs.FS, films map[uint32]uint64) (map[uint32]string, error) {
+	d, err := openDataset(fsys, model.IMDbPrincipals, "tconst", "nconst", "category")
+	if err != nil {
+		return nil, err
+	}
+	defer d.Close()
+
+	cast := make(map[uint32]string)
+	for d.Next() {
+		sex, ok := castCategories[d.Field("category")]
+		if !ok {
+			continue
+		}
+		if film, ok := imdbNumber(d.Field("tconst"), "tt"); !ok || films[film] == 0 {
+			continue
+		}
+		if id, ok := imdbNumber(d.Field("nconst"), "nm"); ok {
+			if _, seen := cast[id]; !seen {
+				cast[id] = sex
+			}
+		}
+	}
+	return cast, d.Err()
+}
+
+func (iu *IMDbUsecase) importCast(ctx context.Context, fsys fs.FS, filter model.IMDbFilter, films, actors map[uint32]uint64, restart bool) (model.IMDbProgress, error) {
+	d, err := openDataset(fsys, model.IMDbPrincipals, "tconst", "ordering", "nconst", "category", "characters")
+	if err != nil {
+		return model.IMDbProgress{Dataset: model.IMDbPrincipals}, err
+	}
+	defer d.Close()
+
+	cp, err := iu.resume(ctx, d, filter, restart)
+	if err != nil || cp.Done {
+		return model.IMDbProgress{Dataset: d.name, Skipped: cp.Done}, err
+	}
+
+	var credits []model.IMDbCredit
+	return iu.batches(d, cp,
+		func() bool {
+			if _, ok := castCategories[d.Field("category")]; !ok {
+				return false
+			}
+			film, _ := imdbNumber(d.Field("tconst"), "tt")
+			actor, _ := imdbNumber(d.Field("nconst"), "nm")
+			credit := model.IMDbCredit{FilmID: films[film], ActorID: actors[actor]}
+			if credit.FilmID == 0 || credit.ActorID == 0 {
+				return false
+			}
+
+			credit.Billing, _ = strconv.Atoi(d.Field("ordering"))
+			if characters := d.Field("characters"); characters != "" {
+				if err := json.Unmarshal([]byte(characters), &credit.Characters); err != nil {
+					credit.Characters = nil
+				}
+			}
+			credits = append(credits, credit)
+			return true
+		},
+		func(cp model.IMDbCheckpoint) error {
+			err := iu.imdbRepo.LinkCast(ctx, credits, cp)
+			credits = credits[:0]
+			return err
+		},
+	)
+}
+
+// resume returns the checkpoint to import d from: the last one, or a fresh
+// one if there is none, the file or filter changed, or restart is set.
+func (iu *IMDbUsecase) resume(ctx context.Context, d *dataset, filter model.IMDbFilter, restart bool) (model.IMDbCheckpoint, error) {
+	cp, err := iu.imdbRepo.GetCheckpoint(ctx, d.name)
+	if err != nil {
+		return model.IMDbCheckpoint{}, err
+	}
+	if restart || cp.Source != d.source || cp.Filters != filter.Key() {
+		cp = model.IMDbCheckpoint{Dataset: d.name, Source: d.source, Filters: filter.Key()}
+	}
+	if cp.LinesDone > 0 && !cp.Done {
+		iu.logger.Info("imdb - %s: resuming after line %d", d.name, cp.LinesDone)
+	}
+	return cp, nil
+}
+
+// batches reads d from cp on, passing every line to add and calling flush
+// with the checkpoint after every batch of lines add took and at the end.
+func (iu *IMDbUsecase) batches(d *dataset, cp model.IMDbCheckpoint, add func() bool, flush func(model.IMDbCheckpoint) error) (model.IMDbProgress, error) {
+	progress := model.IMDbProgress{Dataset: d.name}
+
+	pending := 0
+	for d.Next() {
+		progress.Lines++
+		if d.line <= cp.LinesDone {
+			progress.Resumed++
+			continue
+		}
+		if !add() {
+			continue
+		}
+
+		progress.Imported++
+		if pending++; pending == iu.batchSize {
+			cp.LinesDone = d.line
+			if err := flush(cp); err != nil {
+				return progress, err
+			}
+			pending = 0
+			iu.logger.Debug("imdb - %s: %d lines", d.name, d.line)
+		}
+	}
+	if err := d.Err(); err != nil {
+		return progress, err
+	}
+
+	cp.LinesDone = d.line
+	cp.Done = true
+	if err := flush(cp); err != nil {
+		return progress, err
+	}
+	iu.logger.Info("imdb - %s: imported %d of %d lines", d.name, progress.Imported, progress.Lines)
+	return progress, nil
+}
+
+// imdbNumber returns the number of an IMDb ID with the given prefix, 133093
+// for tt0133093.
+func imdbNumber(id, prefix string) (uint32, bool) {
+	digits, ok := strings.CutPrefix(id, prefix)
+	if !ok {
+		return 0, false
+	}
+	n, err := strconv.ParseUint(digits, 10, 32)
+	return uint32(n), err == nil && n > 0
+}
+
+// truncate cuts s to at most n characters.
+func truncate(s string, n int) string {
+	s = strings.TrimSpace(s)
+	if r := []rune(s); len(r) > n {
+		return strings.TrimSpace(string(r[:n]))
+	}
+	return s
+}
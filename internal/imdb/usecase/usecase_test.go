@@ -0,0 +1,182 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	mock_imdb "films_library/internal/imdb/mocks"
+	"films_library/internal/model"
+	"films_library/pkg/logger"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func tsv(lines ...string) *fstest.MapFile {
+	return &fstest.MapFile{Data: []byte(strings.Join(lines, "\n") + "\n")}
+}
+
+var datasets = fstest.MapFS{
+	"title.ratings.tsv": tsv(
+		"tconst\taverageRating\tnumVotes",
+		"tt0133093\t8.7\t2000000",
+		"tt0113277\t8.3\t700000",
+		"tt0000001\t5.7\t20",
+	),
+	"title.basics.tsv": tsv(
+		"tconst\ttitleType\tprimaryTitle\toriginalTitle\tisAdult\tstartYear\tendYear\truntimeMinutes\tgenres",
+		"tt0000001\tshort\tCarmencita\tCarmencita\t0\t1894\t\\N\t1\tDocumentary",
+		"tt0133093\tmovie\tThe Matrix\tThe Matrix\t0\t1999\t\\N\t136\tAction,Sci-Fi",
+		"tt0113277\tmovie\tHeat\tHeat\t0\t1995\t\\N\t170\tCrime",
+		"tt9999999\tmovie\tUnrated\tUnrated\t0\t2020\t\\N\t90\tDrama",
+	),
+	"name.basics.tsv": tsv(
+		"nconst\tprimaryName\tbirthYear\tdeathYear\tprimaryProfession\tknownForTitles",
+		"nm0000206\tKeanu Reeves\t1964\t\\N\tactor\ttt0133093",
+		"nm0005251\tCarrie-Anne Moss\t1967\t\\N\tactress\ttt0133093",
+		"nm0905152\tLilly Wachowski\t1967\t\\N\tdirector\ttt0133093",
+	),
+	"title.principals.tsv": tsv(
+		"tconst\tordering\tnconst\tcategory\tjob\tcharacters",
+		"tt0133093\t1\tnm0000206\tactor\t\\N\t[\"Neo\"]",
+		"tt0133093\t2\tnm0005251\tactress\t\\N\t[\"Trinity\"]",
+		"tt0133093\t5\tnm0905152\tdirector\t\\N\t\\N",
+		"tt0000001\t1\tnm0000001\tself\t\\N\t\\N",
+	),
+}
+
+func TestIMDbUsecase_Import(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	loggerMock := logger.NewMockInterface(ctrl)
+	loggerMock.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+	loggerMock.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+	imdbRepo := mock_imdb.NewMockRepository(ctrl)
+	usecase := NewIMDbUsecase(imdbRepo, 1, loggerMock)
+
+	ctx := context.Background()
+	filter := model.IMDbFilter{TitleTypes: []string{"movie"}, MinYear: 1990, MinVotes: 1000}
+	checkpoint := func(dataset string, lines int64, done bool) model.IMDbCheckpoint {
+		info, _ := datasets.Stat(dataset + ".tsv")
+		return model.IMDbCheckpoint{
+			Dataset:   dataset,
+			Source:    sourceOf(info),
+			Filters:   filter.Key(),
+			LinesDone: lines,
+			Done:      done,
+		}
+	}
+
+	// title.basics was imported up to The Matrix before.
+	heatRating := 8
+	imdbRepo.EXPECT().GetCheckpoint(ctx, model.IMDbTitles).Return(checkpoint(model.IMDbTitles, 2, false), nil)
+	imdbRepo.EXPECT().UpsertFilms(ctx, []model.IMDbTitle{{
+		ID:          "tt0113277",
+		Title:       "Heat",
+		ReleaseDate: time.Date(1995, 1, 1, 0, 0, 0, 0, time.UTC),
+		Rating:      &heatRating,
+	}}, checkpoint(model.IMDbTitles, 3, false)).Return(nil)
+	imdbRepo.EXPECT().UpsertFilms(ctx, []model.IMDbTitle{}, checkpoint(model.IMDbTitles, 4, true)).Return(nil)
+	imdbRepo.EXPECT().FilmIDs(ctx).Return(map[uint32]uint64{133093: 1, 113277: 2}, nil)
+
+	// name.basics is read again, as title.basics was.
+	imdbRepo.EXPECT().GetCheckpoint(ctx, model.IMDbNames).Return(checkpoint(model.IMDbNames, 3, true), nil)
+	keanu, carrieAnne := time.Date(1964, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(1967, 1, 1, 0, 0, 0, 0, time.UTC)
+	imdbRepo.EXPECT().UpsertActors(ctx, []model.IMDbName{{ID: "nm0000206", Name: "Keanu Reeves", Sex: "M", BirthDate: &keanu}},
+		checkpoint(model.IMDbNames, 1, false)).Return(nil)
+	imdbRepo.EXPECT().UpsertActors(ctx, []model.IMDbName{{ID: "nm0005251", Name: "Carrie-Anne Moss", Sex: "W", BirthDate: &carrieAnne}},
+		checkpoint(model.IMDbNames, 2, false)).Return(nil)
+	imdbRepo.EXPECT().UpsertActors(ctx, []model.IMDbName{}, checkpoint(model.IMDbNames, 3, true)).Return(nil)
+	imdbRepo.EXPECT().ActorIDs(ctx).Return(map[uint32]uint64{206: 10, 5251: 11}, nil)
+
+	imdbRepo.EXPECT().GetCheckpoint(ctx, model.IMDbPrincipals).Return(model.IMDbCheckpoint{Dataset: model.IMDbPrincipals}, nil)
+	imdbRepo.EXPECT().LinkCast(ctx, []model.IMDbCredit{{FilmID: 1, ActorID: 10, Billing: 1, Characters: []string{"Neo"}}},
+		checkpoint(model.IMDbPrincipals, 1, false)).Return(nil)
+	imdbRepo.EXPECT().LinkCast(ctx, []model.IMDbCredit{{FilmID: 1, ActorID: 11, Billing: 2, Characters: []string{"Trinity"}}},
+		checkpoint(model.IMDbPrincipals, 2, false)).Return(nil)
+	imdbRepo.EXPECT().LinkCast(ctx, []model.IMDbCredit{}, checkpoint(model.IMDbPrincipals, 4, true)).Return(nil)
+
+	report, err := usecase.Import(ctx, datasets, filter, false)
+	assert.NoError(t, err)
+	assert.Equal(t, []model.IMDbProgress{
+		{Dataset: model.IMDbTitles, Lines: 4, Resumed: 2, Imported: 1},
+		{Dataset: model.IMDbNames, Lines: 3, Imported: 2},
+		{Dataset: model.IMDbPrincipals, Lines: 4, Imported: 2},
+	}, report)
+}
+
+func TestIMDbUsecase_ImportLeavesUnratedTitlesUnrated(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	loggerMock := logger.NewMockInterface(ctrl)
+	loggerMock.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+	loggerMock.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+	imdbRepo := mock_imdb.NewMockRepository(ctrl)
+	usecase := NewIMDbUsecase(imdbRepo, 100, loggerMock)
+
+	ctx := context.Background()
+	filter := model.IMDbFilter{TitleTypes: []string{"movie"}, MinYear: 1999}
+	info, _ := datasets.Stat(model.IMDbTitles + ".tsv")
+
+	ratings, err := readRatings(datasets, filter)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	matrixRating := 9
+	imdbRepo.EXPECT().GetCheckpoint(ctx, model.IMDbTitles).Return(model.IMDbCheckpoint{Dataset: model.IMDbTitles}, nil)
+	imdbRepo.EXPECT().UpsertFilms(ctx, []model.IMDbTitle{
+		{ID: "tt0133093", Title: "The Matrix", ReleaseDate: time.Date(1999, 1, 1, 0, 0, 0, 0, time.UTC), Rating: &matrixRating},
+		{ID: "tt9999999", Title: "Unrated", ReleaseDate: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}, model.IMDbCheckpoint{
+		Dataset:   model.IMDbTitles,
+		Source:    sourceOf(info),
+		Filters:   filter.Key(),
+		LinesDone: 4,
+		Done:      true,
+	}).Return(nil)
+
+	_, err = usecase.importTitles(ctx, datasets, filter, ratings, false)
+	assert.NoError(t, err)
+}
+
+func TestIMDbUsecase_ImportSkipsImportedDatasets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	imdbRepo := mock_imdb.NewMockRepository(ctrl)
+	usecase := NewIMDbUsecase(imdbRepo, 100, logger.NewMockInterface(ctrl))
+
+	ctx := context.Background()
+	var filter model.IMDbFilter
+	for _, dataset := range []string{model.IMDbTitles, model.IMDbNames, model.IMDbPrincipals} {
+		info, _ := datasets.Stat(dataset + ".tsv")
+		cp := model.IMDbCheckpoint{Dataset: dataset, Source: sourceOf(info), Filters: filter.Key(), LinesDone: 3, Done: true}
+		imdbRepo.EXPECT().GetCheckpoint(ctx, dataset).Return(cp, nil)
+	}
+	imdbRepo.EXPECT().FilmIDs(ctx).Return(map[uint32]uint64{}, nil)
+	imdbRepo.EXPECT().ActorIDs(ctx).Return(map[uint32]uint64{}, nil)
+
+	report, err := usecase.Import(ctx, datasets, filter, false)
+	assert.NoError(t, err)
+	assert.Equal(t, []model.IMDbProgress{
+		{Dataset: model.IMDbTitles, Skipped: true},
+		{Dataset: model.IMDbNames, Skipped: true},
+		{Dataset: model.IMDbPrincipals, Skipped: true},
+	}, report)
+}
+
+func TestIMDbUsecase_ImportNeedsRatingsToFilterByVotes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase := NewIMDbUsecase(mock_imdb.NewMockRepository(ctrl), 100, logger.NewMockInterface(ctrl))
+
+	_, err := usecase.Import(context.Background(), fstest.MapFS{}, model.IMDbFilter{MinVotes: 10}, false)
+	assert.Error(t, err)
+}
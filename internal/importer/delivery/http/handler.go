@@ -0,0 +1,149 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"films_library/internal/auth"
+	"films_library/internal/importer"
+	"films_library/internal/model"
+	"films_library/pkg/httpserver"
+	"films_library/pkg/logger"
+	"films_library/pkg/response"
+)
+
+// maxImportBytes is the largest import accepted over HTTP. Larger files are
+// imported with the import command.
+const maxImportBytes = 64 << 20
+
+type ImportHandler struct {
+	importUsecase importer.Usecase
+	logger        logger.Interface
+}
+
+// ImportPermissions is the permission each import route requires.
+var ImportPermissions = auth.Permissions{
+	"POST /import/films":  auth.FilmWrite,
+	"POST /import/actors": auth.ActorWrite,
+}
+
+func NewImportHandler(mux *http.ServeMux, iu importer.Usecase, l logger.Interface) {
+	r := &ImportHandler{iu, l}
+
+	mux.HandleFunc("POST /import/films", r.ImportFilms)
+	mux.HandleFunc("POST /import/actors", r.ImportActors)
+}
+
+// ImportFilms handles the HTTP POST request to import films in bulk.
+// @Summary Import films
+// @Description Imports films from CSV with the columns title, description, release_date, rating and actors, or from NDJSON objects with those fields. Actors are IDs or names, separated by semicolons in CSV; names must belong to one actor. Rows are held to the rules of added films; invalid rows are reported and skipped. Rows are inserted in batches, and a batch that fails is reported whole. An import that fails part way responds with its error status and the report of the rows read until then; the batches inserted before stay.
+// @Tags import
+// @Accept text/csv
+// @Accept application/x-ndjson
+// @Produce json
+// @Param format query string false "csv or ndjson; taken from the Content-Type if omitted"
+// @Param dry_run query boolean false "Validate the rows without inserting them"
+// @Success 200 {object} model.ImportReport "Import report"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 413 {string} string "Import too large"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /import/films [post]
+func (h *ImportHandler) ImportFilms(w http.ResponseWriter, r *http.Request) {
+	h.serveImport(w, r, h.importUsecase.ImportFilms)
+}
+
+// ImportActors handles the HTTP POST request to import actors in bulk.
+// @Summary Import actors
+// @Description Imports actors from CSV with the columns name, sex and birth_date, or from NDJSON actor objects. Rows are held to the rules of added actors; invalid rows are reported and skipped. An import that fails part way responds with its error status and the report of the rows read until then; the batches inserted before stay.
+// @Tags import
+// @Accept text/csv
+// @Accept application/x-ndjson
+// @Produce json
+// @Param format query string false "csv or ndjson; taken from the Content-Type if omitted"
+// @Param dry_run query boolean false "Validate the rows without inserting them"
+// @Success 200 {object} model.ImportReport "Import report"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 413 {string} string "Import too large"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /import/actors [post]
+func (h *ImportHandler) ImportActors(w http.ResponseWriter, r *http.Request) {
+	h.serveImport(w, r, h.importUsecase.ImportActors)
+}
+
+// serveImport reads the format and dry run flag of an import and runs it.
+func (h *ImportHandler) serveImport(w http.ResponseWriter, r *http.Request, run func(context.Context, string, io.Reader, bool) (model.ImportReport, error)) {
+	query := r.URL.Query()
+
+	format := query.Get("format")
+	if format == "" {
+		format = contentFormat(r.Header.Get("Content-Type"))
+	}
+	if format != model.ImportCSV && format != model.ImportNDJSON {
+		response.ErrorResponse(w, http.StatusBadRequest, "Bad query param", h.logger)
+		return
+	}
+
+	var dryRun bool
+	if s := query.Get("dry_run"); s != "" {
+		var err error
+		if dryRun, err = strconv.ParseBool(s); err != nil {
+			h.logger.Error(err)
+			response.ErrorResponse(w, http.StatusBadRequest, "Bad query param", h.logger)
+			return
+		}
+	}
+
+	// Large imports take longer to upload than the server read timeout allows.
+	if err := httpserver.LiftDeadlines(w); err != nil {
+		h.logger.Error(err)
+	}
+
+	report, err := run(r.Context(), format, http.MaxBytesReader(w, r.Body, maxImportBytes), dryRun)
+	if err != nil {
+		h.usecaseError(w, report, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusOK, report)
+}
+
+// contentFormat is the import format of a Content-Type, if it names one.
+func contentFormat(contentType string) string {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch mediaType {
+	case "text/csv":
+		return model.ImportCSV
+	case "application/x-ndjson", "application/jsonl":
+		return model.ImportNDJSON
+	}
+	return ""
+}
+
+// usecaseError maps errors returned by the import usecase onto HTTP responses.
+// Imports that stopped after reading rows respond with their report, which
+// tells what was imported before.
+func (h *ImportHandler) usecaseError(w http.ResponseWriter, report model.ImportReport, err error) {
+	var badRequest *model.ErrBadRequest
+	var tooLarge *http.MaxBytesError
+
+	code, message := http.StatusInternalServerError, "Internal server error"
+	switch {
+	case errors.As(err, &badRequest):
+		code, message = http.StatusBadRequest, badRequest.Error()
+	case errors.As(err, &tooLarge):
+		code, message = http.StatusRequestEntityTooLarge, "Import too large"
+	default:
+		h.logger.Error(err)
+	}
+
+	if report.Rows == 0 {
+		response.ErrorResponse(w, code, message, h.logger)
+		return
+	}
+	report.Error = message
+	response.SuccessResponse(w, code, report)
+}
@@ -0,0 +1,58 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	mock_importer "films_library/internal/importer/mocks"
+	"films_library/internal/model"
+	"films_library/pkg/logger"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImportHandler_ImportStoppedPartWay(t *testing.T) {
+	tests := []struct {
+		name         string
+		report       model.ImportReport
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name:         "After the first batch",
+			report:       model.ImportReport{Rows: 3, Imported: 2, Failed: 1, Errors: []model.ImportError{{Row: 3, Message: "import aborted"}}},
+			expectedCode: http.StatusRequestEntityTooLarge,
+			expectedBody: `{"status":413,"body":{"dry_run":false,"rows":3,"imported":2,"failed":1,"errors":[{"row":3,"message":"import aborted"}],"error":"Import too large"}}`,
+		},
+		{
+			name:         "Before the first row",
+			report:       model.ImportReport{},
+			expectedCode: http.StatusRequestEntityTooLarge,
+			expectedBody: `{"status":413,"message":"Import too large"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUsecase := mock_importer.NewMockUsecase(ctrl)
+			mockUsecase.EXPECT().ImportActors(gomock.Any(), model.ImportNDJSON, gomock.Any(), false).
+				Return(tt.report, &http.MaxBytesError{Limit: maxImportBytes})
+			handler := ImportHandler{importUsecase: mockUsecase, logger: logger.NewMockInterface(ctrl)}
+
+			req := httptest.NewRequest("POST", "/import/actors", strings.NewReader(`{"name": "Keanu Reeves"}`))
+			req.Header.Set("Content-Type", "application/x-ndjson")
+			recorder := httptest.NewRecorder()
+
+			handler.ImportActors(recorder, req)
+
+			assert.Equal(t, tt.expectedCode, recorder.Code)
+			assert.Equal(t, tt.expectedBody, strings.TrimSpace(recorder.Body.String()))
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package importer
+
+import (
+	"context"
+	"io"
+
+	"films_library/internal/model"
+)
+
+type (
+	// Usecase imports films and actors in bulk. Rows are held to the rules
+	// of films and actors added one at a time; invalid rows are reported
+	// and skipped. Imports that fail part way return the report of the rows
+	// read so far with the error, as the batches before stay inserted.
+	Usecase interface {
+		// ImportFilms reads films in format from r. Their actors are named by
+		// ID or by name.
+		ImportFilms(ctx context.Context, format string, r io.Reader, dryRun bool) (model.ImportReport, error)
+		ImportActors(ctx context.Context, format string, r io.Reader, dryRun bool) (model.ImportReport, error)
+	}
+
+	Repository interface {
+		// FindActorIDs returns which of the given actor IDs exist.
+		FindActorIDs(ctx context.Context, ids []uint) (map[uint]bool, error)
+		// FindActorNames returns the IDs of the actors with each of the given
+		// names, by lower-cased name.
+		FindActorNames(ctx context.Context, names []string) (map[string][]uint, error)
+
+		// CopyFilms inserts a batch of films with their actors in one
+		// transaction.
+		CopyFilms(ctx context.Context, films []model.AddFilmRequest) error
+		// CopyActors inserts a batch of actors in one transaction.
+		CopyActors(ctx context.Context, actors []model.Actor) error
+	}
+)
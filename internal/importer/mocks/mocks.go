@@ -0,0 +1,148 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/importer/importer.go
+
+// Package mock_importer is a generated GoMock package.
+package mock_importer
+
+import (
+	context "context"
+	model "films_library/internal/model"
+	io "io"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUsecase is a mock of Usecase interface.
+type MockUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseMockRecorder
+}
+
+// MockUsecaseMockRecorder is the mock recorder for MockUsecase.
+type MockUsecaseMockRecorder struct {
+	mock *MockUsecase
+}
+
+// NewMockUsecase creates a new mock instance.
+func NewMockUsecase(ctrl *gomock.Controller) *MockUsecase {
+	mock := &MockUsecase{ctrl: ctrl}
+	mock.recorder = &MockUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
+	return m.recorder
+}
+
+// ImportActors mocks base method.
+func (m *MockUsecase) ImportActors(ctx context.Context, format string, r io.Reader, dryRun bool) (model.ImportReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportActors", ctx, format, r, dryRun)
+	ret0, _ := ret[0].(model.ImportReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportActors indicates an expected call of ImportActors.
+func (mr *MockUsecaseMockRecorder) ImportActors(ctx, format, r, dryRun interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportActors", reflect.TypeOf((*MockUsecase)(nil).ImportActors), ctx, format, r, dryRun)
+}
+
+// ImportFilms mocks base method.
+func (m *MockUsecase) ImportFilms(ctx context.Context, format string, r io.Reader, dryRun bool) (model.ImportReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportFilms", ctx, format, r, dryRun)
+	ret0, _ := ret[0].(model.ImportReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportFilms indicates an expected call of ImportFilms.
+func (mr *MockUsecaseMockRecorder) ImportFilms(ctx, format, r, dryRun interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportFilms", reflect.TypeOf((*MockUsecase)(nil).ImportFilms), ctx, format, r, dryRun)
+}
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CopyActors mocks base method.
+func (m *MockRepository) CopyActors(ctx context.Context, actors []model.Actor) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyActors", ctx, actors)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CopyActors indicates an expected call of CopyActors.
+func (mr *MockRepositoryMockRecorder) CopyActors(ctx, actors interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyActors", reflect.TypeOf((*MockRepository)(nil).CopyActors), ctx, actors)
+}
+
+// CopyFilms mocks base method.
+func (m *MockRepository) CopyFilms(ctx context.Context, films []model.AddFilmRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyFilms", ctx, films)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CopyFilms indicates an expected call of CopyFilms.
+func (mr *MockRepositoryMockRecorder) CopyFilms(ctx, films interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyFilms", reflect.TypeOf((*MockRepository)(nil).CopyFilms), ctx, films)
+}
+
+// FindActorIDs mocks base method.
+func (m *MockRepository) FindActorIDs(ctx context.Context, ids []uint) (map[uint]bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActorIDs", ctx, ids)
+	ret0, _ := ret[0].(map[uint]bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindActorIDs indicates an expected call of FindActorIDs.
+func (mr *MockRepositoryMockRecorder) FindActorIDs(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActorIDs", reflect.TypeOf((*MockRepository)(nil).FindActorIDs), ctx, ids)
+}
+
+// FindActorNames mocks base method.
+func (m *MockRepository) FindActorNames(ctx context.Context, names []string) (map[string][]uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActorNames", ctx, names)
+	ret0, _ := ret[0].(map[string][]uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindActorNames indicates an expected call of FindActorNames.
+func (mr *MockRepositoryMockRecorder) FindActorNames(ctx, names interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActorNames", reflect.TypeOf((*MockRepository)(nil).FindActorNames), ctx, names)
+}
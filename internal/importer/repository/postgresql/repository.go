@@ -0,0 +1,149 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"films_library/internal/model"
+	"films_library/pkg/postgres"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+)
+
+type Repository struct {
+	db postgres.DBConn
+}
+
+func NewRepository(db postgres.DBConn) *Repository {
+	return &Repository{db}
+}
+
+func (r *Repository) FindActorIDs(ctx context.Context, ids []uint) (map[uint]bool, error) {
+	sqlQuery := `SELECT actor_id FROM actor WHERE actor_id = ANY($1::bigint[])`
+
+	rows, err := r.db.Query(ctx, sqlQuery, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	known := make(map[uint]bool, len(ids))
+	for rows.Next() {
+		var id uint
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		known[id] = true
+	}
+	return known, rows.Err()
+}
+
+func (r *Repository) FindActorNames(ctx context.Context, names []string) (map[string][]uint, error) {
+	sqlQuery := `SELECT lower(name), actor_id FROM actor WHERE lower(name) = ANY($1::text[]) ORDER BY actor_id`
+
+	rows, err := r.db.Query(ctx, sqlQuery, names)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	named := make(map[string][]uint, len(names))
+	for rows.Next() {
+		var (
+			name string
+			id   uint
+		)
+		if err := rows.Scan(&name, &id); err != nil {
+			return nil, err
+		}
+		named[name] = append(named[name], id)
+	}
+	return named, rows.Err()
+}
+
+// CopyFilms takes IDs for the films from the film sequence up front, so that
+// the films and their cast can both be copied in.
+func (r *Repository) CopyFilms(ctx context.Context, films []model.AddFilmRequest) error {
+	idsQuery := `SELECT nextval(pg_get_serial_sequence('film', 'film_id')) FROM generate_series(1, $1)`
+
+	return r.db.BeginTxFunc(ctx, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, idsQuery, len(films))
+		if err != nil {
+			return err
+		}
+		ids := make([]uint64, 0, len(films))
+		for rows.Next() {
+			var id uint64
+			if err := rows.Scan(&id); err != nil {
+				rows.Close()
+				return err
+			}
+			ids = append(ids, id)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return err
+		}
+
+		filmRows := make([][]interface{}, 0, len(films))
+		var castRows [][]interface{}
+		for i, film := range films {
+			filmRows = append(filmRows, []interface{}{ids[i], film.Title, film.Description, film.ReleaseDate, film.Rating})
+			for _, actorID := range film.Actors {
+				castRows = append(castRows, []interface{}{ids[i], int64(actorID)})
+			}
+		}
+
+		if _, err := tx.CopyFrom(ctx, pgx.Identifier{"film"},
+			[]string{"film_id", "title", "description", "release_date", "rating"},
+			pgx.CopyFromRows(filmRows),
+		); err != nil {
+			return err
+		}
+
+		if len(castRows) > 0 {
+			if _, err := tx.CopyFrom(ctx, pgx.Identifier{"film_actor"},
+				[]string{"film_id", "actor_id"},
+				pgx.CopyFromRows(castRows),
+			); err != nil {
+				return castError(err)
+			}
+		}
+		return nil
+	})
+}
+
+func (r *Repository) CopyActors(ctx context.Context, actors []model.Actor) error {
+	rows := make([][]interface{}, 0, len(actors))
+	for _, actor := range actors {
+		var birthDate interface{}
+		if actor.BirthDate != "" {
+			date, err := time.Parse(time.DateOnly, actor.BirthDate)
+			if err != nil {
+				return err
+			}
+			birthDate = date
+		}
+		rows = append(rows, []interface{}{strings.TrimSpace(actor.Name), actor.Sex, birthDate})
+	}
+
+	return r.db.BeginTxFunc(ctx, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		_, err := tx.CopyFrom(ctx, pgx.Identifier{"actor"},
+			[]string{"name", "sex", "birth_date"},
+			pgx.CopyFromRows(rows),
+		)
+		return err
+	})
+}
+
+// castError tells of actors deleted while their films were being imported.
+func castError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+		return &model.ErrConflict{Message: "an actor was deleted during the import"}
+	}
+	return err
+}
@@ -0,0 +1,62 @@
+package postgresql
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"films_library/internal/model"
+
+	"github.com/pashagolub/pgxmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCopyFilmsCopiesCast(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mock.Close()
+
+	repo := NewRepository(mock)
+
+	films := []model.AddFilmRequest{
+		{Title: "The Matrix", ReleaseDate: time.Date(1999, 3, 31, 0, 0, 0, 0, time.UTC), Rating: 8, Actors: []uint{1, 2}},
+		{Title: "Heat", ReleaseDate: time.Date(1995, 12, 15, 0, 0, 0, 0, time.UTC), Rating: 8},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT nextval(pg_get_serial_sequence('film', 'film_id')) FROM generate_series(1, $1)`)).
+		WithArgs(2).
+		WillReturnRows(pgxmock.NewRows([]string{"nextval"}).AddRow(uint64(41)).AddRow(uint64(42)))
+	mock.ExpectCopyFrom(`"film"`, []string{"film_id", "title", "description", "release_date", "rating"}).
+		WillReturnResult(2)
+	mock.ExpectCopyFrom(`"film_actor"`, []string{"film_id", "actor_id"}).
+		WillReturnResult(2)
+	mock.ExpectCommit()
+
+	assert.NoError(t, repo.CopyFilms(context.Background(), films))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCopyFilmsSkipsEmptyCast(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mock.Close()
+
+	repo := NewRepository(mock)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT nextval(pg_get_serial_sequence('film', 'film_id')) FROM generate_series(1, $1)`)).
+		WithArgs(1).
+		WillReturnRows(pgxmock.NewRows([]string{"nextval"}).AddRow(uint64(7)))
+	mock.ExpectCopyFrom(`"film"`, []string{"film_id", "title", "description", "release_date", "rating"}).
+		WillReturnResult(1)
+	mock.ExpectCommit()
+
+	assert.NoError(t, repo.CopyFilms(context.Background(), []model.AddFilmRequest{{Title: "Heat", Rating: 8}}))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
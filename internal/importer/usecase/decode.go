@@ -0,0 +1,212 @@
+package usecase
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"films_library/internal/model"
+)
+
+// maxLine is the longest NDJSON row read.
+const maxLine = 1 << 20
+
+var (
+	filmColumns  = []string{"title", "description", "release_date", "rating", "actors"}
+	actorColumns = []string{"name", "sex", "birth_date"}
+)
+
+// rowError is why a single row could not be decoded. Reading goes on with
+// the next row.
+type rowError struct {
+	field   string
+	message string
+}
+
+func (e *rowError) Error() string {
+	return e.message
+}
+
+// filmRow is a film as imported, with its actors named by ID or by name.
+type filmRow struct {
+	film   model.AddFilmRequest
+	actors []string
+}
+
+// decoder reads the rows of a CSV or NDJSON import. film and actor return
+// io.EOF after the last row and a *rowError for a row that cannot be
+// decoded; other errors end the import.
+type decoder struct {
+	csv     *csv.Reader
+	columns map[string]int
+	lines   *bufio.Scanner
+}
+
+func newDecoder(format string, r io.Reader, columns []string) (*decoder, error) {
+	switch format {
+	case model.ImportCSV:
+		cr := csv.NewReader(r)
+		cr.FieldsPerRecord = -1
+		cr.TrimLeadingSpace = true
+
+		header, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return nil, &model.ErrBadRequest{Message: "missing CSV header"}
+		}
+		if err != nil {
+			return nil, &model.ErrBadRequest{Message: fmt.Sprintf("malformed CSV header: %s", err)}
+		}
+
+		d := &decoder{csv: cr, columns: make(map[string]int, len(header))}
+		for i, name := range header {
+			name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+			if !slices.Contains(columns, name) {
+				return nil, &model.ErrBadRequest{Message: fmt.Sprintf("unknown CSV column %q", name)}
+			}
+			d.columns[name] = i
+		}
+		return d, nil
+	case model.ImportNDJSON:
+		lines := bufio.NewScanner(r)
+		lines.Buffer(make([]byte, 0, 64*1024), maxLine)
+		return &decoder{lines: lines}, nil
+	default:
+		return nil, &model.ErrBadRequest{Message: fmt.Sprintf("unsupported format %q", format)}
+	}
+}
+
+func (d *decoder) film() (filmRow, error) {
+	var (
+		row         filmRow
+		releaseDate string
+	)
+	if d.csv != nil {
+		record, err := d.record()
+		if err != nil {
+			return filmRow{}, err
+		}
+		row.film.Title = d.field(record, "title")
+		row.film.Description = d.field(record, "description")
+		releaseDate = d.field(record, "release_date")
+		if rating := d.field(record, "rating"); rating != "" {
+			if row.film.Rating, err = strconv.Atoi(rating); err != nil {
+				return filmRow{}, &rowError{"rating", "must be a whole number"}
+			}
+		}
+		for _, actor := range strings.Split(d.field(record, "actors"), ";") {
+			if actor = strings.TrimSpace(actor); actor != "" {
+				row.actors = append(row.actors, actor)
+			}
+		}
+	} else {
+		line, err := d.line()
+		if err != nil {
+			return filmRow{}, err
+		}
+		var raw struct {
+			Title       string            `json:"title"`
+			Description string            `json:"description"`
+			ReleaseDate string            `json:"release_date"`
+			Rating      int               `json:"rating"`
+			Actors      []json.RawMessage `json:"actors"`
+		}
+		if err := json.Unmarshal(line, &raw); err != nil {
+			return filmRow{}, &rowError{"", "malformed JSON"}
+		}
+		row.film = model.AddFilmRequest{Title: raw.Title, Description: raw.Description, Rating: raw.Rating}
+		releaseDate = raw.ReleaseDate
+		for _, actor := range raw.Actors {
+			var name string
+			if err := json.Unmarshal(actor, &name); err == nil {
+				if name = strings.TrimSpace(name); name != "" {
+					row.actors = append(row.actors, name)
+				}
+				continue
+			}
+			var id uint
+			if err := json.Unmarshal(actor, &id); err != nil {
+				return filmRow{}, &rowError{"actors", "must be actor IDs or names"}
+			}
+			row.actors = append(row.actors, strconv.FormatUint(uint64(id), 10))
+		}
+	}
+
+	if releaseDate != "" {
+		date, err := time.Parse(time.DateOnly, releaseDate)
+		if err != nil {
+			return filmRow{}, &rowError{"release_date", "must be a date as YYYY-MM-DD"}
+		}
+		row.film.ReleaseDate = date
+	}
+	return row, nil
+}
+
+func (d *decoder) actor() (model.Actor, error) {
+	var actor model.Actor
+	if d.csv != nil {
+		record, err := d.record()
+		if err != nil {
+			return model.Actor{}, err
+		}
+		actor.Name = d.field(record, "name")
+		actor.Sex = d.field(record, "sex")
+		actor.BirthDate = d.field(record, "birth_date")
+	} else {
+		line, err := d.line()
+		if err != nil {
+			return model.Actor{}, err
+		}
+		if err := json.Unmarshal(line, &actor); err != nil {
+			return model.Actor{}, &rowError{"", "malformed JSON"}
+		}
+		actor.ID, actor.Headshot = 0, nil
+	}
+
+	if actor.BirthDate != "" {
+		if _, err := time.Parse(time.DateOnly, actor.BirthDate); err != nil {
+			return model.Actor{}, &rowError{"birth_date", "must be a date as YYYY-MM-DD"}
+		}
+	}
+	return actor, nil
+}
+
+func (d *decoder) record() ([]string, error) {
+	record, err := d.csv.Read()
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		return nil, &rowError{"", fmt.Sprintf("malformed CSV: %s", parseErr.Err)}
+	}
+	return record, err
+}
+
+func (d *decoder) field(record []string, column string) string {
+	i, ok := d.columns[column]
+	if !ok || i >= len(record) {
+		return ""
+	}
+	return strings.TrimSpace(record[i])
+}
+
+// line returns the next NDJSON row, skipping blank lines.
+func (d *decoder) line() ([]byte, error) {
+	for d.lines.Scan() {
+		if line := bytes.TrimSpace(d.lines.Bytes()); len(line) > 0 {
+			return line, nil
+		}
+	}
+	if err := d.lines.Err(); err != nil {
+		if errors.Is(err, bufio.ErrTooLong) {
+			return nil, &model.ErrBadRequest{Message: fmt.Sprintf("row longer than %d bytes", maxLine)}
+		}
+		return nil, err
+	}
+	return nil, io.EOF
+}
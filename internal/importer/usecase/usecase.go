@@ -0,0 +1,284 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"films_library/internal/importer"
+	"films_library/internal/model"
+	"films_library/pkg/logger"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// maxReportErrors is how many failed rows a report lists.
+const maxReportErrors = 1000
+
+type ImportUsecase struct {
+	importRepo importer.Repository
+	batchSize  int
+	validate   *validator.Validate
+	logger     logger.Interface
+}
+
+// NewImportUsecase returns a usecase inserting rows batchSize at a time, or
+// all in one batch if batchSize is 0.
+func NewImportUsecase(ir importer.Repository, batchSize int, l logger.Interface) *ImportUsecase {
+	v := validator.New()
+	v.RegisterTagNameFunc(jsonName)
+	return &ImportUsecase{ir, batchSize, v, l}
+}
+
+// pending is a valid row waiting for its batch.
+type pending[T any] struct {
+	row  int
+	item T
+}
+
+func (iu *ImportUsecase) ImportFilms(ctx context.Context, format string, r io.Reader, dryRun bool) (model.ImportReport, error) {
+	d, err := newDecoder(format, r, filmColumns)
+	if err != nil {
+		return model.ImportReport{}, err
+	}
+
+	report := model.ImportReport{DryRun: dryRun, Errors: []model.ImportError{}}
+	err = importRows(iu, &report, d.film,
+		func(row filmRow) error {
+			return iu.validate.Struct(row.film)
+		},
+		func(batch []pending[filmRow]) error {
+			return iu.flushFilms(ctx, &report, batch, dryRun)
+		},
+	)
+	return report, err
+}
+
+func (iu *ImportUsecase) ImportActors(ctx context.Context, format string, r io.Reader, dryRun bool) (model.ImportReport, error) {
+	d, err := newDecoder(format, r, actorColumns)
+	if err != nil {
+		return model.ImportReport{}, err
+	}
+
+	report := model.ImportReport{DryRun: dryRun, Errors: []model.ImportError{}}
+	err = importRows(iu, &report, d.actor,
+		func(actor model.Actor) error {
+			return iu.validate.Struct(actor)
+		},
+		func(batch []pending[model.Actor]) error {
+			actors := make([]model.Actor, 0, len(batch))
+			for _, p := range batch {
+				actors = append(actors, p.item)
+			}
+			return insert(ctx, iu, &report, batch, len(actors), dryRun, func() error {
+				return iu.importRepo.CopyActors(ctx, actors)
+			})
+		},
+	)
+	return report, err
+}
+
+// importRows reads rows with next until io.EOF, reports those that fail to
+// decode or validate, and passes the others to flush in batches. An import
+// that stops early keeps the batches flushed before and reports the rows
+// read since as aborted.
+func importRows[T any](iu *ImportUsecase, report *model.ImportReport, next func() (T, error), check func(T) error, flush func([]pending[T]) error) error {
+	defer func() {
+		slices.SortStableFunc(report.Errors, func(a, b model.ImportError) int {
+			return cmp.Compare(a.Row, b.Row)
+		})
+	}()
+
+	var batch []pending[T]
+	for {
+		item, err := next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		var rowErr *rowError
+		if errors.As(err, &rowErr) {
+			report.Rows++
+			fail(report, report.Rows, rowErr.field, rowErr.message)
+			continue
+		}
+		if err != nil {
+			abort(report, batch)
+			return err
+		}
+
+		report.Rows++
+		if err := check(item); err != nil {
+			invalid(report, report.Rows, err)
+			continue
+		}
+
+		batch = append(batch, pending[T]{report.Rows, item})
+		if len(batch) == iu.batchSize {
+			if err := flush(batch); err != nil {
+				return err
+			}
+			batch = nil
+		}
+	}
+
+	if len(batch) > 0 {
+		return flush(batch)
+	}
+	return nil
+}
+
+// flushFilms resolves the actors of a batch of films and inserts the films
+// whose actors all resolve to one actor each. Like insert, it reports the
+// rows of a batch it fails to flush.
+func (iu *ImportUsecase) flushFilms(ctx context.Context, report *model.ImportReport, batch []pending[filmRow], dryRun bool) error {
+	var (
+		ids   []uint
+		names []string
+	)
+	for _, p := range batch {
+		for _, ref := range p.item.actors {
+			if id, err := strconv.ParseUint(ref, 10, 32); err == nil {
+				ids = append(ids, uint(id))
+			} else {
+				names = append(names, strings.ToLower(ref))
+			}
+		}
+	}
+
+	known := map[uint]bool{}
+	if len(ids) > 0 {
+		var err error
+		if known, err = iu.importRepo.FindActorIDs(ctx, ids); err != nil {
+			abort(report, batch)
+			return fmt.Errorf("importer - ImportFilms - FindActorIDs: %w", err)
+		}
+	}
+	named := map[string][]uint{}
+	if len(names) > 0 {
+		var err error
+		if named, err = iu.importRepo.FindActorNames(ctx, names); err != nil {
+			abort(report, batch)
+			return fmt.Errorf("importer - ImportFilms - FindActorNames: %w", err)
+		}
+	}
+
+	films := make([]model.AddFilmRequest, 0, len(batch))
+	resolved := make([]pending[filmRow], 0, len(batch))
+	for _, p := range batch {
+		film := p.item.film
+		film.Actors = nil
+		if err := resolveActors(&film, p.item.actors, known, named); err != nil {
+			fail(report, p.row, "actors", err.Error())
+			continue
+		}
+		films = append(films, film)
+		resolved = append(resolved, p)
+	}
+
+	return insert(ctx, iu, report, resolved, len(films), dryRun, func() error {
+		return iu.importRepo.CopyFilms(ctx, films)
+	})
+}
+
+// insert runs write for the n rows of a batch. A batch that fails to insert
+// is rolled back whole and its rows are reported; the import goes on unless
+// ctx is done.
+func insert[T any](ctx context.Context, iu *ImportUsecase, report *model.ImportReport, batch []pending[T], n int, dryRun bool, write func() error) error {
+	if n == 0 || dryRun {
+		report.Imported += n
+		return nil
+	}
+
+	if err := write(); err != nil {
+		if ctx.Err() != nil {
+			abort(report, batch)
+			return ctx.Err()
+		}
+		iu.logger.Error(fmt.Errorf("importer - insert: %w", err))
+
+		message := "batch not imported"
+		var conflict *model.ErrConflict
+		if errors.As(err, &conflict) {
+			message += ": " + conflict.Message
+		}
+		for _, p := range batch {
+			fail(report, p.row, "", message)
+		}
+		return nil
+	}
+
+	report.Imported += n
+	return nil
+}
+
+// resolveActors sets the actors of film from their IDs and names. Names
+// must belong to exactly one actor.
+func resolveActors(film *model.AddFilmRequest, refs []string, known map[uint]bool, named map[string][]uint) error {
+	for _, ref := range refs {
+		var id uint
+		if n, err := strconv.ParseUint(ref, 10, 32); err == nil {
+			if !known[uint(n)] {
+				return fmt.Errorf("actor %d not found", n)
+			}
+			id = uint(n)
+		} else {
+			switch matches := named[strings.ToLower(ref)]; len(matches) {
+			case 0:
+				return fmt.Errorf("actor %q not found", ref)
+			case 1:
+				id = matches[0]
+			default:
+				return fmt.Errorf("actor %q is ambiguous: %d actors have that name, use an ID", ref, len(matches))
+			}
+		}
+		if !slices.Contains(film.Actors, id) {
+			film.Actors = append(film.Actors, id)
+		}
+	}
+	return nil
+}
+
+// invalid reports the first rule a row breaks.
+func invalid(report *model.ImportReport, row int, err error) {
+	var errs validator.ValidationErrors
+	if !errors.As(err, &errs) || len(errs) == 0 {
+		fail(report, row, "", err.Error())
+		return
+	}
+
+	rule := errs[0].Tag()
+	if errs[0].Param() != "" {
+		rule += "=" + errs[0].Param()
+	}
+	fail(report, row, errs[0].Field(), "must satisfy "+rule)
+}
+
+// abort reports the rows of a batch left out by an import that stopped.
+func abort[T any](report *model.ImportReport, batch []pending[T]) {
+	for _, p := range batch {
+		fail(report, p.row, "", "import aborted")
+	}
+}
+
+func fail(report *model.ImportReport, row int, field, message string) {
+	report.Failed++
+	if len(report.Errors) < maxReportErrors {
+		report.Errors = append(report.Errors, model.ImportError{Row: row, Field: field, Message: message})
+	}
+}
+
+// jsonName names fields in validation errors as they are named in rows.
+func jsonName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "-" {
+		return ""
+	}
+	return name
+}
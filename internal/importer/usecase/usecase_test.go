@@ -0,0 +1,146 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	mock_importer "films_library/internal/importer/mocks"
+	"films_library/internal/model"
+	"films_library/pkg/logger"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImportUsecase_ImportFilms(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	loggerMock := logger.NewMockInterface(ctrl)
+	importRepo := mock_importer.NewMockRepository(ctrl)
+	usecase := NewImportUsecase(importRepo, 2, loggerMock)
+
+	ctx := context.Background()
+	csv := strings.Join([]string{
+		"title,release_date,rating,actors",
+		"The Matrix,1999-03-31,8,Keanu Reeves;2;keanu reeves",
+		",2000-01-01,5,",
+		"Heat,1995-12-15,11,",
+		"Ronin,1998-13-01,7,",
+		"Alien,1979-05-25,8,Sigourney Weaver",
+		"Speed,1994-06-10,7,John Smith",
+	}, "\n")
+
+	importRepo.EXPECT().FindActorIDs(ctx, []uint{2}).Return(map[uint]bool{2: true}, nil)
+	importRepo.EXPECT().FindActorNames(ctx, []string{"keanu reeves", "keanu reeves", "sigourney weaver"}).
+		Return(map[string][]uint{"keanu reeves": {1}, "sigourney weaver": {3, 4}}, nil)
+	importRepo.EXPECT().CopyFilms(ctx, []model.AddFilmRequest{{
+		Title:       "The Matrix",
+		ReleaseDate: time.Date(1999, 3, 31, 0, 0, 0, 0, time.UTC),
+		Rating:      8,
+		Actors:      []uint{1, 2},
+	}}).Return(nil)
+	importRepo.EXPECT().FindActorNames(ctx, []string{"john smith"}).Return(map[string][]uint{}, nil)
+
+	report, err := usecase.ImportFilms(ctx, model.ImportCSV, strings.NewReader(csv), false)
+	assert.NoError(t, err)
+	assert.Equal(t, model.ImportReport{
+		Rows:     6,
+		Imported: 1,
+		Failed:   5,
+		Errors: []model.ImportError{
+			{Row: 2, Field: "title", Message: "must satisfy required"},
+			{Row: 3, Field: "rating", Message: "must satisfy max=10"},
+			{Row: 4, Field: "release_date", Message: "must be a date as YYYY-MM-DD"},
+			{Row: 5, Field: "actors", Message: `actor "Sigourney Weaver" is ambiguous: 2 actors have that name, use an ID`},
+			{Row: 6, Field: "actors", Message: `actor "John Smith" not found`},
+		},
+	}, report)
+}
+
+func TestImportUsecase_ImportActorsReportsFailedBatches(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	loggerMock := logger.NewMockInterface(ctrl)
+	importRepo := mock_importer.NewMockRepository(ctrl)
+	usecase := NewImportUsecase(importRepo, 2, loggerMock)
+
+	ctx := context.Background()
+	ndjson := strings.Join([]string{
+		`{"name": "Keanu Reeves", "sex": "M", "birth_date": "1964-09-02"}`,
+		`{"name": "Carrie-Anne Moss", "sex": "W"}`,
+		``,
+		`{"name": "Hugo Weaving", "sex": "X"}`,
+		`{"name": "Laurence Fishburne", "sex": "M"`,
+		`{"name": "Gloria Foster", "sex": "W", "birth_date": "1933-11-15"}`,
+	}, "\n")
+
+	importRepo.EXPECT().CopyActors(ctx, []model.Actor{
+		{Name: "Keanu Reeves", Sex: "M", BirthDate: "1964-09-02"},
+		{Name: "Carrie-Anne Moss", Sex: "W"},
+	}).Return(errors.New("connection reset"))
+	loggerMock.EXPECT().Error(gomock.Any())
+	importRepo.EXPECT().CopyActors(ctx, []model.Actor{
+		{Name: "Gloria Foster", Sex: "W", BirthDate: "1933-11-15"},
+	}).Return(nil)
+
+	report, err := usecase.ImportActors(ctx, model.ImportNDJSON, strings.NewReader(ndjson), false)
+	assert.NoError(t, err)
+	assert.Equal(t, model.ImportReport{
+		Rows:     5,
+		Imported: 1,
+		Failed:   4,
+		Errors: []model.ImportError{
+			{Row: 1, Message: "batch not imported"},
+			{Row: 2, Message: "batch not imported"},
+			{Row: 3, Field: "sex", Message: "must satisfy oneof=M W N"},
+			{Row: 4, Message: "malformed JSON"},
+		},
+	}, report)
+}
+
+func TestImportUsecase_ImportStoppedAfterFirstBatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	importRepo := mock_importer.NewMockRepository(ctrl)
+	usecase := NewImportUsecase(importRepo, 2, logger.NewMockInterface(ctrl))
+
+	ctx := context.Background()
+	ndjson := strings.Join([]string{
+		`{"name": "Keanu Reeves", "sex": "M"}`,
+		`{"name": "Carrie-Anne Moss", "sex": "W"}`,
+		`{"name": "Hugo Weaving", "sex": "M"}`,
+		`{"name": "` + strings.Repeat("x", maxLine) + `", "sex": "M"}`,
+	}, "\n")
+
+	importRepo.EXPECT().CopyActors(ctx, []model.Actor{
+		{Name: "Keanu Reeves", Sex: "M"},
+		{Name: "Carrie-Anne Moss", Sex: "W"},
+	}).Return(nil)
+
+	report, err := usecase.ImportActors(ctx, model.ImportNDJSON, strings.NewReader(ndjson), false)
+	var badRequest *model.ErrBadRequest
+	assert.ErrorAs(t, err, &badRequest)
+	assert.Equal(t, model.ImportReport{
+		Rows:     3,
+		Imported: 2,
+		Failed:   1,
+		Errors:   []model.ImportError{{Row: 3, Message: "import aborted"}},
+	}, report)
+}
+
+func TestImportUsecase_ImportRejectsUnknownColumns(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase := NewImportUsecase(mock_importer.NewMockRepository(ctrl), 2, logger.NewMockInterface(ctrl))
+
+	_, err := usecase.ImportActors(context.Background(), model.ImportCSV, strings.NewReader("name,gender\nKeanu Reeves,M\n"), true)
+	var badRequest *model.ErrBadRequest
+	assert.ErrorAs(t, err, &badRequest)
+}
@@ -0,0 +1,289 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"films_library/internal/auth"
+	"films_library/internal/inventory"
+	"films_library/internal/model"
+	"films_library/pkg/logger"
+	"films_library/pkg/pagination"
+	"films_library/pkg/response"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mailru/easyjson"
+)
+
+type InventoryHandler struct {
+	inventoryUsecase inventory.Usecase
+	logger           logger.Interface
+}
+
+// InventoryPermissions is the permission each copy route requires.
+var InventoryPermissions = auth.Permissions{
+	"GET /copies":            auth.CopyRead,
+	"GET /copies/{id}":       auth.CopyRead,
+	"GET /films/{id}/copies": auth.CopyRead,
+	"POST /copies":           auth.CopyWrite,
+	"PUT /copies/{id}":       auth.CopyWrite,
+	"DELETE /copies/{id}":    auth.CopyDelete,
+}
+
+func NewInventoryHandler(mux *http.ServeMux, iu inventory.Usecase, l logger.Interface) {
+	r := &InventoryHandler{iu, l}
+
+	mux.HandleFunc("GET /copies", r.GetCopies)
+	mux.HandleFunc("GET /copies/{id}", r.GetCopy)
+	mux.HandleFunc("GET /films/{id}/copies", r.GetFilmCopies)
+	mux.HandleFunc("POST /copies", r.AddCopy)
+	mux.HandleFunc("PUT /copies/{id}", r.UpdateCopy)
+	mux.HandleFunc("DELETE /copies/{id}", r.DeleteCopy)
+}
+
+// GetCopies handles the HTTP GET request to retrieve a list of copies.
+// @Summary Get copies
+// @Description Retrieves a page of the copies the library owns.
+// @Tags inventory
+// @Produce json
+// @Param film_id query integer false "Only copies of this film"
+// @Param format query string false "Only copies in this format (dvd, bluray, 4k, vhs, digital)"
+// @Param status query string false "Only copies in this status (active, withdrawn, lost)"
+// @Param limit query integer false "Page size (default 20, max 100)"
+// @Param offset query integer false "Number of copies to skip"
+// @Param cursor query string false "Cursor of the page to fetch"
+// @Param total query boolean false "Include the total number of copies"
+// @Success 200 {array} model.Copy "List of copies"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /copies [get]
+func (h *InventoryHandler) GetCopies(w http.ResponseWriter, r *http.Request) {
+	var filmId uint64
+	if s := r.URL.Query().Get("film_id"); s != "" {
+		var err error
+		filmId, err = strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			h.logger.Error(err)
+			response.ErrorResponse(w, http.StatusBadRequest, "Bad query param", h.logger)
+			return
+		}
+	}
+
+	h.getCopies(w, r, filmId)
+}
+
+// GetFilmCopies handles the HTTP GET request to retrieve the copies of a film.
+// @Summary Get film copies
+// @Description Retrieves a page of the copies of a film.
+// @Tags inventory
+// @Produce json
+// @Param id path integer true "ID of the film"
+// @Param format query string false "Only copies in this format (dvd, bluray, 4k, vhs, digital)"
+// @Param status query string false "Only copies in this status (active, withdrawn, lost)"
+// @Param limit query integer false "Page size (default 20, max 100)"
+// @Param offset query integer false "Number of copies to skip"
+// @Param cursor query string false "Cursor of the page to fetch"
+// @Param total query boolean false "Include the total number of copies"
+// @Success 200 {array} model.Copy "List of copies"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /films/{id}/copies [get]
+func (h *InventoryHandler) GetFilmCopies(w http.ResponseWriter, r *http.Request) {
+	filmId, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, response.InvalidURLParameter, h.logger)
+		return
+	}
+
+	h.getCopies(w, r, filmId)
+}
+
+// getCopies serves a page of copies, of the film filmId when it is set.
+func (h *InventoryHandler) getCopies(w http.ResponseWriter, r *http.Request, filmId uint64) {
+	queryParams := r.URL.Query()
+
+	params, err := pagination.ParseQuery(queryParams)
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Bad query param", h.logger)
+		return
+	}
+
+	filter := model.CopyFilter{
+		FilmID: filmId,
+		Format: queryParams.Get("format"),
+		Status: queryParams.Get("status"),
+		Params: params,
+	}
+
+	v := validator.New()
+	if err := v.Struct(filter); err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Invalid request", h.logger)
+		return
+	}
+
+	copies, page, err := h.inventoryUsecase.GetCopies(r.Context(), filter)
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.PageResponse(w, http.StatusOK, copies, page)
+}
+
+// GetCopy handles the HTTP GET request to retrieve a copy.
+// @Summary Get copy
+// @Description Retrieves a copy the library owns.
+// @Tags inventory
+// @Produce json
+// @Param id path integer true "ID of the copy"
+// @Success 200 {object} model.Copy "Copy"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Copy not found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /copies/{id} [get]
+func (h *InventoryHandler) GetCopy(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, response.InvalidURLParameter, h.logger)
+		return
+	}
+
+	c, err := h.inventoryUsecase.GetCopy(r.Context(), id)
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusOK, c)
+}
+
+// AddCopy handles the HTTP POST request to record a copy.
+// @Summary Add copy
+// @Description Records a copy of a film. Copies are in circulation unless a status is given.
+// @Tags inventory
+// @Accept json
+// @Produce json
+// @Param copy body model.CopyRequest true "Copy to be added"
+// @Success 201 {object} model.Copy "Added copy"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Film not found"
+// @Failure 409 {string} string "Barcode is already in use"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /copies [post]
+func (h *InventoryHandler) AddCopy(w http.ResponseWriter, r *http.Request) {
+	req, ok := h.copyRequest(w, r)
+	if !ok {
+		return
+	}
+
+	c, err := h.inventoryUsecase.AddCopy(r.Context(), req)
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusCreated, c)
+}
+
+// UpdateCopy handles the HTTP PUT request to update a copy.
+// @Summary Update copy
+// @Description Replaces the details of a copy. Its status is kept when left out.
+// @Tags inventory
+// @Accept json
+// @Produce json
+// @Param id path integer true "ID of the copy"
+// @Param copy body model.CopyRequest true "Copy details"
+// @Success 200 {object} model.Copy "Updated copy"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Copy or film not found"
+// @Failure 409 {string} string "Barcode is already in use"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /copies/{id} [put]
+func (h *InventoryHandler) UpdateCopy(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, response.InvalidURLParameter, h.logger)
+		return
+	}
+
+	req, ok := h.copyRequest(w, r)
+	if !ok {
+		return
+	}
+
+	c, err := h.inventoryUsecase.UpdateCopy(r.Context(), id, req)
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusOK, c)
+}
+
+// DeleteCopy handles the HTTP DELETE request to delete a copy.
+// @Summary Delete copy
+// @Description Deletes a copy recorded by mistake. Copies that were lent must be withdrawn instead.
+// @Tags inventory
+// @Produce json
+// @Param id path integer true "ID of the copy"
+// @Success 200 {string} string "Copy deleted"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Copy not found"
+// @Failure 409 {string} string "Copy has loans"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /copies/{id} [delete]
+func (h *InventoryHandler) DeleteCopy(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, response.InvalidURLParameter, h.logger)
+		return
+	}
+
+	if err := h.inventoryUsecase.DeleteCopy(r.Context(), id); err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusOK, response.NIL())
+}
+
+// copyRequest decodes and validates copy details from the request body.
+func (h *InventoryHandler) copyRequest(w http.ResponseWriter, r *http.Request) (model.CopyRequest, bool) {
+	var req model.CopyRequest
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Corrupted request body", h.logger)
+		return model.CopyRequest{}, false
+	}
+
+	v := validator.New()
+	if err := v.Struct(req); err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Invalid request", h.logger)
+		return model.CopyRequest{}, false
+	}
+	return req, true
+}
+
+// usecaseError maps errors returned by the inventory usecase onto HTTP responses.
+func (h *InventoryHandler) usecaseError(w http.ResponseWriter, err error) {
+	var notFound *model.ErrNotFound
+	var conflict *model.ErrConflict
+
+	switch {
+	case errors.As(err, &notFound):
+		response.ErrorResponse(w, http.StatusNotFound, notFound.Error(), h.logger)
+	case errors.As(err, &conflict):
+		response.ErrorResponse(w, http.StatusConflict, conflict.Error(), h.logger)
+	default:
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusInternalServerError, "Internal server error", h.logger)
+	}
+}
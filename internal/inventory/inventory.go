@@ -0,0 +1,27 @@
+package inventory
+
+import (
+	"context"
+
+	"films_library/internal/model"
+	"films_library/pkg/pagination"
+)
+
+type (
+	Usecase interface {
+		GetCopies(ctx context.Context, filter model.CopyFilter) ([]model.Copy, pagination.Page, error)
+		GetCopy(ctx context.Context, id uint64) (model.Copy, error)
+		AddCopy(ctx context.Context, req model.CopyRequest) (model.Copy, error)
+		UpdateCopy(ctx context.Context, id uint64, req model.CopyRequest) (model.Copy, error)
+		DeleteCopy(ctx context.Context, id uint64) error
+	}
+
+	Repository interface {
+		GetCopies(ctx context.Context, filter model.CopyFilter) ([]model.Copy, error)
+		CountCopies(ctx context.Context, filter model.CopyFilter) (int64, error)
+		GetCopy(ctx context.Context, id uint64) (model.Copy, error)
+		AddCopy(ctx context.Context, c model.Copy) (model.Copy, error)
+		UpdateCopy(ctx context.Context, c model.Copy) (model.Copy, error)
+		DeleteCopy(ctx context.Context, id uint64) error
+	}
+)
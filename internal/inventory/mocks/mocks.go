@@ -0,0 +1,224 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/inventory/inventory.go
+
+// Package mock_inventory is a generated GoMock package.
+package mock_inventory
+
+import (
+	context "context"
+	model "films_library/internal/model"
+	pagination "films_library/pkg/pagination"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUsecase is a mock of Usecase interface.
+type MockUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseMockRecorder
+}
+
+// MockUsecaseMockRecorder is the mock recorder for MockUsecase.
+type MockUsecaseMockRecorder struct {
+	mock *MockUsecase
+}
+
+// NewMockUsecase creates a new mock instance.
+func NewMockUsecase(ctrl *gomock.Controller) *MockUsecase {
+	mock := &MockUsecase{ctrl: ctrl}
+	mock.recorder = &MockUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
+	return m.recorder
+}
+
+// AddCopy mocks base method.
+func (m *MockUsecase) AddCopy(ctx context.Context, req model.CopyRequest) (model.Copy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCopy", ctx, req)
+	ret0, _ := ret[0].(model.Copy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddCopy indicates an expected call of AddCopy.
+func (mr *MockUsecaseMockRecorder) AddCopy(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCopy", reflect.TypeOf((*MockUsecase)(nil).AddCopy), ctx, req)
+}
+
+// DeleteCopy mocks base method.
+func (m *MockUsecase) DeleteCopy(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCopy", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCopy indicates an expected call of DeleteCopy.
+func (mr *MockUsecaseMockRecorder) DeleteCopy(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCopy", reflect.TypeOf((*MockUsecase)(nil).DeleteCopy), ctx, id)
+}
+
+// GetCopies mocks base method.
+func (m *MockUsecase) GetCopies(ctx context.Context, filter model.CopyFilter) ([]model.Copy, pagination.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCopies", ctx, filter)
+	ret0, _ := ret[0].([]model.Copy)
+	ret1, _ := ret[1].(pagination.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetCopies indicates an expected call of GetCopies.
+func (mr *MockUsecaseMockRecorder) GetCopies(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCopies", reflect.TypeOf((*MockUsecase)(nil).GetCopies), ctx, filter)
+}
+
+// GetCopy mocks base method.
+func (m *MockUsecase) GetCopy(ctx context.Context, id uint64) (model.Copy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCopy", ctx, id)
+	ret0, _ := ret[0].(model.Copy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCopy indicates an expected call of GetCopy.
+func (mr *MockUsecaseMockRecorder) GetCopy(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCopy", reflect.TypeOf((*MockUsecase)(nil).GetCopy), ctx, id)
+}
+
+// UpdateCopy mocks base method.
+func (m *MockUsecase) UpdateCopy(ctx context.Context, id uint64, req model.CopyRequest) (model.Copy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCopy", ctx, id, req)
+	ret0, _ := ret[0].(model.Copy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCopy indicates an expected call of UpdateCopy.
+func (mr *MockUsecaseMockRecorder) UpdateCopy(ctx, id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCopy", reflect.TypeOf((*MockUsecase)(nil).UpdateCopy), ctx, id, req)
+}
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddCopy mocks base method.
+func (m *MockRepository) AddCopy(ctx context.Context, c model.Copy) (model.Copy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCopy", ctx, c)
+	ret0, _ := ret[0].(model.Copy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddCopy indicates an expected call of AddCopy.
+func (mr *MockRepositoryMockRecorder) AddCopy(ctx, c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCopy", reflect.TypeOf((*MockRepository)(nil).AddCopy), ctx, c)
+}
+
+// CountCopies mocks base method.
+func (m *MockRepository) CountCopies(ctx context.Context, filter model.CopyFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountCopies", ctx, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountCopies indicates an expected call of CountCopies.
+func (mr *MockRepositoryMockRecorder) CountCopies(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountCopies", reflect.TypeOf((*MockRepository)(nil).CountCopies), ctx, filter)
+}
+
+// DeleteCopy mocks base method.
+func (m *MockRepository) DeleteCopy(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCopy", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCopy indicates an expected call of DeleteCopy.
+func (mr *MockRepositoryMockRecorder) DeleteCopy(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCopy", reflect.TypeOf((*MockRepository)(nil).DeleteCopy), ctx, id)
+}
+
+// GetCopies mocks base method.
+func (m *MockRepository) GetCopies(ctx context.Context, filter model.CopyFilter) ([]model.Copy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCopies", ctx, filter)
+	ret0, _ := ret[0].([]model.Copy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCopies indicates an expected call of GetCopies.
+func (mr *MockRepositoryMockRecorder) GetCopies(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCopies", reflect.TypeOf((*MockRepository)(nil).GetCopies), ctx, filter)
+}
+
+// GetCopy mocks base method.
+func (m *MockRepository) GetCopy(ctx context.Context, id uint64) (model.Copy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCopy", ctx, id)
+	ret0, _ := ret[0].(model.Copy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCopy indicates an expected call of GetCopy.
+func (mr *MockRepositoryMockRecorder) GetCopy(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCopy", reflect.TypeOf((*MockRepository)(nil).GetCopy), ctx, id)
+}
+
+// UpdateCopy mocks base method.
+func (m *MockRepository) UpdateCopy(ctx context.Context, c model.Copy) (model.Copy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCopy", ctx, c)
+	ret0, _ := ret[0].(model.Copy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCopy indicates an expected call of UpdateCopy.
+func (mr *MockRepositoryMockRecorder) UpdateCopy(ctx, c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCopy", reflect.TypeOf((*MockRepository)(nil).UpdateCopy), ctx, c)
+}
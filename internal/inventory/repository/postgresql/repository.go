@@ -0,0 +1,205 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"films_library/internal/model"
+	"films_library/pkg/pagination"
+	"films_library/pkg/postgres"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	foreignKeyViolation = "23503"
+	uniqueViolation     = "23505"
+)
+
+// copyColumns reads missing barcodes and acquisition dates as empty strings.
+const copyColumns = `c.copy_id, c.film_id, c.format, COALESCE(c.barcode, ''), c."condition",
+        COALESCE(to_char(c.acquired_on, 'YYYY-MM-DD'), ''), c.shelf, c.status, c.created_at, c.updated_at`
+
+type Repository struct {
+	db postgres.DBConn
+}
+
+func NewRepository(db postgres.DBConn) *Repository {
+	return &Repository{db}
+}
+
+// GetCopies lists copies in the order they were recorded.
+func (r *Repository) GetCopies(ctx context.Context, filter model.CopyFilter) ([]model.Copy, error) {
+	sqlQuery := `SELECT ` + copyColumns + ` FROM copy c`
+
+	backward := filter.Backward()
+
+	args, where := copyConditions(filter)
+	condition, clauses := filter.Keyset(pagination.Order{Key: "c.copy_id"}, &args)
+	if condition != "" {
+		where = append(where, condition)
+	}
+	for i, condition := range where {
+		if i == 0 {
+			sqlQuery += " WHERE " + condition
+		} else {
+			sqlQuery += " AND " + condition
+		}
+	}
+	sqlQuery += clauses
+
+	rows, err := r.db.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var copies []model.Copy
+	for rows.Next() {
+		c, err := scanCopy(rows)
+		if err != nil {
+			return nil, err
+		}
+		copies = append(copies, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if backward {
+		slices.Reverse(copies)
+	}
+	return copies, nil
+}
+
+func (r *Repository) CountCopies(ctx context.Context, filter model.CopyFilter) (int64, error) {
+	sqlQuery := `SELECT count(*) FROM copy c`
+
+	args, where := copyConditions(filter)
+	for i, condition := range where {
+		if i == 0 {
+			sqlQuery += " WHERE " + condition
+		} else {
+			sqlQuery += " AND " + condition
+		}
+	}
+
+	var total int64
+	if err := r.db.QueryRow(ctx, sqlQuery, args...).Scan(&total); err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+func copyConditions(filter model.CopyFilter) ([]interface{}, []string) {
+	var args []interface{}
+	var where []string
+	if filter.FilmID != 0 {
+		args = append(args, filter.FilmID)
+		where = append(where, fmt.Sprintf("c.film_id = $%d", len(args)))
+	}
+	if filter.Format != "" {
+		args = append(args, filter.Format)
+		where = append(where, fmt.Sprintf("c.format = $%d", len(args)))
+	}
+	if filter.Status != "" {
+		args = append(args, filter.Status)
+		where = append(where, fmt.Sprintf("c.status = $%d", len(args)))
+	}
+	return args, where
+}
+
+func (r *Repository) GetCopy(ctx context.Context, id uint64) (model.Copy, error) {
+	sqlQuery := `SELECT ` + copyColumns + ` FROM copy c WHERE c.copy_id=$1`
+
+	c, err := scanCopy(r.db.QueryRow(ctx, sqlQuery, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Copy{}, &model.ErrNotFound{Message: "copy not found"}
+		}
+		return model.Copy{}, err
+	}
+	return c, nil
+}
+
+func (r *Repository) AddCopy(ctx context.Context, c model.Copy) (model.Copy, error) {
+	sqlQuery := `
+        INSERT INTO copy AS c (film_id, format, barcode, "condition", acquired_on, shelf, status)
+        VALUES ($1, $2, NULLIF($3, ''), $4, NULLIF($5, '')::date, $6, $7)
+        RETURNING ` + copyColumns
+
+	added, err := scanCopy(r.db.QueryRow(ctx, sqlQuery,
+		c.FilmID, c.Format, c.Barcode, c.Condition, c.AcquiredOn, c.Shelf, c.Status))
+	if err != nil {
+		return model.Copy{}, copyError(err)
+	}
+	return added, nil
+}
+
+func (r *Repository) UpdateCopy(ctx context.Context, c model.Copy) (model.Copy, error) {
+	sqlQuery := `
+        UPDATE copy AS c SET film_id=$2, format=$3, barcode=NULLIF($4, ''), "condition"=$5,
+            acquired_on=NULLIF($6, '')::date, shelf=$7, status=$8, updated_at=now()
+        WHERE c.copy_id=$1
+        RETURNING ` + copyColumns
+
+	updated, err := scanCopy(r.db.QueryRow(ctx, sqlQuery,
+		c.ID, c.FilmID, c.Format, c.Barcode, c.Condition, c.AcquiredOn, c.Shelf, c.Status))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Copy{}, &model.ErrNotFound{Message: "copy not found"}
+		}
+		return model.Copy{}, copyError(err)
+	}
+	return updated, nil
+}
+
+func (r *Repository) DeleteCopy(ctx context.Context, id uint64) error {
+	res, err := r.db.Exec(ctx, `DELETE FROM copy WHERE copy_id=$1`, id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation {
+			return &model.ErrConflict{Message: "copy has loans, withdraw it instead"}
+		}
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return &model.ErrNotFound{Message: "copy not found"}
+	}
+	return nil
+}
+
+func scanCopy(row pgx.Row) (model.Copy, error) {
+	var c model.Copy
+	err := row.Scan(
+		&c.ID,
+		&c.FilmID,
+		&c.Format,
+		&c.Barcode,
+		&c.Condition,
+		&c.AcquiredOn,
+		&c.Shelf,
+		&c.Status,
+		&c.CreatedAt,
+		&c.UpdatedAt,
+	)
+	return c, err
+}
+
+func copyError(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+
+	switch pgErr.Code {
+	case foreignKeyViolation:
+		return &model.ErrNotFound{Message: "film not found"}
+	case uniqueViolation:
+		return &model.ErrConflict{Message: "barcode is already in use"}
+	}
+	return err
+}
@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+
+	"films_library/internal/inventory"
+	"films_library/internal/model"
+	"films_library/pkg/logger"
+	"films_library/pkg/pagination"
+)
+
+type InventoryUsecase struct {
+	inventoryRepo inventory.Repository
+	logger        logger.Interface
+}
+
+func NewInventoryUsecase(ir inventory.Repository, l logger.Interface) *InventoryUsecase {
+	return &InventoryUsecase{ir, l}
+}
+
+func (iu *InventoryUsecase) GetCopies(ctx context.Context, filter model.CopyFilter) ([]model.Copy, pagination.Page, error) {
+	copies, err := iu.inventoryRepo.GetCopies(ctx, filter)
+	if err != nil {
+		return []model.Copy{}, pagination.Page{}, err
+	}
+
+	copies, page := pagination.Paginate(copies, filter.Params, func(c model.Copy) pagination.Cursor {
+		return pagination.Cursor{ID: c.ID}
+	})
+
+	if filter.WithTotal {
+		total, err := iu.inventoryRepo.CountCopies(ctx, filter)
+		if err != nil {
+			return []model.Copy{}, pagination.Page{}, err
+		}
+		page.Total = &total
+	}
+	return copies, page, nil
+}
+
+func (iu *InventoryUsecase) GetCopy(ctx context.Context, id uint64) (model.Copy, error) {
+	return iu.inventoryRepo.GetCopy(ctx, id)
+}
+
+// AddCopy records a new copy, in circulation unless the request says otherwise.
+func (iu *InventoryUsecase) AddCopy(ctx context.Context, req model.CopyRequest) (model.Copy, error) {
+	c := copyFromRequest(req)
+	if c.Status == "" {
+		c.Status = model.CopyActive
+	}
+	return iu.inventoryRepo.AddCopy(ctx, c)
+}
+
+// UpdateCopy replaces the details of a copy. Its status is kept when the
+// request leaves it out.
+func (iu *InventoryUsecase) UpdateCopy(ctx context.Context, id uint64, req model.CopyRequest) (model.Copy, error) {
+	c := copyFromRequest(req)
+	c.ID = id
+	if c.Status == "" {
+		stored, err := iu.inventoryRepo.GetCopy(ctx, id)
+		if err != nil {
+			return model.Copy{}, err
+		}
+		c.Status = stored.Status
+	}
+	return iu.inventoryRepo.UpdateCopy(ctx, c)
+}
+
+func (iu *InventoryUsecase) DeleteCopy(ctx context.Context, id uint64) error {
+	return iu.inventoryRepo.DeleteCopy(ctx, id)
+}
+
+func copyFromRequest(req model.CopyRequest) model.Copy {
+	return model.Copy{
+		FilmID:     req.FilmID,
+		Format:     req.Format,
+		Barcode:    req.Barcode,
+		Condition:  req.Condition,
+		AcquiredOn: req.AcquiredOn,
+		Shelf:      req.Shelf,
+		Status:     req.Status,
+	}
+}
@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	mock_inventory "films_library/internal/inventory/mocks"
+	"films_library/internal/model"
+	"films_library/pkg/logger"
+
+	"github.com/golang/mock/gomock"
+)
+
+func TestInventoryUsecase_AddCopy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	loggerMock := logger.NewMockInterface(ctrl)
+	inventoryRepo := mock_inventory.NewMockRepository(ctrl)
+	usecase := NewInventoryUsecase(inventoryRepo, loggerMock)
+
+	ctx := context.Background()
+
+	testCases := []struct {
+		name           string
+		req            model.CopyRequest
+		expectedStatus string
+	}{
+		{
+			name:           "New copy is in circulation",
+			req:            model.CopyRequest{FilmID: 1, Format: model.FormatBluRay, Barcode: "4006381333931", Condition: model.ConditionNew},
+			expectedStatus: model.CopyActive,
+		},
+		{
+			name:           "Status from request is kept",
+			req:            model.CopyRequest{FilmID: 1, Format: model.FormatVHS, Condition: model.ConditionPoor, Status: model.CopyWithdrawn},
+			expectedStatus: model.CopyWithdrawn,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := model.Copy{
+				FilmID:    tc.req.FilmID,
+				Format:    tc.req.Format,
+				Barcode:   tc.req.Barcode,
+				Condition: tc.req.Condition,
+				Status:    tc.expectedStatus,
+			}
+			inventoryRepo.EXPECT().AddCopy(ctx, c).Return(c, nil)
+
+			added, err := usecase.AddCopy(ctx, tc.req)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(added, c) {
+				t.Errorf("Expected copy %v, got %v", c, added)
+			}
+		})
+	}
+}
+
+func TestInventoryUsecase_UpdateCopyKeepsStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	loggerMock := logger.NewMockInterface(ctrl)
+	inventoryRepo := mock_inventory.NewMockRepository(ctrl)
+	usecase := NewInventoryUsecase(inventoryRepo, loggerMock)
+
+	ctx := context.Background()
+	req := model.CopyRequest{FilmID: 1, Format: model.FormatDVD, Condition: model.ConditionFair, Shelf: "B-12"}
+	updated := model.Copy{ID: 3, FilmID: 1, Format: model.FormatDVD, Condition: model.ConditionFair, Shelf: "B-12", Status: model.CopyLost}
+
+	inventoryRepo.EXPECT().GetCopy(ctx, uint64(3)).Return(model.Copy{ID: 3, Status: model.CopyLost}, nil)
+	inventoryRepo.EXPECT().UpdateCopy(ctx, updated).Return(updated, nil)
+
+	c, err := usecase.UpdateCopy(ctx, 3, req)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(c, updated) {
+		t.Errorf("Expected copy %v, got %v", updated, c)
+	}
+}
@@ -0,0 +1,274 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"films_library/internal/auth"
+	"films_library/internal/loan"
+	"films_library/internal/model"
+	"films_library/pkg/logger"
+	"films_library/pkg/pagination"
+	"films_library/pkg/response"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mailru/easyjson"
+)
+
+type LoanHandler struct {
+	loanUsecase loan.Usecase
+	logger      logger.Interface
+}
+
+// LoanPermissions is the permission each loan route requires.
+var LoanPermissions = auth.Permissions{
+	"GET /loans":              auth.LoanRead,
+	"GET /loans/{id}":         auth.LoanRead,
+	"POST /loans":             auth.LoanWrite,
+	"POST /loans/{id}/return": auth.LoanWrite,
+	"POST /loans/{id}/renew":  auth.LoanWrite,
+	"GET /me/loans":           auth.Authenticated,
+}
+
+func NewLoanHandler(mux *http.ServeMux, lu loan.Usecase, l logger.Interface) {
+	r := &LoanHandler{lu, l}
+
+	mux.HandleFunc("GET /loans", r.GetLoans)
+	mux.HandleFunc("GET /loans/{id}", r.GetLoan)
+	mux.HandleFunc("POST /loans", r.Checkout)
+	mux.HandleFunc("POST /loans/{id}/return", r.Return)
+	mux.HandleFunc("POST /loans/{id}/renew", r.Renew)
+	mux.HandleFunc("GET /me/loans", r.GetMyLoans)
+}
+
+// GetLoans handles the HTTP GET request to retrieve a list of loans.
+// @Summary Get loans
+// @Description Retrieves a page of loans, newest first.
+// @Tags loans
+// @Produce json
+// @Param status query string false "Only loans in this state (current, overdue, returned)"
+// @Param borrower_id query integer false "Only loans of this borrower"
+// @Param limit query integer false "Page size (default 20, max 100)"
+// @Param offset query integer false "Number of loans to skip"
+// @Param cursor query string false "Cursor of the page to fetch"
+// @Param total query boolean false "Include the total number of loans"
+// @Success 200 {array} model.Loan "List of loans"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /loans [get]
+func (h *LoanHandler) GetLoans(w http.ResponseWriter, r *http.Request) {
+	var borrowerId uint64
+	if s := r.URL.Query().Get("borrower_id"); s != "" {
+		var err error
+		borrowerId, err = strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			h.logger.Error(err)
+			response.ErrorResponse(w, http.StatusBadRequest, "Bad query param", h.logger)
+			return
+		}
+	}
+
+	h.getLoans(w, r, borrowerId)
+}
+
+// GetMyLoans handles the HTTP GET request to retrieve the loans of the caller.
+// @Summary Get my loans
+// @Description Retrieves a page of the loans of the caller, newest first.
+// @Tags loans
+// @Produce json
+// @Param status query string false "Only loans in this state (current, overdue, returned)"
+// @Param limit query integer false "Page size (default 20, max 100)"
+// @Param offset query integer false "Number of loans to skip"
+// @Param cursor query string false "Cursor of the page to fetch"
+// @Param total query boolean false "Include the total number of loans"
+// @Success 200 {array} model.Loan "List of loans"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /me/loans [get]
+func (h *LoanHandler) GetMyLoans(w http.ResponseWriter, r *http.Request) {
+	user, ok := auth.UserFromContext(r.Context())
+	if !ok {
+		response.ErrorResponse(w, http.StatusUnauthorized, "unauthorized", h.logger)
+		return
+	}
+
+	h.getLoans(w, r, user.ID)
+}
+
+// getLoans serves a page of loans, of the borrower borrowerId when it is set.
+func (h *LoanHandler) getLoans(w http.ResponseWriter, r *http.Request, borrowerId uint64) {
+	queryParams := r.URL.Query()
+
+	params, err := pagination.ParseQuery(queryParams)
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Bad query param", h.logger)
+		return
+	}
+
+	filter := model.LoanFilter{BorrowerID: borrowerId, Status: queryParams.Get("status"), Params: params}
+
+	v := validator.New()
+	if err := v.Struct(filter); err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Invalid request", h.logger)
+		return
+	}
+
+	loans, page, err := h.loanUsecase.GetLoans(r.Context(), filter)
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.PageResponse(w, http.StatusOK, loans, page)
+}
+
+// GetLoan handles the HTTP GET request to retrieve a loan.
+// @Summary Get loan
+// @Description Retrieves a loan.
+// @Tags loans
+// @Produce json
+// @Param id path integer true "ID of the loan"
+// @Success 200 {object} model.Loan "Loan"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Loan not found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /loans/{id} [get]
+func (h *LoanHandler) GetLoan(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.id(w, r)
+	if !ok {
+		return
+	}
+
+	l, err := h.loanUsecase.GetLoan(r.Context(), id)
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusOK, l)
+}
+
+// Checkout handles the HTTP POST request to lend a copy.
+// @Summary Check out copy
+// @Description Lends a copy in circulation to a borrower. Without due_on the loan is due after the loan period; due_on may not be in the past.
+// @Tags loans
+// @Accept json
+// @Produce json
+// @Param loan body model.CheckoutRequest true "Copy and borrower"
+// @Success 201 {object} model.Loan "New loan"
+// @Failure 400 {string} string "Invalid request or due date in the past"
+// @Failure 404 {string} string "Copy or borrower not found"
+// @Failure 409 {string} string "Copy is on loan or not in circulation"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /loans [post]
+func (h *LoanHandler) Checkout(w http.ResponseWriter, r *http.Request) {
+	var req model.CheckoutRequest
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Corrupted request body", h.logger)
+		return
+	}
+
+	v := validator.New()
+	if err := v.Struct(req); err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Invalid request", h.logger)
+		return
+	}
+
+	l, err := h.loanUsecase.Checkout(r.Context(), req)
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusCreated, l)
+}
+
+// Return handles the HTTP POST request to return a copy.
+// @Summary Return loan
+// @Description Closes a current loan.
+// @Tags loans
+// @Produce json
+// @Param id path integer true "ID of the loan"
+// @Success 200 {object} model.Loan "Returned loan"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Loan not found"
+// @Failure 409 {string} string "Loan is already returned"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /loans/{id}/return [post]
+func (h *LoanHandler) Return(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.id(w, r)
+	if !ok {
+		return
+	}
+
+	l, err := h.loanUsecase.Return(r.Context(), id)
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusOK, l)
+}
+
+// Renew handles the HTTP POST request to renew a loan.
+// @Summary Renew loan
+// @Description Extends a current loan by the loan period, up to the renewal limit.
+// @Tags loans
+// @Produce json
+// @Param id path integer true "ID of the loan"
+// @Success 200 {object} model.Loan "Renewed loan"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Loan not found"
+// @Failure 409 {string} string "Loan is returned or at the renewal limit"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /loans/{id}/renew [post]
+func (h *LoanHandler) Renew(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.id(w, r)
+	if !ok {
+		return
+	}
+
+	l, err := h.loanUsecase.Renew(r.Context(), id)
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusOK, l)
+}
+
+// id reads the loan ID from the path.
+func (h *LoanHandler) id(w http.ResponseWriter, r *http.Request) (uint64, bool) {
+	id, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, response.InvalidURLParameter, h.logger)
+		return 0, false
+	}
+	return id, true
+}
+
+// usecaseError maps errors returned by the loan usecase onto HTTP responses.
+func (h *LoanHandler) usecaseError(w http.ResponseWriter, err error) {
+	var notFound *model.ErrNotFound
+	var conflict *model.ErrConflict
+	var badRequest *model.ErrBadRequest
+
+	switch {
+	case errors.As(err, &notFound):
+		response.ErrorResponse(w, http.StatusNotFound, notFound.Error(), h.logger)
+	case errors.As(err, &conflict):
+		response.ErrorResponse(w, http.StatusConflict, conflict.Error(), h.logger)
+	case errors.As(err, &badRequest):
+		response.ErrorResponse(w, http.StatusBadRequest, badRequest.Error(), h.logger)
+	default:
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusInternalServerError, "Internal server error", h.logger)
+	}
+}
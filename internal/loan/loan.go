@@ -0,0 +1,32 @@
+package loan
+
+import (
+	"context"
+
+	"films_library/internal/model"
+	"films_library/pkg/pagination"
+)
+
+type (
+	Usecase interface {
+		GetLoans(ctx context.Context, filter model.LoanFilter) ([]model.Loan, pagination.Page, error)
+		GetLoan(ctx context.Context, id uint64) (model.Loan, error)
+		Checkout(ctx context.Context, req model.CheckoutRequest) (model.Loan, error)
+		Return(ctx context.Context, id uint64) (model.Loan, error)
+		Renew(ctx context.Context, id uint64) (model.Loan, error)
+
+		// MarkOverdue flags the current loans past their due date. It is
+		// run periodically in the background.
+		MarkOverdue(ctx context.Context) error
+	}
+
+	Repository interface {
+		GetLoans(ctx context.Context, filter model.LoanFilter) ([]model.Loan, error)
+		CountLoans(ctx context.Context, filter model.LoanFilter) (int64, error)
+		GetLoan(ctx context.Context, id uint64) (model.Loan, error)
+		Checkout(ctx context.Context, req model.CheckoutRequest, periodDays int) (model.Loan, error)
+		Return(ctx context.Context, id uint64) (model.Loan, error)
+		Renew(ctx context.Context, id uint64, policy model.LoanPolicy) (model.Loan, error)
+		MarkOverdue(ctx context.Context) (int64, error)
+	}
+)
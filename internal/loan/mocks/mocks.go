@@ -0,0 +1,255 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/loan/loan.go
+
+// Package mock_loan is a generated GoMock package.
+package mock_loan
+
+import (
+	context "context"
+	model "films_library/internal/model"
+	pagination "films_library/pkg/pagination"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUsecase is a mock of Usecase interface.
+type MockUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseMockRecorder
+}
+
+// MockUsecaseMockRecorder is the mock recorder for MockUsecase.
+type MockUsecaseMockRecorder struct {
+	mock *MockUsecase
+}
+
+// NewMockUsecase creates a new mock instance.
+func NewMockUsecase(ctrl *gomock.Controller) *MockUsecase {
+	mock := &MockUsecase{ctrl: ctrl}
+	mock.recorder = &MockUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Checkout mocks base method.
+func (m *MockUsecase) Checkout(ctx context.Context, req model.CheckoutRequest) (model.Loan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Checkout", ctx, req)
+	ret0, _ := ret[0].(model.Loan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Checkout indicates an expected call of Checkout.
+func (mr *MockUsecaseMockRecorder) Checkout(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Checkout", reflect.TypeOf((*MockUsecase)(nil).Checkout), ctx, req)
+}
+
+// GetLoan mocks base method.
+func (m *MockUsecase) GetLoan(ctx context.Context, id uint64) (model.Loan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoan", ctx, id)
+	ret0, _ := ret[0].(model.Loan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLoan indicates an expected call of GetLoan.
+func (mr *MockUsecaseMockRecorder) GetLoan(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoan", reflect.TypeOf((*MockUsecase)(nil).GetLoan), ctx, id)
+}
+
+// GetLoans mocks base method.
+func (m *MockUsecase) GetLoans(ctx context.Context, filter model.LoanFilter) ([]model.Loan, pagination.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoans", ctx, filter)
+	ret0, _ := ret[0].([]model.Loan)
+	ret1, _ := ret[1].(pagination.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLoans indicates an expected call of GetLoans.
+func (mr *MockUsecaseMockRecorder) GetLoans(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoans", reflect.TypeOf((*MockUsecase)(nil).GetLoans), ctx, filter)
+}
+
+// MarkOverdue mocks base method.
+func (m *MockUsecase) MarkOverdue(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkOverdue", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkOverdue indicates an expected call of MarkOverdue.
+func (mr *MockUsecaseMockRecorder) MarkOverdue(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkOverdue", reflect.TypeOf((*MockUsecase)(nil).MarkOverdue), ctx)
+}
+
+// Renew mocks base method.
+func (m *MockUsecase) Renew(ctx context.Context, id uint64) (model.Loan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Renew", ctx, id)
+	ret0, _ := ret[0].(model.Loan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Renew indicates an expected call of Renew.
+func (mr *MockUsecaseMockRecorder) Renew(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Renew", reflect.TypeOf((*MockUsecase)(nil).Renew), ctx, id)
+}
+
+// Return mocks base method.
+func (m *MockUsecase) Return(ctx context.Context, id uint64) (model.Loan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Return", ctx, id)
+	ret0, _ := ret[0].(model.Loan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Return indicates an expected call of Return.
+func (mr *MockUsecaseMockRecorder) Return(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Return", reflect.TypeOf((*MockUsecase)(nil).Return), ctx, id)
+}
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Checkout mocks base method.
+func (m *MockRepository) Checkout(ctx context.Context, req model.CheckoutRequest, periodDays int) (model.Loan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Checkout", ctx, req, periodDays)
+	ret0, _ := ret[0].(model.Loan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Checkout indicates an expected call of Checkout.
+func (mr *MockRepositoryMockRecorder) Checkout(ctx, req, periodDays interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Checkout", reflect.TypeOf((*MockRepository)(nil).Checkout), ctx, req, periodDays)
+}
+
+// CountLoans mocks base method.
+func (m *MockRepository) CountLoans(ctx context.Context, filter model.LoanFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountLoans", ctx, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountLoans indicates an expected call of CountLoans.
+func (mr *MockRepositoryMockRecorder) CountLoans(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountLoans", reflect.TypeOf((*MockRepository)(nil).CountLoans), ctx, filter)
+}
+
+// GetLoan mocks base method.
+func (m *MockRepository) GetLoan(ctx context.Context, id uint64) (model.Loan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoan", ctx, id)
+	ret0, _ := ret[0].(model.Loan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLoan indicates an expected call of GetLoan.
+func (mr *MockRepositoryMockRecorder) GetLoan(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoan", reflect.TypeOf((*MockRepository)(nil).GetLoan), ctx, id)
+}
+
+// GetLoans mocks base method.
+func (m *MockRepository) GetLoans(ctx context.Context, filter model.LoanFilter) ([]model.Loan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoans", ctx, filter)
+	ret0, _ := ret[0].([]model.Loan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLoans indicates an expected call of GetLoans.
+func (mr *MockRepositoryMockRecorder) GetLoans(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoans", reflect.TypeOf((*MockRepository)(nil).GetLoans), ctx, filter)
+}
+
+// MarkOverdue mocks base method.
+func (m *MockRepository) MarkOverdue(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkOverdue", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkOverdue indicates an expected call of MarkOverdue.
+func (mr *MockRepositoryMockRecorder) MarkOverdue(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkOverdue", reflect.TypeOf((*MockRepository)(nil).MarkOverdue), ctx)
+}
+
+// Renew mocks base method.
+func (m *MockRepository) Renew(ctx context.Context, id uint64, policy model.LoanPolicy) (model.Loan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Renew", ctx, id, policy)
+	ret0, _ := ret[0].(model.Loan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Renew indicates an expected call of Renew.
+func (mr *MockRepositoryMockRecorder) Renew(ctx, id, policy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Renew", reflect.TypeOf((*MockRepository)(nil).Renew), ctx, id, policy)
+}
+
+// Return mocks base method.
+func (m *MockRepository) Return(ctx context.Context, id uint64) (model.Loan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Return", ctx, id)
+	ret0, _ := ret[0].(model.Loan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Return indicates an expected call of Return.
+func (mr *MockRepositoryMockRecorder) Return(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Return", reflect.TypeOf((*MockRepository)(nil).Return), ctx, id)
+}
@@ -0,0 +1,350 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"films_library/internal/model"
+	"films_library/pkg/pagination"
+	"films_library/pkg/postgres"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	foreignKeyViolation = "23503"
+	uniqueViolation     = "23505"
+)
+
+const loanColumns = `l.loan_id, l.copy_id, c.film_id, f.title, l.borrower_id, u.username, l.checked_out_at,
+        to_char(l.due_on, 'YYYY-MM-DD'), l.renewals, l.returned_at, l.overdue`
+
+const loanJoins = ` FROM loan l
+        JOIN copy c ON c.copy_id = l.copy_id
+        JOIN film f ON f.film_id = c.film_id
+        JOIN users u ON u.user_id = l.borrower_id`
+
+type Repository struct {
+	db postgres.DBConn
+}
+
+func NewRepository(db postgres.DBConn) *Repository {
+	return &Repository{db}
+}
+
+// GetLoans lists loans newest first.
+func (r *Repository) GetLoans(ctx context.Context, filter model.LoanFilter) ([]model.Loan, error) {
+	sqlQuery := `SELECT ` + loanColumns + loanJoins
+
+	backward := filter.Backward()
+
+	args, where := loanConditions(filter)
+	condition, clauses := filter.Keyset(pagination.Order{Key: "l.loan_id", Desc: true}, &args)
+	if condition != "" {
+		where = append(where, condition)
+	}
+	for i, condition := range where {
+		if i == 0 {
+			sqlQuery += " WHERE " + condition
+		} else {
+			sqlQuery += " AND " + condition
+		}
+	}
+	sqlQuery += clauses
+
+	rows, err := r.db.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var loans []model.Loan
+	for rows.Next() {
+		l, err := scanLoan(rows)
+		if err != nil {
+			return nil, err
+		}
+		loans = append(loans, l)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if backward {
+		slices.Reverse(loans)
+	}
+	return loans, nil
+}
+
+func (r *Repository) CountLoans(ctx context.Context, filter model.LoanFilter) (int64, error) {
+	sqlQuery := `SELECT count(*) FROM loan l`
+
+	args, where := loanConditions(filter)
+	for i, condition := range where {
+		if i == 0 {
+			sqlQuery += " WHERE " + condition
+		} else {
+			sqlQuery += " AND " + condition
+		}
+	}
+
+	var total int64
+	if err := r.db.QueryRow(ctx, sqlQuery, args...).Scan(&total); err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+func loanConditions(filter model.LoanFilter) ([]interface{}, []string) {
+	var args []interface{}
+	var where []string
+	if filter.BorrowerID != 0 {
+		args = append(args, filter.BorrowerID)
+		where = append(where, fmt.Sprintf("l.borrower_id = $%d", len(args)))
+	}
+	switch filter.Status {
+	case model.LoanCurrent:
+		where = append(where, "l.returned_at IS NULL")
+	case model.LoanOverdue:
+		where = append(where, "l.returned_at IS NULL AND l.overdue")
+	case model.LoanReturned:
+		where = append(where, "l.returned_at IS NOT NULL")
+	}
+	return args, where
+}
+
+func (r *Repository) GetLoan(ctx context.Context, id uint64) (model.Loan, error) {
+	return getLoan(ctx, r.db, id)
+}
+
+// Checkout lends a copy in circulation that is not already on loan to an
+// active member. A copy set aside for a hold is lent to its holder only,
+// collecting the hold.
+// Without a due date the loan is due periodDays from today.
+func (r *Repository) Checkout(ctx context.Context, req model.CheckoutRequest, periodDays int) (model.Loan, error) {
+	var checkedOut model.Loan
+	err := r.db.BeginTxFunc(ctx, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		var status string
+		err := tx.QueryRow(ctx, `SELECT status FROM copy WHERE copy_id=$1 FOR UPDATE`, req.CopyID).Scan(&status)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return &model.ErrNotFound{Message: "copy not found"}
+			}
+			return err
+		}
+		if status != model.CopyActive {
+			return &model.ErrConflict{Message: "copy is not in circulation"}
+		}
+
+		var onLoan bool
+		err = tx.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM loan WHERE copy_id=$1 AND returned_at IS NULL)`, req.CopyID).Scan(&onLoan)
+		if err != nil {
+			return err
+		}
+		if onLoan {
+			return &model.ErrConflict{Message: "copy is already on loan"}
+		}
+
+		if err := collectHold(ctx, tx, req.CopyID, req.BorrowerID); err != nil {
+			return err
+		}
+		if err := checkMembership(ctx, tx, req.BorrowerID); err != nil {
+			return err
+		}
+
+		sqlQuery := `
+            INSERT INTO loan (copy_id, borrower_id, due_on)
+            VALUES ($1, $2, COALESCE(NULLIF($3, '')::date, current_date + $4::int))
+            RETURNING loan_id`
+
+		var id uint64
+		if err := tx.QueryRow(ctx, sqlQuery, req.CopyID, req.BorrowerID, req.DueOn, periodDays).Scan(&id); err != nil {
+			return loanError(err)
+		}
+
+		checkedOut, err = getLoan(ctx, tx, id)
+		return err
+	})
+	if err != nil {
+		return model.Loan{}, err
+	}
+	return checkedOut, nil
+}
+
+// Return closes a current loan.
+func (r *Repository) Return(ctx context.Context, id uint64) (model.Loan, error) {
+	var returned model.Loan
+	err := r.db.BeginTxFunc(ctx, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		if _, err := lockCurrentLoan(ctx, tx, id); err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(ctx, `UPDATE loan SET returned_at=now() WHERE loan_id=$1`, id); err != nil {
+			return err
+		}
+
+		var err error
+		returned, err = getLoan(ctx, tx, id)
+		return err
+	})
+	if err != nil {
+		return model.Loan{}, err
+	}
+	return returned, nil
+}
+
+// Renew extends a current loan by the loan period, counted from its due date
+// or from today when it is overdue.
+func (r *Repository) Renew(ctx context.Context, id uint64, policy model.LoanPolicy) (model.Loan, error) {
+	var renewed model.Loan
+	err := r.db.BeginTxFunc(ctx, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		renewals, err := lockCurrentLoan(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if renewals >= policy.RenewLimit {
+			return &model.ErrConflict{Message: "loan has reached the renewal limit"}
+		}
+
+		sqlQuery := `
+            UPDATE loan SET due_on = GREATEST(due_on, current_date) + $2::int, renewals = renewals + 1, overdue = false
+            WHERE loan_id=$1`
+		if _, err := tx.Exec(ctx, sqlQuery, id, policy.PeriodDays); err != nil {
+			return err
+		}
+
+		renewed, err = getLoan(ctx, tx, id)
+		return err
+	})
+	if err != nil {
+		return model.Loan{}, err
+	}
+	return renewed, nil
+}
+
+// MarkOverdue flags the current loans past their due date and returns how
+// many were flagged.
+func (r *Repository) MarkOverdue(ctx context.Context) (int64, error) {
+	sqlQuery := `UPDATE loan SET overdue = true WHERE returned_at IS NULL AND NOT overdue AND due_on < current_date`
+
+	res, err := r.db.Exec(ctx, sqlQuery)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected(), nil
+}
+
+// collectHold marks the hold a copy is set aside for as collected when
+// borrowerID is its holder. Copies held for someone else are a conflict.
+func collectHold(ctx context.Context, tx pgx.Tx, copyID, borrowerID uint64) error {
+	var holdID, holderID uint64
+	err := tx.QueryRow(ctx, `SELECT hold_id, user_id FROM hold WHERE copy_id=$1 AND status='ready' FOR UPDATE`, copyID).
+		Scan(&holdID, &holderID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if holderID != borrowerID {
+		return &model.ErrConflict{Message: "copy is held for another borrower"}
+	}
+
+	_, err = tx.Exec(ctx, `UPDATE hold SET status='collected', closed_at=now() WHERE hold_id=$1`, holdID)
+	return err
+}
+
+// checkMembership lets borrowers with an active, unexpired membership borrow.
+func checkMembership(ctx context.Context, tx pgx.Tx, borrowerID uint64) error {
+	var status string
+	var expired bool
+	err := tx.QueryRow(ctx, `SELECT status, expires_on < current_date FROM member WHERE user_id=$1`, borrowerID).
+		Scan(&status, &expired)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return &model.ErrConflict{Message: "borrower is not a member"}
+	}
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case status != model.MemberActive:
+		return &model.ErrConflict{Message: "borrower membership is suspended"}
+	case expired:
+		return &model.ErrConflict{Message: "borrower membership has expired"}
+	}
+	return nil
+}
+
+// lockCurrentLoan keeps a loan locked until the transaction ends and returns
+// how often it was renewed. Returned loans are a conflict.
+func lockCurrentLoan(ctx context.Context, tx pgx.Tx, id uint64) (int, error) {
+	var renewals int
+	var returnedAt *time.Time
+	err := tx.QueryRow(ctx, `SELECT renewals, returned_at FROM loan WHERE loan_id=$1 FOR UPDATE`, id).Scan(&renewals, &returnedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, &model.ErrNotFound{Message: "loan not found"}
+		}
+		return 0, err
+	}
+	if returnedAt != nil {
+		return 0, &model.ErrConflict{Message: "loan is already returned"}
+	}
+	return renewals, nil
+}
+
+// querier is what getLoan needs of a pool or a transaction.
+type querier interface {
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+}
+
+func getLoan(ctx context.Context, q querier, id uint64) (model.Loan, error) {
+	sqlQuery := `SELECT ` + loanColumns + loanJoins + ` WHERE l.loan_id=$1`
+
+	l, err := scanLoan(q.QueryRow(ctx, sqlQuery, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Loan{}, &model.ErrNotFound{Message: "loan not found"}
+		}
+		return model.Loan{}, err
+	}
+	return l, nil
+}
+
+func scanLoan(row pgx.Row) (model.Loan, error) {
+	var l model.Loan
+	err := row.Scan(
+		&l.ID,
+		&l.CopyID,
+		&l.FilmID,
+		&l.Title,
+		&l.BorrowerID,
+		&l.Borrower,
+		&l.CheckedOutAt,
+		&l.DueOn,
+		&l.Renewals,
+		&l.ReturnedAt,
+		&l.Overdue,
+	)
+	return l, err
+}
+
+func loanError(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+
+	switch pgErr.Code {
+	case foreignKeyViolation:
+		return &model.ErrNotFound{Message: "borrower not found"}
+	case uniqueViolation:
+		return &model.ErrConflict{Message: "copy is already on loan"}
+	}
+	return err
+}
@@ -0,0 +1,113 @@
+package postgresql
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"films_library/internal/model"
+
+	"github.com/pashagolub/pgxmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckoutRejectsUnavailableCopies(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mock.Close()
+
+	repo := NewRepository(mock)
+	req := model.CheckoutRequest{CopyID: 4, BorrowerID: 2}
+
+	testCases := []struct {
+		name    string
+		status  string
+		onLoan  bool
+		message string
+	}{
+		{name: "Copy on loan", status: model.CopyActive, onLoan: true, message: "copy is already on loan"},
+		{name: "Copy withdrawn", status: model.CopyWithdrawn, message: "copy is not in circulation"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT status FROM copy WHERE copy_id=$1 FOR UPDATE`)).
+				WithArgs(uint64(4)).
+				WillReturnRows(pgxmock.NewRows([]string{"status"}).AddRow(tc.status))
+			if tc.status == model.CopyActive {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT EXISTS(SELECT 1 FROM loan WHERE copy_id=$1 AND returned_at IS NULL)`)).
+					WithArgs(uint64(4)).
+					WillReturnRows(pgxmock.NewRows([]string{"exists"}).AddRow(tc.onLoan))
+			}
+			// pgxmock's BeginTxFunc rolls back once on the error and
+			// again in its deferred cleanup.
+			mock.ExpectRollback()
+			mock.ExpectRollback()
+
+			_, err := repo.Checkout(context.Background(), req, 14)
+			assert.Equal(t, &model.ErrConflict{Message: tc.message}, err)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestCheckoutKeepsHeldCopiesForHolder(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mock.Close()
+
+	repo := NewRepository(mock)
+	req := model.CheckoutRequest{CopyID: 4, BorrowerID: 2}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT status FROM copy WHERE copy_id=$1 FOR UPDATE`)).
+		WithArgs(uint64(4)).
+		WillReturnRows(pgxmock.NewRows([]string{"status"}).AddRow(model.CopyActive))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT EXISTS(SELECT 1 FROM loan WHERE copy_id=$1 AND returned_at IS NULL)`)).
+		WithArgs(uint64(4)).
+		WillReturnRows(pgxmock.NewRows([]string{"exists"}).AddRow(false))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT hold_id, user_id FROM hold WHERE copy_id=$1 AND status='ready' FOR UPDATE`)).
+		WithArgs(uint64(4)).
+		WillReturnRows(pgxmock.NewRows([]string{"hold_id", "user_id"}).AddRow(uint64(5), uint64(3)))
+	mock.ExpectRollback()
+	mock.ExpectRollback()
+
+	_, err = repo.Checkout(context.Background(), req, 14)
+	assert.Equal(t, &model.ErrConflict{Message: "copy is held for another borrower"}, err)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestRenewStopsAtLimit(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mock.Close()
+
+	repo := NewRepository(mock)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT renewals, returned_at FROM loan WHERE loan_id=$1 FOR UPDATE`)).
+		WithArgs(uint64(9)).
+		WillReturnRows(pgxmock.NewRows([]string{"renewals", "returned_at"}).AddRow(2, nil))
+	mock.ExpectRollback()
+	mock.ExpectRollback()
+
+	_, err = repo.Renew(context.Background(), 9, model.LoanPolicy{PeriodDays: 14, RenewLimit: 2})
+	assert.Equal(t, &model.ErrConflict{Message: "loan has reached the renewal limit"}, err)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"films_library/internal/hold"
+	"films_library/internal/loan"
+	"films_library/internal/model"
+	"films_library/pkg/logger"
+	"films_library/pkg/pagination"
+)
+
+type LoanUsecase struct {
+	loanRepo    loan.Repository
+	HoldUsecase hold.Usecase
+	policy      model.LoanPolicy
+	logger      logger.Interface
+}
+
+func NewLoanUsecase(lr loan.Repository, hu hold.Usecase, policy model.LoanPolicy, l logger.Interface) *LoanUsecase {
+	return &LoanUsecase{lr, hu, policy, l}
+}
+
+func (lu *LoanUsecase) GetLoans(ctx context.Context, filter model.LoanFilter) ([]model.Loan, pagination.Page, error) {
+	loans, err := lu.loanRepo.GetLoans(ctx, filter)
+	if err != nil {
+		return []model.Loan{}, pagination.Page{}, err
+	}
+
+	loans, page := pagination.Paginate(loans, filter.Params, func(l model.Loan) pagination.Cursor {
+		return pagination.Cursor{ID: l.ID}
+	})
+
+	if filter.WithTotal {
+		total, err := lu.loanRepo.CountLoans(ctx, filter)
+		if err != nil {
+			return []model.Loan{}, pagination.Page{}, err
+		}
+		page.Total = &total
+	}
+	return loans, page, nil
+}
+
+func (lu *LoanUsecase) GetLoan(ctx context.Context, id uint64) (model.Loan, error) {
+	return lu.loanRepo.GetLoan(ctx, id)
+}
+
+// Checkout lends a copy. A loan may not be due before the day it is made.
+func (lu *LoanUsecase) Checkout(ctx context.Context, req model.CheckoutRequest) (model.Loan, error) {
+	if req.DueOn != "" {
+		dueOn, err := time.Parse(time.DateOnly, req.DueOn)
+		if err != nil {
+			return model.Loan{}, &model.ErrBadRequest{Message: "due_on must be a date as YYYY-MM-DD"}
+		}
+		now := time.Now()
+		if dueOn.Before(time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)) {
+			return model.Loan{}, &model.ErrBadRequest{Message: "due_on is in the past"}
+		}
+	}
+	return lu.loanRepo.Checkout(ctx, req, lu.policy.PeriodDays)
+}
+
+// Return closes a loan and sets the copy aside for the next hold on its film.
+// The loan stays returned when that fails; the copy is then passed on by the
+// next hold expiry run.
+func (lu *LoanUsecase) Return(ctx context.Context, id uint64) (model.Loan, error) {
+	returned, err := lu.loanRepo.Return(ctx, id)
+	if err != nil {
+		return model.Loan{}, err
+	}
+
+	if err := lu.HoldUsecase.CopyReturned(ctx, returned.CopyID); err != nil {
+		lu.logger.Error(fmt.Errorf("loans - Return - CopyReturned: %w", err))
+	}
+	return returned, nil
+}
+
+func (lu *LoanUsecase) Renew(ctx context.Context, id uint64) (model.Loan, error) {
+	return lu.loanRepo.Renew(ctx, id, lu.policy)
+}
+
+func (lu *LoanUsecase) MarkOverdue(ctx context.Context) error {
+	flagged, err := lu.loanRepo.MarkOverdue(ctx)
+	if err != nil {
+		return err
+	}
+	if flagged > 0 {
+		lu.logger.Info(fmt.Sprintf("loans - MarkOverdue: %d loans overdue", flagged))
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mock_hold "films_library/internal/hold/mocks"
+	mock_loan "films_library/internal/loan/mocks"
+	"films_library/internal/model"
+	"films_library/pkg/logger"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoanUsecase_MarkOverdue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	loggerMock := logger.NewMockInterface(ctrl)
+	loanRepo := mock_loan.NewMockRepository(ctrl)
+	holdUsecase := mock_hold.NewMockUsecase(ctrl)
+	usecase := NewLoanUsecase(loanRepo, holdUsecase, model.LoanPolicy{PeriodDays: 14, RenewLimit: 2}, loggerMock)
+
+	ctx := context.Background()
+
+	testCases := []struct {
+		name      string
+		flagged   int64
+		repoError error
+		logged    bool
+	}{
+		{name: "Nothing overdue is not logged", flagged: 0},
+		{name: "Flagged loans are logged", flagged: 3, logged: true},
+		{name: "Repository error", repoError: errors.New("db down")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			loanRepo.EXPECT().MarkOverdue(ctx).Return(tc.flagged, tc.repoError)
+			if tc.logged {
+				loggerMock.EXPECT().Info("loans - MarkOverdue: 3 loans overdue")
+			}
+
+			err := usecase.MarkOverdue(ctx)
+			assert.Equal(t, tc.repoError, err)
+		})
+	}
+}
+
+func TestLoanUsecase_CheckoutUsesLoanPeriod(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	loggerMock := logger.NewMockInterface(ctrl)
+	loanRepo := mock_loan.NewMockRepository(ctrl)
+	holdUsecase := mock_hold.NewMockUsecase(ctrl)
+	usecase := NewLoanUsecase(loanRepo, holdUsecase, model.LoanPolicy{PeriodDays: 21, RenewLimit: 1}, loggerMock)
+
+	ctx := context.Background()
+	req := model.CheckoutRequest{CopyID: 4, BorrowerID: 2}
+	loanRepo.EXPECT().Checkout(ctx, req, 21).Return(model.Loan{ID: 1, CopyID: 4, BorrowerID: 2}, nil)
+
+	l, err := usecase.Checkout(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), l.ID)
+}
+
+func TestLoanUsecase_CheckoutRejectsPastDueDate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	loanRepo := mock_loan.NewMockRepository(ctrl)
+	usecase := NewLoanUsecase(loanRepo, mock_hold.NewMockUsecase(ctrl), model.LoanPolicy{PeriodDays: 21}, logger.NewMockInterface(ctrl))
+
+	ctx := context.Background()
+	_, err := usecase.Checkout(ctx, model.CheckoutRequest{CopyID: 4, BorrowerID: 2, DueOn: "2000-01-01"})
+	var badRequest *model.ErrBadRequest
+	assert.ErrorAs(t, err, &badRequest)
+
+	today := model.CheckoutRequest{CopyID: 4, BorrowerID: 2, DueOn: time.Now().Format(time.DateOnly)}
+	loanRepo.EXPECT().Checkout(ctx, today, 21).Return(model.Loan{ID: 1}, nil)
+
+	_, err = usecase.Checkout(ctx, today)
+	assert.NoError(t, err)
+}
+
+func TestLoanUsecase_ReturnPassesCopyToHolds(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	loggerMock := logger.NewMockInterface(ctrl)
+	loanRepo := mock_loan.NewMockRepository(ctrl)
+	holdUsecase := mock_hold.NewMockUsecase(ctrl)
+	usecase := NewLoanUsecase(loanRepo, holdUsecase, model.LoanPolicy{PeriodDays: 14, RenewLimit: 2}, loggerMock)
+
+	ctx := context.Background()
+	returned := model.Loan{ID: 1, CopyID: 4, BorrowerID: 2}
+
+	testCases := []struct {
+		name      string
+		holdError error
+	}{
+		{name: "Copy goes to the next hold"},
+		{name: "Hold error keeps the loan returned", holdError: errors.New("db down")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			loanRepo.EXPECT().Return(ctx, returned.ID).Return(returned, nil)
+			holdUsecase.EXPECT().CopyReturned(ctx, returned.CopyID).Return(tc.holdError)
+			if tc.holdError != nil {
+				loggerMock.EXPECT().Error(gomock.Any())
+			}
+
+			l, err := usecase.Return(ctx, returned.ID)
+			assert.NoError(t, err)
+			assert.Equal(t, returned, l)
+		})
+	}
+}
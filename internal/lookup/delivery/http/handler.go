@@ -0,0 +1,238 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"films_library/internal/auth"
+	"films_library/internal/lookup"
+	"films_library/internal/model"
+	"films_library/pkg/barcode"
+	"films_library/pkg/logger"
+	"films_library/pkg/response"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mailru/easyjson"
+)
+
+type LookupHandler struct {
+	lookupUsecase lookup.Usecase
+	logger        logger.Interface
+}
+
+// LookupPermissions is the permission each lookup route requires.
+var LookupPermissions = auth.Permissions{
+	"GET /lookup":                     auth.CopyRead,
+	"POST /lookup":                    auth.CopyRead,
+	"GET /films/{id}/codes":           auth.FilmRead,
+	"POST /films/{id}/codes":          auth.FilmWrite,
+	"DELETE /films/{id}/codes/{code}": auth.FilmWrite,
+}
+
+func NewLookupHandler(mux *http.ServeMux, lu lookup.Usecase, l logger.Interface) {
+	r := &LookupHandler{lu, l}
+
+	mux.HandleFunc("GET /lookup", r.Lookup)
+	mux.HandleFunc("POST /lookup", r.LookupBatch)
+	mux.HandleFunc("GET /films/{id}/codes", r.GetProductCodes)
+	mux.HandleFunc("POST /films/{id}/codes", r.AddProductCode)
+	mux.HandleFunc("DELETE /films/{id}/codes/{code}", r.DeleteProductCode)
+}
+
+// Lookup handles the HTTP GET request to look up a scanned code.
+// @Summary Look up code
+// @Description Matches a code against copy barcodes, then against the EAN-13/UPC-A product codes of films. Copy matches carry the copy status, circulation and due date.
+// @Tags lookup
+// @Produce json
+// @Param code query string true "Scanned code"
+// @Success 200 {object} model.LookupResult "Match"
+// @Failure 400 {string} string "Missing code or invalid checksum"
+// @Failure 404 {string} string "Code not found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /lookup [get]
+func (h *LookupHandler) Lookup(w http.ResponseWriter, r *http.Request) {
+	code := r.URL.Query().Get("code")
+	if code == "" || len(code) > 32 {
+		response.ErrorResponse(w, http.StatusBadRequest, "Bad query param", h.logger)
+		return
+	}
+
+	results, err := h.lookupUsecase.Lookup(r.Context(), []string{code})
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	switch result := results[0]; result.Error {
+	case model.LookupInvalidChecksum:
+		response.ErrorResponse(w, http.StatusBadRequest, result.Error, h.logger)
+	case model.LookupNotFound:
+		response.ErrorResponse(w, http.StatusNotFound, result.Error, h.logger)
+	default:
+		response.SuccessResponse(w, http.StatusOK, result)
+	}
+}
+
+// LookupBatch handles the HTTP POST request to look up a batch of scanned codes.
+// @Summary Look up codes
+// @Description Looks up up to 500 codes, as in stocktaking. Results keep the order of the codes; codes matching nothing carry an error.
+// @Tags lookup
+// @Accept json
+// @Produce json
+// @Param codes body model.LookupRequest true "Scanned codes"
+// @Success 200 {array} model.LookupResult "Matches"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /lookup [post]
+func (h *LookupHandler) LookupBatch(w http.ResponseWriter, r *http.Request) {
+	var req model.LookupRequest
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Corrupted request body", h.logger)
+		return
+	}
+
+	v := validator.New()
+	if err := v.Struct(req); err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Invalid request", h.logger)
+		return
+	}
+
+	results, err := h.lookupUsecase.Lookup(r.Context(), req.Codes)
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusOK, results)
+}
+
+// GetProductCodes handles the HTTP GET request to retrieve the product codes of a film.
+// @Summary Get film product codes
+// @Description Retrieves the EAN-13 product codes of the releases of a film.
+// @Tags lookup
+// @Produce json
+// @Param id path integer true "ID of the film"
+// @Success 200 {array} model.ProductCode "Product codes"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /films/{id}/codes [get]
+func (h *LookupHandler) GetProductCodes(w http.ResponseWriter, r *http.Request) {
+	filmId, ok := h.filmId(w, r)
+	if !ok {
+		return
+	}
+
+	codes, err := h.lookupUsecase.GetProductCodes(r.Context(), filmId)
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusOK, codes)
+}
+
+// AddProductCode handles the HTTP POST request to add a product code to a film.
+// @Summary Add film product code
+// @Description Stores the EAN-13 or UPC-A code of a release of a film, UPC-A codes as EAN-13.
+// @Tags lookup
+// @Accept json
+// @Produce json
+// @Param id path integer true "ID of the film"
+// @Param code body model.ProductCodeRequest true "Product code"
+// @Success 201 {object} model.ProductCode "New product code"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Film not found"
+// @Failure 409 {string} string "Product code is already assigned"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /films/{id}/codes [post]
+func (h *LookupHandler) AddProductCode(w http.ResponseWriter, r *http.Request) {
+	filmId, ok := h.filmId(w, r)
+	if !ok {
+		return
+	}
+
+	var req model.ProductCodeRequest
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Corrupted request body", h.logger)
+		return
+	}
+
+	v := validator.New()
+	if err := v.Struct(req); err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Invalid request", h.logger)
+		return
+	}
+	if _, ok := barcode.EAN13(barcode.Normalize(req.Code)); !ok {
+		response.ErrorResponse(w, http.StatusBadRequest, model.LookupInvalidChecksum, h.logger)
+		return
+	}
+
+	pc, err := h.lookupUsecase.AddProductCode(r.Context(), filmId, req)
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusCreated, pc)
+}
+
+// DeleteProductCode handles the HTTP DELETE request to remove a product code from a film.
+// @Summary Delete film product code
+// @Description Removes a product code from a film.
+// @Tags lookup
+// @Produce json
+// @Param id path integer true "ID of the film"
+// @Param code path string true "Product code"
+// @Success 200 {string} string "Product code deleted"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Product code not found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /films/{id}/codes/{code} [delete]
+func (h *LookupHandler) DeleteProductCode(w http.ResponseWriter, r *http.Request) {
+	filmId, ok := h.filmId(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.lookupUsecase.DeleteProductCode(r.Context(), filmId, r.PathValue("code")); err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusOK, response.NIL())
+}
+
+// filmId reads the film ID from the path.
+func (h *LookupHandler) filmId(w http.ResponseWriter, r *http.Request) (uint64, bool) {
+	id, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, response.InvalidURLParameter, h.logger)
+		return 0, false
+	}
+	return id, true
+}
+
+// usecaseError maps errors returned by the lookup usecase onto HTTP responses.
+func (h *LookupHandler) usecaseError(w http.ResponseWriter, err error) {
+	var notFound *model.ErrNotFound
+	var conflict *model.ErrConflict
+	var badRequest *model.ErrBadRequest
+
+	switch {
+	case errors.As(err, &notFound):
+		response.ErrorResponse(w, http.StatusNotFound, notFound.Error(), h.logger)
+	case errors.As(err, &conflict):
+		response.ErrorResponse(w, http.StatusConflict, conflict.Error(), h.logger)
+	case errors.As(err, &badRequest):
+		response.ErrorResponse(w, http.StatusBadRequest, badRequest.Error(), h.logger)
+	default:
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusInternalServerError, "Internal server error", h.logger)
+	}
+}
@@ -0,0 +1,32 @@
+package lookup
+
+import (
+	"context"
+
+	"films_library/internal/model"
+)
+
+type (
+	// Usecase resolves the codes scanned at the desk to copies and films.
+	Usecase interface {
+		// Lookup resolves codes in order. Codes matching nothing carry an
+		// error in their result.
+		Lookup(ctx context.Context, codes []string) ([]model.LookupResult, error)
+
+		GetProductCodes(ctx context.Context, filmID uint64) ([]model.ProductCode, error)
+		AddProductCode(ctx context.Context, filmID uint64, req model.ProductCodeRequest) (model.ProductCode, error)
+		DeleteProductCode(ctx context.Context, filmID uint64, code string) error
+	}
+
+	Repository interface {
+		// FindCopies returns the copies with the given barcodes by barcode,
+		// each with its circulation and due date.
+		FindCopies(ctx context.Context, barcodes []string) (map[string]model.LookupResult, error)
+		// FindProducts returns the films of the given EAN-13 codes by code.
+		FindProducts(ctx context.Context, codes []string) (map[string]uint64, error)
+
+		GetProductCodes(ctx context.Context, filmID uint64) ([]model.ProductCode, error)
+		AddProductCode(ctx context.Context, filmID uint64, code model.ProductCode) (model.ProductCode, error)
+		DeleteProductCode(ctx context.Context, filmID uint64, code string) error
+	}
+)
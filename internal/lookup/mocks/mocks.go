@@ -0,0 +1,192 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/lookup/lookup.go
+
+// Package mock_lookup is a generated GoMock package.
+package mock_lookup
+
+import (
+	context "context"
+	model "films_library/internal/model"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUsecase is a mock of Usecase interface.
+type MockUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseMockRecorder
+}
+
+// MockUsecaseMockRecorder is the mock recorder for MockUsecase.
+type MockUsecaseMockRecorder struct {
+	mock *MockUsecase
+}
+
+// NewMockUsecase creates a new mock instance.
+func NewMockUsecase(ctrl *gomock.Controller) *MockUsecase {
+	mock := &MockUsecase{ctrl: ctrl}
+	mock.recorder = &MockUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
+	return m.recorder
+}
+
+// AddProductCode mocks base method.
+func (m *MockUsecase) AddProductCode(ctx context.Context, filmID uint64, req model.ProductCodeRequest) (model.ProductCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddProductCode", ctx, filmID, req)
+	ret0, _ := ret[0].(model.ProductCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddProductCode indicates an expected call of AddProductCode.
+func (mr *MockUsecaseMockRecorder) AddProductCode(ctx, filmID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProductCode", reflect.TypeOf((*MockUsecase)(nil).AddProductCode), ctx, filmID, req)
+}
+
+// DeleteProductCode mocks base method.
+func (m *MockUsecase) DeleteProductCode(ctx context.Context, filmID uint64, code string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProductCode", ctx, filmID, code)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProductCode indicates an expected call of DeleteProductCode.
+func (mr *MockUsecaseMockRecorder) DeleteProductCode(ctx, filmID, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProductCode", reflect.TypeOf((*MockUsecase)(nil).DeleteProductCode), ctx, filmID, code)
+}
+
+// GetProductCodes mocks base method.
+func (m *MockUsecase) GetProductCodes(ctx context.Context, filmID uint64) ([]model.ProductCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductCodes", ctx, filmID)
+	ret0, _ := ret[0].([]model.ProductCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductCodes indicates an expected call of GetProductCodes.
+func (mr *MockUsecaseMockRecorder) GetProductCodes(ctx, filmID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductCodes", reflect.TypeOf((*MockUsecase)(nil).GetProductCodes), ctx, filmID)
+}
+
+// Lookup mocks base method.
+func (m *MockUsecase) Lookup(ctx context.Context, codes []string) ([]model.LookupResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lookup", ctx, codes)
+	ret0, _ := ret[0].([]model.LookupResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Lookup indicates an expected call of Lookup.
+func (mr *MockUsecaseMockRecorder) Lookup(ctx, codes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lookup", reflect.TypeOf((*MockUsecase)(nil).Lookup), ctx, codes)
+}
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddProductCode mocks base method.
+func (m *MockRepository) AddProductCode(ctx context.Context, filmID uint64, code model.ProductCode) (model.ProductCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddProductCode", ctx, filmID, code)
+	ret0, _ := ret[0].(model.ProductCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddProductCode indicates an expected call of AddProductCode.
+func (mr *MockRepositoryMockRecorder) AddProductCode(ctx, filmID, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProductCode", reflect.TypeOf((*MockRepository)(nil).AddProductCode), ctx, filmID, code)
+}
+
+// DeleteProductCode mocks base method.
+func (m *MockRepository) DeleteProductCode(ctx context.Context, filmID uint64, code string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProductCode", ctx, filmID, code)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProductCode indicates an expected call of DeleteProductCode.
+func (mr *MockRepositoryMockRecorder) DeleteProductCode(ctx, filmID, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProductCode", reflect.TypeOf((*MockRepository)(nil).DeleteProductCode), ctx, filmID, code)
+}
+
+// FindCopies mocks base method.
+func (m *MockRepository) FindCopies(ctx context.Context, barcodes []string) (map[string]model.LookupResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindCopies", ctx, barcodes)
+	ret0, _ := ret[0].(map[string]model.LookupResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindCopies indicates an expected call of FindCopies.
+func (mr *MockRepositoryMockRecorder) FindCopies(ctx, barcodes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindCopies", reflect.TypeOf((*MockRepository)(nil).FindCopies), ctx, barcodes)
+}
+
+// FindProducts mocks base method.
+func (m *MockRepository) FindProducts(ctx context.Context, codes []string) (map[string]uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindProducts", ctx, codes)
+	ret0, _ := ret[0].(map[string]uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindProducts indicates an expected call of FindProducts.
+func (mr *MockRepositoryMockRecorder) FindProducts(ctx, codes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindProducts", reflect.TypeOf((*MockRepository)(nil).FindProducts), ctx, codes)
+}
+
+// GetProductCodes mocks base method.
+func (m *MockRepository) GetProductCodes(ctx context.Context, filmID uint64) ([]model.ProductCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductCodes", ctx, filmID)
+	ret0, _ := ret[0].([]model.ProductCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductCodes indicates an expected call of GetProductCodes.
+func (mr *MockRepositoryMockRecorder) GetProductCodes(ctx, filmID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductCodes", reflect.TypeOf((*MockRepository)(nil).GetProductCodes), ctx, filmID)
+}
@@ -0,0 +1,149 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+
+	"films_library/internal/model"
+	"films_library/pkg/postgres"
+
+	"github.com/jackc/pgconn"
+)
+
+const (
+	foreignKeyViolation = "23503"
+	uniqueViolation     = "23505"
+)
+
+type Repository struct {
+	db postgres.DBConn
+}
+
+func NewRepository(db postgres.DBConn) *Repository {
+	return &Repository{db}
+}
+
+// FindCopies reads copies out of circulation without a circulation state.
+func (r *Repository) FindCopies(ctx context.Context, barcodes []string) (map[string]model.LookupResult, error) {
+	sqlQuery := `
+        SELECT c.copy_id, c.film_id, c.format, c.barcode, c."condition",
+            COALESCE(to_char(c.acquired_on, 'YYYY-MM-DD'), ''), c.shelf, c.status, c.created_at, c.updated_at,
+            CASE
+                WHEN c.status <> 'active' THEN ''
+                WHEN l.loan_id IS NOT NULL THEN 'on_loan'
+                WHEN h.hold_id IS NOT NULL THEN 'on_hold'
+                ELSE 'available'
+            END,
+            COALESCE(to_char(l.due_on, 'YYYY-MM-DD'), '')
+        FROM copy c
+        LEFT JOIN loan l ON l.copy_id = c.copy_id AND l.returned_at IS NULL
+        LEFT JOIN hold h ON h.copy_id = c.copy_id AND h.status = 'ready'
+        WHERE c.barcode = ANY($1::text[])`
+
+	rows, err := r.db.Query(ctx, sqlQuery, barcodes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	copies := make(map[string]model.LookupResult)
+	for rows.Next() {
+		var c model.Copy
+		result := model.LookupResult{Match: model.MatchCopy, Copy: &c}
+		err := rows.Scan(
+			&c.ID,
+			&c.FilmID,
+			&c.Format,
+			&c.Barcode,
+			&c.Condition,
+			&c.AcquiredOn,
+			&c.Shelf,
+			&c.Status,
+			&c.CreatedAt,
+			&c.UpdatedAt,
+			&result.Circulation,
+			&result.DueOn,
+		)
+		if err != nil {
+			return nil, err
+		}
+		result.Code = c.Barcode
+		copies[c.Barcode] = result
+	}
+	return copies, rows.Err()
+}
+
+func (r *Repository) FindProducts(ctx context.Context, codes []string) (map[string]uint64, error) {
+	rows, err := r.db.Query(ctx, `SELECT code, film_id FROM product_code WHERE code = ANY($1::text[])`, codes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	films := make(map[string]uint64)
+	for rows.Next() {
+		var code string
+		var filmID uint64
+		if err := rows.Scan(&code, &filmID); err != nil {
+			return nil, err
+		}
+		films[code] = filmID
+	}
+	return films, rows.Err()
+}
+
+func (r *Repository) GetProductCodes(ctx context.Context, filmID uint64) ([]model.ProductCode, error) {
+	sqlQuery := `SELECT code, film_id, COALESCE(format, ''), created_at FROM product_code WHERE film_id=$1 ORDER BY code`
+
+	rows, err := r.db.Query(ctx, sqlQuery, filmID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	codes := []model.ProductCode{}
+	for rows.Next() {
+		var pc model.ProductCode
+		if err := rows.Scan(&pc.Code, &pc.FilmID, &pc.Format, &pc.CreatedAt); err != nil {
+			return nil, err
+		}
+		codes = append(codes, pc)
+	}
+	return codes, rows.Err()
+}
+
+func (r *Repository) AddProductCode(ctx context.Context, filmID uint64, code model.ProductCode) (model.ProductCode, error) {
+	sqlQuery := `INSERT INTO product_code (code, film_id, format) VALUES ($1, $2, NULLIF($3, '')) RETURNING created_at`
+
+	err := r.db.QueryRow(ctx, sqlQuery, code.Code, filmID, code.Format).Scan(&code.CreatedAt)
+	if err != nil {
+		return model.ProductCode{}, productCodeError(err)
+	}
+	return code, nil
+}
+
+func (r *Repository) DeleteProductCode(ctx context.Context, filmID uint64, code string) error {
+	res, err := r.db.Exec(ctx, `DELETE FROM product_code WHERE film_id=$1 AND code=$2`, filmID, code)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return &model.ErrNotFound{Message: "product code not found"}
+	}
+	return nil
+}
+
+func productCodeError(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+
+	switch pgErr.Code {
+	case foreignKeyViolation:
+		return &model.ErrNotFound{Message: "film not found"}
+	case uniqueViolation:
+		return &model.ErrConflict{Message: "product code is already assigned"}
+	}
+	return err
+}
@@ -0,0 +1,125 @@
+package usecase
+
+import (
+	"context"
+
+	"films_library/internal/film"
+	"films_library/internal/lookup"
+	"films_library/internal/model"
+	"films_library/pkg/barcode"
+	"films_library/pkg/logger"
+)
+
+type LookupUsecase struct {
+	lookupRepo  lookup.Repository
+	FilmUsecase film.Usecase
+	logger      logger.Interface
+}
+
+func NewLookupUsecase(lr lookup.Repository, fu film.Usecase, l logger.Interface) *LookupUsecase {
+	return &LookupUsecase{lr, fu, l}
+}
+
+// Lookup matches codes against copy barcodes first, so library labels that
+// happen to look like product codes still resolve to their copies. Other
+// EAN-13 and UPC-A codes must carry a valid check digit to match a film.
+func (lu *LookupUsecase) Lookup(ctx context.Context, codes []string) ([]model.LookupResult, error) {
+	results := make([]model.LookupResult, len(codes))
+	barcodes := make([]string, len(codes))
+	for i, code := range codes {
+		barcodes[i] = barcode.Normalize(code)
+	}
+
+	copies, err := lu.lookupRepo.FindCopies(ctx, barcodes)
+	if err != nil {
+		return []model.LookupResult{}, err
+	}
+
+	products := make([]string, len(codes))
+	var productCodes []string
+	for i, code := range barcodes {
+		if c, ok := copies[code]; ok {
+			results[i] = c
+			continue
+		}
+
+		results[i].Code = code
+		ean, ok := barcode.EAN13(code)
+		switch {
+		case ok:
+			products[i] = ean
+			productCodes = append(productCodes, ean)
+		case barcode.IsGTIN(code):
+			results[i].Error = model.LookupInvalidChecksum
+		default:
+			results[i].Error = model.LookupNotFound
+		}
+	}
+
+	filmIDs := make([]uint64, len(codes))
+	if len(productCodes) > 0 {
+		films, err := lu.lookupRepo.FindProducts(ctx, productCodes)
+		if err != nil {
+			return []model.LookupResult{}, err
+		}
+		for i, ean := range products {
+			if ean == "" {
+				continue
+			}
+			if id, ok := films[ean]; ok {
+				results[i].Match, filmIDs[i] = model.MatchProduct, id
+			} else {
+				results[i].Error = model.LookupNotFound
+			}
+		}
+	}
+
+	var ids []uint64
+	for i := range results {
+		if results[i].Copy != nil {
+			filmIDs[i] = results[i].Copy.FilmID
+		}
+		if filmIDs[i] != 0 {
+			ids = append(ids, filmIDs[i])
+		}
+	}
+	if len(ids) == 0 {
+		return results, nil
+	}
+
+	films, _, err := lu.FilmUsecase.GetFilms(ctx, model.FilmFilter{IDs: ids})
+	if err != nil {
+		return []model.LookupResult{}, err
+	}
+	byID := make(map[uint64]*model.Film, len(films))
+	for i := range films {
+		byID[films[i].ID] = &films[i]
+	}
+	for i, id := range filmIDs {
+		if id != 0 {
+			results[i].Film = byID[id]
+		}
+	}
+	return results, nil
+}
+
+func (lu *LookupUsecase) GetProductCodes(ctx context.Context, filmID uint64) ([]model.ProductCode, error) {
+	return lu.lookupRepo.GetProductCodes(ctx, filmID)
+}
+
+// AddProductCode stores a valid EAN-13 or UPC-A code on a film as EAN-13.
+func (lu *LookupUsecase) AddProductCode(ctx context.Context, filmID uint64, req model.ProductCodeRequest) (model.ProductCode, error) {
+	ean, ok := barcode.EAN13(barcode.Normalize(req.Code))
+	if !ok {
+		return model.ProductCode{}, &model.ErrBadRequest{Message: model.LookupInvalidChecksum}
+	}
+	return lu.lookupRepo.AddProductCode(ctx, filmID, model.ProductCode{Code: ean, FilmID: filmID, Format: req.Format})
+}
+
+func (lu *LookupUsecase) DeleteProductCode(ctx context.Context, filmID uint64, code string) error {
+	ean, ok := barcode.EAN13(barcode.Normalize(code))
+	if !ok {
+		return &model.ErrNotFound{Message: "product code not found"}
+	}
+	return lu.lookupRepo.DeleteProductCode(ctx, filmID, ean)
+}
@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	mock_film "films_library/internal/film/mocks"
+	mock_lookup "films_library/internal/lookup/mocks"
+	"films_library/internal/model"
+	"films_library/pkg/logger"
+	"films_library/pkg/pagination"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookupUsecase_Lookup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	loggerMock := logger.NewMockInterface(ctrl)
+	lookupRepo := mock_lookup.NewMockRepository(ctrl)
+	filmUsecase := mock_film.NewMockUsecase(ctrl)
+	usecase := NewLookupUsecase(lookupRepo, filmUsecase, loggerMock)
+
+	ctx := context.Background()
+	onLoan := model.Copy{ID: 4, FilmID: 1, Barcode: "LIB000123", Status: model.CopyActive}
+	matrix := model.Film{ID: 1, Title: "The Matrix"}
+	heat := model.Film{ID: 2, Title: "Heat"}
+
+	codes := []string{"LIB000123", "0-85391-16372-5", "5051892002166", "5051892002165", "UNKNOWN"}
+	barcodes := []string{"LIB000123", "085391163725", "5051892002166", "5051892002165", "UNKNOWN"}
+
+	lookupRepo.EXPECT().FindCopies(ctx, barcodes).Return(map[string]model.LookupResult{
+		"LIB000123": {Code: "LIB000123", Match: model.MatchCopy, Copy: &onLoan, Circulation: model.CirculationOnLoan, DueOn: "2024-04-01"},
+	}, nil)
+	lookupRepo.EXPECT().FindProducts(ctx, []string{"0085391163725", "5051892002165"}).
+		Return(map[string]uint64{"0085391163725": 2}, nil)
+	filmUsecase.EXPECT().GetFilms(ctx, model.FilmFilter{IDs: []uint64{1, 2}}).
+		Return([]model.Film{matrix, heat}, pagination.Page{}, nil)
+
+	results, err := usecase.Lookup(ctx, codes)
+	assert.NoError(t, err)
+	assert.Equal(t, []model.LookupResult{
+		{Code: "LIB000123", Match: model.MatchCopy, Film: &matrix, Copy: &onLoan, Circulation: model.CirculationOnLoan, DueOn: "2024-04-01"},
+		{Code: "085391163725", Match: model.MatchProduct, Film: &heat},
+		{Code: "5051892002166", Error: model.LookupInvalidChecksum},
+		{Code: "5051892002165", Error: model.LookupNotFound},
+		{Code: "UNKNOWN", Error: model.LookupNotFound},
+	}, results)
+}
+
+func TestLookupUsecase_AddProductCode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	lookupRepo := mock_lookup.NewMockRepository(ctrl)
+	usecase := NewLookupUsecase(lookupRepo, mock_film.NewMockUsecase(ctrl), logger.NewMockInterface(ctrl))
+
+	ctx := context.Background()
+	lookupRepo.EXPECT().AddProductCode(ctx, uint64(1), model.ProductCode{Code: "0085391163725", FilmID: 1}).
+		Return(model.ProductCode{Code: "0085391163725", FilmID: 1}, nil)
+
+	code, err := usecase.AddProductCode(ctx, 1, model.ProductCodeRequest{Code: "0-85391-16372-5"})
+	assert.NoError(t, err)
+	assert.Equal(t, "0085391163725", code.Code)
+
+	_, err = usecase.AddProductCode(ctx, 1, model.ProductCodeRequest{Code: "5051892002166"})
+	var badRequest *model.ErrBadRequest
+	assert.ErrorAs(t, err, &badRequest)
+}
@@ -0,0 +1,303 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"films_library/internal/auth"
+	"films_library/internal/media"
+	"films_library/internal/model"
+	"films_library/pkg/httpserver"
+	"films_library/pkg/logger"
+	"films_library/pkg/response"
+)
+
+// imageField is the multipart form field images are uploaded in.
+const imageField = "image"
+
+// formOverhead is the room left in upload bodies for the multipart framing
+// and other form fields.
+const formOverhead = 64 << 10
+
+type MediaHandler struct {
+	mediaUsecase media.Usecase
+	maxSize      int64
+	logger       logger.Interface
+}
+
+// MediaPermissions is the permission each image route requires.
+var MediaPermissions = auth.Permissions{
+	"GET /films/{id}/poster":    auth.FilmRead,
+	"PUT /films/{id}/poster":    auth.FilmWrite,
+	"DELETE /films/{id}/poster": auth.FilmWrite,
+
+	"GET /actors/{id}/headshot":    auth.ActorRead,
+	"PUT /actors/{id}/headshot":    auth.ActorWrite,
+	"DELETE /actors/{id}/headshot": auth.ActorWrite,
+}
+
+// NewMediaHandler registers the image routes. Uploads over maxSize bytes are
+// rejected.
+func NewMediaHandler(mux *http.ServeMux, mu media.Usecase, maxSize int64, l logger.Interface) {
+	r := &MediaHandler{mu, maxSize, l}
+
+	mux.HandleFunc("GET /films/{id}/poster", r.GetFilmPoster)
+	mux.HandleFunc("PUT /films/{id}/poster", r.PutFilmPoster)
+	mux.HandleFunc("DELETE /films/{id}/poster", r.DeleteFilmPoster)
+
+	mux.HandleFunc("GET /actors/{id}/headshot", r.GetActorHeadshot)
+	mux.HandleFunc("PUT /actors/{id}/headshot", r.PutActorHeadshot)
+	mux.HandleFunc("DELETE /actors/{id}/headshot", r.DeleteActorHeadshot)
+}
+
+// GetFilmPoster handles the HTTP GET request to retrieve the poster of a film.
+// @Summary Get film poster
+// @Description Serves the poster of a film or one of its thumbnails, with an ETag. The URLs in film responses carry the ETag as v and may be cached for good.
+// @Tags media
+// @Produce jpeg,png,gif
+// @Param id path integer true "ID of the film"
+// @Param size query string false "original (the default), w92, w185 or w500"
+// @Param v query string false "ETag of the poster"
+// @Success 200 {file} file "Poster"
+// @Success 304 {string} string "Not Modified"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Poster not found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /films/{id}/poster [get]
+func (h *MediaHandler) GetFilmPoster(w http.ResponseWriter, r *http.Request) {
+	h.getImage(w, r, model.EntityFilm)
+}
+
+// PutFilmPoster handles the HTTP PUT request to upload the poster of a film.
+// @Summary Upload film poster
+// @Description Uploads a JPEG, PNG or GIF poster, replacing any the film had, and makes thumbnails 92, 185 and 500 pixels wide.
+// @Tags media
+// @Accept mpfd
+// @Produce json
+// @Param id path integer true "ID of the film"
+// @Param image formData file true "Poster"
+// @Success 200 {object} model.Image "Poster"
+// @Failure 400 {string} string "Missing, unsupported or corrupted image"
+// @Failure 404 {string} string "Film not found"
+// @Failure 413 {string} string "Image too large"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /films/{id}/poster [put]
+func (h *MediaHandler) PutFilmPoster(w http.ResponseWriter, r *http.Request) {
+	h.putImage(w, r, model.EntityFilm)
+}
+
+// DeleteFilmPoster handles the HTTP DELETE request to remove the poster of a film.
+// @Summary Delete film poster
+// @Description Removes the poster of a film and its thumbnails.
+// @Tags media
+// @Produce json
+// @Param id path integer true "ID of the film"
+// @Success 200 {string} string "Poster deleted"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Poster not found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /films/{id}/poster [delete]
+func (h *MediaHandler) DeleteFilmPoster(w http.ResponseWriter, r *http.Request) {
+	h.deleteImage(w, r, model.EntityFilm)
+}
+
+// GetActorHeadshot handles the HTTP GET request to retrieve the headshot of an actor.
+// @Summary Get actor headshot
+// @Description Serves the headshot of an actor or one of its thumbnails, with an ETag. The URLs in actor responses carry the ETag as v and may be cached for good.
+// @Tags media
+// @Produce jpeg,png,gif
+// @Param id path integer true "ID of the actor"
+// @Param size query string false "original (the default), w92, w185 or w500"
+// @Param v query string false "ETag of the headshot"
+// @Success 200 {file} file "Headshot"
+// @Success 304 {string} string "Not Modified"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Headshot not found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /actors/{id}/headshot [get]
+func (h *MediaHandler) GetActorHeadshot(w http.ResponseWriter, r *http.Request) {
+	h.getImage(w, r, model.EntityActor)
+}
+
+// PutActorHeadshot handles the HTTP PUT request to upload the headshot of an actor.
+// @Summary Upload actor headshot
+// @Description Uploads a JPEG, PNG or GIF headshot, replacing any the actor had, and makes thumbnails 92, 185 and 500 pixels wide.
+// @Tags media
+// @Accept mpfd
+// @Produce json
+// @Param id path integer true "ID of the actor"
+// @Param image formData file true "Headshot"
+// @Success 200 {object} model.Image "Headshot"
+// @Failure 400 {string} string "Missing, unsupported or corrupted image"
+// @Failure 404 {string} string "Actor not found"
+// @Failure 413 {string} string "Image too large"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /actors/{id}/headshot [put]
+func (h *MediaHandler) PutActorHeadshot(w http.ResponseWriter, r *http.Request) {
+	h.putImage(w, r, model.EntityActor)
+}
+
+// DeleteActorHeadshot handles the HTTP DELETE request to remove the headshot of an actor.
+// @Summary Delete actor headshot
+// @Description Removes the headshot of an actor and its thumbnails.
+// @Tags media
+// @Produce json
+// @Param id path integer true "ID of the actor"
+// @Success 200 {string} string "Headshot deleted"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Headshot not found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /actors/{id}/headshot [delete]
+func (h *MediaHandler) DeleteActorHeadshot(w http.ResponseWriter, r *http.Request) {
+	h.deleteImage(w, r, model.EntityActor)
+}
+
+func (h *MediaHandler) getImage(w http.ResponseWriter, r *http.Request, entity string) {
+	id, ok := h.id(w, r)
+	if !ok {
+		return
+	}
+
+	size := r.URL.Query().Get("size")
+	if size == "" {
+		size = model.ImageOriginal
+	}
+
+	file, err := h.mediaUsecase.OpenImage(r.Context(), entity, id, size)
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+	defer file.Body.Close()
+
+	// The image is behind a session, so only the browser may cache it. URLs
+	// carrying its ETag never change content.
+	etag := fmt.Sprintf(`"%s-%s"`, file.ETag, size)
+	w.Header().Set("ETag", etag)
+	if r.URL.Query().Get("v") == file.ETag {
+		w.Header().Set("Cache-Control", "private, max-age=31536000, immutable")
+	} else {
+		w.Header().Set("Cache-Control", "private, no-cache")
+	}
+
+	if etagMatch(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", file.ContentType)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+	if _, err := io.Copy(w, file.Body); err != nil {
+		h.logger.Error(err)
+	}
+}
+
+func (h *MediaHandler) putImage(w http.ResponseWriter, r *http.Request, entity string) {
+	id, ok := h.id(w, r)
+	if !ok {
+		return
+	}
+
+	// Images take longer to upload and resize than the server timeouts allow.
+	if err := httpserver.LiftDeadlines(w); err != nil {
+		h.logger.Error(err)
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, h.maxSize+formOverhead)
+	form, err := r.MultipartReader()
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Corrupted request body", h.logger)
+		return
+	}
+
+	for {
+		part, err := form.NextPart()
+		if errors.Is(err, io.EOF) {
+			response.ErrorResponse(w, http.StatusBadRequest, "Missing "+imageField+" field", h.logger)
+			return
+		}
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			h.usecaseError(w, err)
+			return
+		}
+		if err != nil {
+			h.logger.Error(err)
+			response.ErrorResponse(w, http.StatusBadRequest, "Corrupted request body", h.logger)
+			return
+		}
+		if part.FormName() != imageField {
+			continue
+		}
+
+		image, err := h.mediaUsecase.PutImage(r.Context(), entity, id, http.MaxBytesReader(w, part, h.maxSize))
+		if err != nil {
+			h.usecaseError(w, err)
+			return
+		}
+
+		response.SuccessResponse(w, http.StatusOK, image)
+		return
+	}
+}
+
+func (h *MediaHandler) deleteImage(w http.ResponseWriter, r *http.Request, entity string) {
+	id, ok := h.id(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.mediaUsecase.DeleteImage(r.Context(), entity, id); err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusOK, response.NIL())
+}
+
+// id reads the film or actor ID from the path.
+func (h *MediaHandler) id(w http.ResponseWriter, r *http.Request) (uint64, bool) {
+	id, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, response.InvalidURLParameter, h.logger)
+		return 0, false
+	}
+	return id, true
+}
+
+// etagMatch reports whether an If-None-Match header lists etag.
+func etagMatch(header, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// usecaseError maps errors returned by the media usecase onto HTTP responses.
+func (h *MediaHandler) usecaseError(w http.ResponseWriter, err error) {
+	var notFound *model.ErrNotFound
+	var badRequest *model.ErrBadRequest
+	var tooLarge *http.MaxBytesError
+
+	switch {
+	case errors.As(err, &notFound):
+		response.ErrorResponse(w, http.StatusNotFound, notFound.Error(), h.logger)
+	case errors.As(err, &badRequest):
+		response.ErrorResponse(w, http.StatusBadRequest, badRequest.Error(), h.logger)
+	case errors.As(err, &tooLarge):
+		response.ErrorResponse(w, http.StatusRequestEntityTooLarge, fmt.Sprintf("Image larger than %d bytes", h.maxSize), h.logger)
+	default:
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusInternalServerError, "Internal server error", h.logger)
+	}
+}
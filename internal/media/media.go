@@ -0,0 +1,31 @@
+package media
+
+import (
+	"context"
+	"io"
+
+	"films_library/internal/model"
+)
+
+type (
+	// Usecase keeps film posters and actor headshots together with their
+	// thumbnails. Entities are model.EntityFilm or model.EntityActor.
+	Usecase interface {
+		// PutImage stores the JPEG, PNG or GIF image read from r and its
+		// thumbnails, replacing any image the entity had.
+		PutImage(ctx context.Context, entity string, id uint64, r io.Reader) (model.Image, error)
+		DeleteImage(ctx context.Context, entity string, id uint64) error
+		// OpenImage returns one size of the image of an entity. The caller
+		// closes its body.
+		OpenImage(ctx context.Context, entity string, id uint64, size string) (model.ImageFile, error)
+	}
+
+	Repository interface {
+		GetImage(ctx context.Context, entity string, id uint64) (model.Image, error)
+		// SetImage records the image of an entity and returns the ETag of the
+		// image it replaces, if any.
+		SetImage(ctx context.Context, entity string, id uint64, image model.Image) (string, error)
+		// DeleteImage removes the image of an entity and returns its ETag.
+		DeleteImage(ctx context.Context, entity string, id uint64) (string, error)
+	}
+)
@@ -0,0 +1,149 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/media/media.go
+
+// Package mock_media is a generated GoMock package.
+package mock_media
+
+import (
+	context "context"
+	model "films_library/internal/model"
+	io "io"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUsecase is a mock of Usecase interface.
+type MockUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseMockRecorder
+}
+
+// MockUsecaseMockRecorder is the mock recorder for MockUsecase.
+type MockUsecaseMockRecorder struct {
+	mock *MockUsecase
+}
+
+// NewMockUsecase creates a new mock instance.
+func NewMockUsecase(ctrl *gomock.Controller) *MockUsecase {
+	mock := &MockUsecase{ctrl: ctrl}
+	mock.recorder = &MockUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
+	return m.recorder
+}
+
+// DeleteImage mocks base method.
+func (m *MockUsecase) DeleteImage(ctx context.Context, entity string, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteImage", ctx, entity, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteImage indicates an expected call of DeleteImage.
+func (mr *MockUsecaseMockRecorder) DeleteImage(ctx, entity, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImage", reflect.TypeOf((*MockUsecase)(nil).DeleteImage), ctx, entity, id)
+}
+
+// OpenImage mocks base method.
+func (m *MockUsecase) OpenImage(ctx context.Context, entity string, id uint64, size string) (model.ImageFile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenImage", ctx, entity, id, size)
+	ret0, _ := ret[0].(model.ImageFile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OpenImage indicates an expected call of OpenImage.
+func (mr *MockUsecaseMockRecorder) OpenImage(ctx, entity, id, size interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenImage", reflect.TypeOf((*MockUsecase)(nil).OpenImage), ctx, entity, id, size)
+}
+
+// PutImage mocks base method.
+func (m *MockUsecase) PutImage(ctx context.Context, entity string, id uint64, r io.Reader) (model.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutImage", ctx, entity, id, r)
+	ret0, _ := ret[0].(model.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutImage indicates an expected call of PutImage.
+func (mr *MockUsecaseMockRecorder) PutImage(ctx, entity, id, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutImage", reflect.TypeOf((*MockUsecase)(nil).PutImage), ctx, entity, id, r)
+}
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteImage mocks base method.
+func (m *MockRepository) DeleteImage(ctx context.Context, entity string, id uint64) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteImage", ctx, entity, id)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteImage indicates an expected call of DeleteImage.
+func (mr *MockRepositoryMockRecorder) DeleteImage(ctx, entity, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImage", reflect.TypeOf((*MockRepository)(nil).DeleteImage), ctx, entity, id)
+}
+
+// GetImage mocks base method.
+func (m *MockRepository) GetImage(ctx context.Context, entity string, id uint64) (model.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImage", ctx, entity, id)
+	ret0, _ := ret[0].(model.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImage indicates an expected call of GetImage.
+func (mr *MockRepositoryMockRecorder) GetImage(ctx, entity, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImage", reflect.TypeOf((*MockRepository)(nil).GetImage), ctx, entity, id)
+}
+
+// SetImage mocks base method.
+func (m *MockRepository) SetImage(ctx context.Context, entity string, id uint64, image model.Image) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetImage", ctx, entity, id, image)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetImage indicates an expected call of SetImage.
+func (mr *MockRepositoryMockRecorder) SetImage(ctx, entity, id, image interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetImage", reflect.TypeOf((*MockRepository)(nil).SetImage), ctx, entity, id, image)
+}
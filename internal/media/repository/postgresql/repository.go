@@ -0,0 +1,116 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"films_library/internal/model"
+	"films_library/pkg/postgres"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+)
+
+const foreignKeyViolation = "23503"
+
+// table is where the image of an entity is recorded.
+type table struct {
+	name   string
+	column string
+	image  string
+}
+
+var tables = map[string]table{
+	model.EntityFilm:  {"film_poster", "film_id", "poster"},
+	model.EntityActor: {"actor_headshot", "actor_id", "headshot"},
+}
+
+type Repository struct {
+	db postgres.DBConn
+}
+
+func NewRepository(db postgres.DBConn) *Repository {
+	return &Repository{db}
+}
+
+func (r *Repository) GetImage(ctx context.Context, entity string, id uint64) (model.Image, error) {
+	t, err := tableOf(entity)
+	if err != nil {
+		return model.Image{}, err
+	}
+	sqlQuery := fmt.Sprintf(`SELECT content_type, width, height, size, etag, created_at FROM %s WHERE %s=$1`, t.name, t.column)
+
+	var image model.Image
+	err = r.db.QueryRow(ctx, sqlQuery, id).Scan(
+		&image.ContentType,
+		&image.Width,
+		&image.Height,
+		&image.Size,
+		&image.ETag,
+		&image.CreatedAt,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.Image{}, &model.ErrNotFound{Message: t.image + " not found"}
+	}
+	if err != nil {
+		return model.Image{}, err
+	}
+	image.URLs = model.NewImageURLs(entity, id, image.ETag)
+	return image, nil
+}
+
+func (r *Repository) SetImage(ctx context.Context, entity string, id uint64, image model.Image) (string, error) {
+	t, err := tableOf(entity)
+	if err != nil {
+		return "", err
+	}
+	sqlQuery := fmt.Sprintf(`
+        WITH previous AS (SELECT etag FROM %[1]s WHERE %[2]s=$1 FOR UPDATE)
+        INSERT INTO %[1]s (%[2]s, content_type, width, height, size, etag) VALUES ($1, $2, $3, $4, $5, $6)
+        ON CONFLICT (%[2]s) DO UPDATE SET
+            content_type = EXCLUDED.content_type,
+            width = EXCLUDED.width,
+            height = EXCLUDED.height,
+            size = EXCLUDED.size,
+            etag = EXCLUDED.etag,
+            created_at = now()
+        RETURNING (SELECT etag FROM previous)`, t.name, t.column)
+
+	var previous *string
+	err = r.db.QueryRow(ctx, sqlQuery, id, image.ContentType, image.Width, image.Height, image.Size, image.ETag).Scan(&previous)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation {
+			return "", &model.ErrNotFound{Message: entity + " not found"}
+		}
+		return "", err
+	}
+	if previous == nil {
+		return "", nil
+	}
+	return *previous, nil
+}
+
+func (r *Repository) DeleteImage(ctx context.Context, entity string, id uint64) (string, error) {
+	t, err := tableOf(entity)
+	if err != nil {
+		return "", err
+	}
+	sqlQuery := fmt.Sprintf(`DELETE FROM %s WHERE %s=$1 RETURNING etag`, t.name, t.column)
+
+	var etag string
+	err = r.db.QueryRow(ctx, sqlQuery, id).Scan(&etag)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", &model.ErrNotFound{Message: t.image + " not found"}
+	}
+	return etag, err
+}
+
+func tableOf(entity string) (table, error) {
+	t, ok := tables[entity]
+	if !ok {
+		return table{}, fmt.Errorf("unknown entity %q", entity)
+	}
+	return t, nil
+}
@@ -0,0 +1,200 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"net/http"
+
+	"films_library/internal/media"
+	"films_library/internal/model"
+	"films_library/pkg/blob"
+	"films_library/pkg/logger"
+	"films_library/pkg/thumbnail"
+)
+
+// maxPixels bounds the images decoded, which take four bytes a pixel however
+// small their files are.
+const maxPixels = 40_000_000
+
+// thumbnailQuality is the JPEG quality of thumbnails of JPEG images.
+const thumbnailQuality = 85
+
+// contentTypes are the image types accepted, as sniffed.
+var contentTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/gif":  true,
+}
+
+type MediaUsecase struct {
+	mediaRepo media.Repository
+	store     blob.Store
+	logger    logger.Interface
+}
+
+func NewMediaUsecase(mr media.Repository, store blob.Store, l logger.Interface) *MediaUsecase {
+	return &MediaUsecase{mr, store, l}
+}
+
+func (mu *MediaUsecase) PutImage(ctx context.Context, entity string, id uint64, r io.Reader) (model.Image, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return model.Image{}, err
+	}
+	if len(data) == 0 {
+		return model.Image{}, &model.ErrBadRequest{Message: "empty image"}
+	}
+
+	contentType := http.DetectContentType(data)
+	if !contentTypes[contentType] {
+		return model.Image{}, &model.ErrBadRequest{Message: "unsupported image type " + contentType}
+	}
+
+	sum := sha256.Sum256(data)
+	etag := hex.EncodeToString(sum[:8])
+
+	// Uploading the image again leaves it as it is. Its files are not put
+	// again, so that a failure cannot remove them.
+	current, err := mu.mediaRepo.GetImage(ctx, entity, id)
+	var notFound *model.ErrNotFound
+	if err != nil && !errors.As(err, &notFound) {
+		return model.Image{}, err
+	}
+	if err == nil && current.ETag == etag {
+		return current, nil
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return model.Image{}, &model.ErrBadRequest{Message: "corrupted image"}
+	}
+	if config.Width <= 0 || config.Height <= 0 || config.Width*config.Height > maxPixels {
+		return model.Image{}, &model.ErrBadRequest{Message: fmt.Sprintf("image of %dx%d pixels is too large", config.Width, config.Height)}
+	}
+
+	decoded, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return model.Image{}, &model.ErrBadRequest{Message: "corrupted image"}
+	}
+
+	img := model.Image{
+		ContentType: contentType,
+		Width:       config.Width,
+		Height:      config.Height,
+		Size:        int64(len(data)),
+		ETag:        etag,
+	}
+
+	if err := mu.putFiles(ctx, entity, id, img, data, decoded); err != nil {
+		mu.deleteFiles(ctx, entity, id, img.ETag)
+		return model.Image{}, err
+	}
+
+	previous, err := mu.mediaRepo.SetImage(ctx, entity, id, img)
+	if err != nil {
+		mu.deleteFiles(ctx, entity, id, img.ETag)
+		return model.Image{}, err
+	}
+	if previous != "" && previous != img.ETag {
+		mu.deleteFiles(ctx, entity, id, previous)
+	}
+
+	return mu.mediaRepo.GetImage(ctx, entity, id)
+}
+
+func (mu *MediaUsecase) DeleteImage(ctx context.Context, entity string, id uint64) error {
+	etag, err := mu.mediaRepo.DeleteImage(ctx, entity, id)
+	if err != nil {
+		return err
+	}
+	mu.deleteFiles(ctx, entity, id, etag)
+	return nil
+}
+
+func (mu *MediaUsecase) OpenImage(ctx context.Context, entity string, id uint64, size string) (model.ImageFile, error) {
+	if _, ok := model.ThumbnailWidths[size]; !ok && size != model.ImageOriginal {
+		return model.ImageFile{}, &model.ErrBadRequest{Message: "unknown image size " + size}
+	}
+
+	img, err := mu.mediaRepo.GetImage(ctx, entity, id)
+	if err != nil {
+		return model.ImageFile{}, err
+	}
+
+	body, err := mu.store.Open(ctx, key(entity, id, img.ETag, size))
+	if err != nil {
+		return model.ImageFile{}, fmt.Errorf("open %s %d image: %w", entity, id, err)
+	}
+
+	contentType := img.ContentType
+	if size != model.ImageOriginal {
+		contentType = thumbnailType(contentType)
+	}
+	return model.ImageFile{Body: body, ContentType: contentType, ETag: img.ETag}, nil
+}
+
+// putFiles stores the upload and its thumbnails.
+func (mu *MediaUsecase) putFiles(ctx context.Context, entity string, id uint64, img model.Image, data []byte, decoded image.Image) error {
+	if err := mu.store.Put(ctx, key(entity, id, img.ETag, model.ImageOriginal), bytes.NewReader(data)); err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	for size, width := range model.ThumbnailWidths {
+		buf.Reset()
+		if err := encode(&buf, thumbnail.Resize(decoded, width), img.ContentType); err != nil {
+			return err
+		}
+		if err := mu.store.Put(ctx, key(entity, id, img.ETag, size), &buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteFiles removes the files of an image. Files left behind are logged,
+// not returned, as the image is already gone or was never recorded.
+func (mu *MediaUsecase) deleteFiles(ctx context.Context, entity string, id uint64, etag string) {
+	sizes := []string{model.ImageOriginal}
+	for size := range model.ThumbnailWidths {
+		sizes = append(sizes, size)
+	}
+
+	var errs []error
+	for _, size := range sizes {
+		errs = append(errs, mu.store.Delete(ctx, key(entity, id, etag, size)))
+	}
+	if err := errors.Join(errs...); err != nil {
+		mu.logger.Error(fmt.Errorf("delete %s %d image %s: %w", entity, id, etag, err))
+	}
+}
+
+// key is where one size of an image is kept: films/7/9f86d081884c7d65/w185.
+func key(entity string, id uint64, etag, size string) string {
+	return fmt.Sprintf("%ss/%d/%s/%s", entity, id, etag, size)
+}
+
+// thumbnailType is the type of the thumbnails of an image: JPEG for JPEG
+// images and PNG for the others, which may be transparent.
+func thumbnailType(contentType string) string {
+	if contentType == "image/jpeg" {
+		return "image/jpeg"
+	}
+	return "image/png"
+}
+
+func encode(w io.Writer, img image.Image, contentType string) error {
+	if thumbnailType(contentType) == "image/jpeg" {
+		return jpeg.Encode(w, img, &jpeg.Options{Quality: thumbnailQuality})
+	}
+	return png.Encode(w, img)
+}
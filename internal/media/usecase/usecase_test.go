@@ -0,0 +1,170 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"io/fs"
+	"os"
+	"strings"
+	"testing"
+
+	mock_media "films_library/internal/media/mocks"
+	"films_library/internal/model"
+	"films_library/pkg/blob"
+	"films_library/pkg/logger"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func testPNG(t *testing.T, width, height int) []byte {
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, color.NRGBA{uint8(x), uint8(y), 0, 255})
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestMediaUsecase_PutImage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mediaRepo := mock_media.NewMockRepository(ctrl)
+	store, err := blob.NewLocal(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	usecase := NewMediaUsecase(mediaRepo, store, logger.NewMockInterface(ctrl))
+
+	ctx := context.Background()
+	if err := store.Put(ctx, "films/7/0123456789abcdef/w92", strings.NewReader("old")); err != nil {
+		t.Fatal(err)
+	}
+
+	var stored model.Image
+	gomock.InOrder(
+		mediaRepo.EXPECT().GetImage(ctx, model.EntityFilm, uint64(7)).
+			Return(model.Image{ETag: "0123456789abcdef"}, nil),
+		mediaRepo.EXPECT().SetImage(ctx, model.EntityFilm, uint64(7), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, _ uint64, img model.Image) (string, error) {
+				stored = img
+				return "0123456789abcdef", nil
+			}),
+		mediaRepo.EXPECT().GetImage(ctx, model.EntityFilm, uint64(7)).
+			DoAndReturn(func(context.Context, string, uint64) (model.Image, error) { return stored, nil }),
+	)
+
+	data := testPNG(t, 600, 300)
+	img, err := usecase.PutImage(ctx, model.EntityFilm, 7, bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "image/png", img.ContentType)
+	assert.Equal(t, 600, img.Width)
+	assert.Equal(t, 300, img.Height)
+	assert.Equal(t, int64(len(data)), img.Size)
+	assert.Len(t, img.ETag, 16)
+
+	_, err = store.Open(ctx, "films/7/0123456789abcdef/w92")
+	assert.ErrorIs(t, err, fs.ErrNotExist, "the files of the replaced image are deleted")
+
+	for size, width := range model.ThumbnailWidths {
+		mediaRepo.EXPECT().GetImage(ctx, model.EntityFilm, uint64(7)).Return(img, nil)
+
+		file, err := usecase.OpenImage(ctx, model.EntityFilm, 7, size)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, "image/png", file.ContentType)
+
+		thumb, err := png.Decode(file.Body)
+		file.Body.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, image.Rect(0, 0, width, (width+1)/2), thumb.Bounds(), size)
+	}
+
+	mediaRepo.EXPECT().GetImage(ctx, model.EntityFilm, uint64(7)).Return(img, nil)
+
+	file, err := usecase.OpenImage(ctx, model.EntityFilm, 7, model.ImageOriginal)
+	if err != nil {
+		t.Fatal(err)
+	}
+	original, _ := io.ReadAll(file.Body)
+	file.Body.Close()
+	assert.Equal(t, data, original)
+}
+
+func TestMediaUsecase_PutImageRejected(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mediaRepo := mock_media.NewMockRepository(ctrl)
+	dir := t.TempDir()
+	store, err := blob.NewLocal(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	usecase := NewMediaUsecase(mediaRepo, store, logger.NewMockInterface(ctrl))
+
+	ctx := context.Background()
+	cases := []struct {
+		name string
+		data []byte
+	}{
+		{"Empty", nil},
+		{"Text", []byte("not an image")},
+		{"Truncated PNG", testPNG(t, 10, 10)[:40]},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mediaRepo.EXPECT().GetImage(ctx, model.EntityActor, uint64(3)).
+				Return(model.Image{}, &model.ErrNotFound{Message: "headshot not found"}).AnyTimes()
+
+			_, err := usecase.PutImage(ctx, model.EntityActor, 3, bytes.NewReader(tc.data))
+			var badRequest *model.ErrBadRequest
+			assert.ErrorAs(t, err, &badRequest)
+		})
+	}
+
+	t.Run("Missing actor", func(t *testing.T) {
+		mediaRepo.EXPECT().SetImage(ctx, model.EntityActor, uint64(3), gomock.Any()).
+			Return("", &model.ErrNotFound{Message: "actor not found"})
+
+		_, err := usecase.PutImage(ctx, model.EntityActor, 3, bytes.NewReader(testPNG(t, 100, 100)))
+		var notFound *model.ErrNotFound
+		assert.ErrorAs(t, err, &notFound)
+
+		entries, err := os.ReadDir(dir)
+		assert.NoError(t, err)
+		assert.Empty(t, entries, "the files of an image not recorded are deleted")
+	})
+}
+
+func TestMediaUsecase_OpenImageUnknownSize(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store, err := blob.NewLocal(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	usecase := NewMediaUsecase(mock_media.NewMockRepository(ctrl), store, logger.NewMockInterface(ctrl))
+
+	_, err = usecase.OpenImage(context.Background(), model.EntityFilm, 7, "w1000")
+	var badRequest *model.ErrBadRequest
+	assert.ErrorAs(t, err, &badRequest)
+}
@@ -0,0 +1,591 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"films_library/internal/auth"
+	"films_library/internal/member"
+	"films_library/internal/model"
+	"films_library/pkg/logger"
+	"films_library/pkg/pagination"
+	"films_library/pkg/response"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mailru/easyjson"
+)
+
+type MemberHandler struct {
+	memberUsecase member.Usecase
+	logger        logger.Interface
+}
+
+// MemberPermissions is the permission each member route requires.
+var MemberPermissions = auth.Permissions{
+	"GET /members":                 auth.MemberManage,
+	"POST /members":                auth.MemberManage,
+	"GET /members/{id}":            auth.MemberManage,
+	"PUT /members/{id}":            auth.MemberManage,
+	"POST /members/{id}/suspend":   auth.MemberManage,
+	"POST /members/{id}/reinstate": auth.MemberManage,
+	"GET /members/{id}/balance":    auth.MemberManage,
+	"GET /members/{id}/statement":  auth.MemberManage,
+	"POST /members/{id}/charges":   auth.LedgerWrite,
+	"POST /members/{id}/payments":  auth.LedgerWrite,
+	"POST /members/{id}/waivers":   auth.LedgerManage,
+	"GET /ledger/accounts":         auth.LedgerManage,
+	"GET /me/membership":           auth.MemberRead,
+	"GET /me/balance":              auth.MemberRead,
+	"GET /me/statement":            auth.MemberRead,
+}
+
+func NewMemberHandler(mux *http.ServeMux, mu member.Usecase, l logger.Interface) {
+	r := &MemberHandler{mu, l}
+
+	mux.HandleFunc("GET /members", r.GetMembers)
+	mux.HandleFunc("POST /members", r.AddMember)
+	mux.HandleFunc("GET /members/{id}", r.GetMember)
+	mux.HandleFunc("PUT /members/{id}", r.UpdateMember)
+	mux.HandleFunc("POST /members/{id}/suspend", r.Suspend)
+	mux.HandleFunc("POST /members/{id}/reinstate", r.Reinstate)
+	mux.HandleFunc("GET /members/{id}/balance", r.GetBalance)
+	mux.HandleFunc("GET /members/{id}/statement", r.GetStatement)
+	mux.HandleFunc("POST /members/{id}/charges", r.Charge)
+	mux.HandleFunc("POST /members/{id}/payments", r.Pay)
+	mux.HandleFunc("POST /members/{id}/waivers", r.Waive)
+	mux.HandleFunc("GET /ledger/accounts", r.GetAccounts)
+	mux.HandleFunc("GET /me/membership", r.GetMyMembership)
+	mux.HandleFunc("GET /me/balance", r.GetMyBalance)
+	mux.HandleFunc("GET /me/statement", r.GetMyStatement)
+}
+
+// GetMembers handles the HTTP GET request to retrieve a list of members.
+// @Summary Get members
+// @Description Retrieves a page of members with their balances.
+// @Tags members
+// @Produce json
+// @Param status query string false "Only members in this state (active, suspended)"
+// @Param tier query string false "Only members of this tier (standard, premium, student)"
+// @Param limit query integer false "Page size (default 20, max 100)"
+// @Param offset query integer false "Number of members to skip"
+// @Param cursor query string false "Cursor of the page to fetch"
+// @Param total query boolean false "Include the total number of members"
+// @Success 200 {array} model.Member "List of members"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /members [get]
+func (h *MemberHandler) GetMembers(w http.ResponseWriter, r *http.Request) {
+	queryParams := r.URL.Query()
+
+	params, err := pagination.ParseQuery(queryParams)
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Bad query param", h.logger)
+		return
+	}
+
+	filter := model.MemberFilter{Status: queryParams.Get("status"), Tier: queryParams.Get("tier"), Params: params}
+
+	v := validator.New()
+	if err := v.Struct(filter); err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Invalid request", h.logger)
+		return
+	}
+
+	members, page, err := h.memberUsecase.GetMembers(r.Context(), filter)
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.PageResponse(w, http.StatusOK, members, page)
+}
+
+// AddMember handles the HTTP POST request to enroll a user.
+// @Summary Add member
+// @Description Enrolls a user as a library member.
+// @Tags members
+// @Accept json
+// @Produce json
+// @Param member body model.MemberRequest true "Membership"
+// @Success 201 {object} model.Member "New member"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "User not found"
+// @Failure 409 {string} string "User is already a member"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /members [post]
+func (h *MemberHandler) AddMember(w http.ResponseWriter, r *http.Request) {
+	req, ok := h.member(w, r)
+	if !ok {
+		return
+	}
+	if req.UserID == 0 {
+		response.ErrorResponse(w, http.StatusBadRequest, "Invalid request", h.logger)
+		return
+	}
+
+	m, err := h.memberUsecase.AddMember(r.Context(), req)
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusCreated, m)
+}
+
+// GetMember handles the HTTP GET request to retrieve a member.
+// @Summary Get member
+// @Description Retrieves a member with its balance.
+// @Tags members
+// @Produce json
+// @Param id path integer true "User ID of the member"
+// @Success 200 {object} model.Member "Member"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Member not found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /members/{id} [get]
+func (h *MemberHandler) GetMember(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.id(w, r)
+	if !ok {
+		return
+	}
+
+	m, err := h.memberUsecase.GetMember(r.Context(), id)
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusOK, m)
+}
+
+// UpdateMember handles the HTTP PUT request to update a membership.
+// @Summary Update member
+// @Description Updates the tier, expiry date and contact details of a member.
+// @Tags members
+// @Accept json
+// @Produce json
+// @Param id path integer true "User ID of the member"
+// @Param member body model.MemberRequest true "Membership"
+// @Success 200 {object} model.Member "Updated member"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Member not found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /members/{id} [put]
+func (h *MemberHandler) UpdateMember(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.id(w, r)
+	if !ok {
+		return
+	}
+
+	req, ok := h.member(w, r)
+	if !ok {
+		return
+	}
+
+	m, err := h.memberUsecase.UpdateMember(r.Context(), id, req)
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusOK, m)
+}
+
+// Suspend handles the HTTP POST request to suspend a member.
+// @Summary Suspend member
+// @Description Suspends a member until staff reinstate it.
+// @Tags members
+// @Produce json
+// @Param id path integer true "User ID of the member"
+// @Success 200 {object} model.Member "Suspended member"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Member not found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /members/{id}/suspend [post]
+func (h *MemberHandler) Suspend(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.id(w, r)
+	if !ok {
+		return
+	}
+
+	m, err := h.memberUsecase.Suspend(r.Context(), id)
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusOK, m)
+}
+
+// Reinstate handles the HTTP POST request to reinstate a member.
+// @Summary Reinstate member
+// @Description Lifts the suspension of a member owing no more than the suspension threshold.
+// @Tags members
+// @Produce json
+// @Param id path integer true "User ID of the member"
+// @Success 200 {object} model.Member "Reinstated member"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Member not found"
+// @Failure 409 {string} string "Member owes more than the suspension threshold"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /members/{id}/reinstate [post]
+func (h *MemberHandler) Reinstate(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.id(w, r)
+	if !ok {
+		return
+	}
+
+	m, err := h.memberUsecase.Reinstate(r.Context(), id)
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusOK, m)
+}
+
+// GetBalance handles the HTTP GET request to retrieve the balance of a member.
+// @Summary Get member balance
+// @Description Retrieves what a member owes, in minor currency units, and the debt above which it is suspended.
+// @Tags members
+// @Produce json
+// @Param id path integer true "User ID of the member"
+// @Success 200 {object} model.Balance "Balance"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Member not found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /members/{id}/balance [get]
+func (h *MemberHandler) GetBalance(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.id(w, r)
+	if !ok {
+		return
+	}
+
+	h.getBalance(w, r, id)
+}
+
+// GetStatement handles the HTTP GET request to retrieve the statement of a member.
+// @Summary Get member statement
+// @Description Retrieves a page of the ledger entries of a member, newest first, with the balance after each.
+// @Tags members
+// @Produce json
+// @Param id path integer true "User ID of the member"
+// @Param limit query integer false "Page size (default 20, max 100)"
+// @Param offset query integer false "Number of entries to skip"
+// @Param cursor query string false "Cursor of the page to fetch"
+// @Param total query boolean false "Include the total number of entries"
+// @Success 200 {array} model.LedgerEntry "List of entries"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Member not found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /members/{id}/statement [get]
+func (h *MemberHandler) GetStatement(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.id(w, r)
+	if !ok {
+		return
+	}
+
+	h.getStatement(w, r, id)
+}
+
+// Charge handles the HTTP POST request to charge a member.
+// @Summary Charge member
+// @Description Charges a member a fee or fine at the configured rate. Overdue fines are charged for a loan; only other charges name their amount. Members owing more than the threshold are suspended.
+// @Tags members
+// @Accept json
+// @Produce json
+// @Param id path integer true "User ID of the member"
+// @Param charge body model.ChargeRequest true "Charge"
+// @Success 201 {object} model.LedgerEntry "Posted entry"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 404 {string} string "Member or loan not found"
+// @Failure 409 {string} string "Loan is not overdue or already fined"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /members/{id}/charges [post]
+func (h *MemberHandler) Charge(w http.ResponseWriter, r *http.Request) {
+	staff, ok := auth.UserFromContext(r.Context())
+	if !ok {
+		response.ErrorResponse(w, http.StatusUnauthorized, "unauthorized", h.logger)
+		return
+	}
+
+	id, ok := h.id(w, r)
+	if !ok {
+		return
+	}
+
+	var req model.ChargeRequest
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Corrupted request body", h.logger)
+		return
+	}
+
+	v := validator.New()
+	if err := v.Struct(req); err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Invalid request", h.logger)
+		return
+	}
+
+	e, err := h.memberUsecase.Charge(r.Context(), staff, id, req)
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusCreated, e)
+}
+
+// Pay handles the HTTP POST request to record a payment.
+// @Summary Record payment
+// @Description Records a payment by a member. Members suspended for debt are reinstated once they owe no more than the threshold.
+// @Tags members
+// @Accept json
+// @Produce json
+// @Param id path integer true "User ID of the member"
+// @Param payment body model.PaymentRequest true "Payment"
+// @Success 201 {object} model.LedgerEntry "Posted entry"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 404 {string} string "Member not found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /members/{id}/payments [post]
+func (h *MemberHandler) Pay(w http.ResponseWriter, r *http.Request) {
+	staff, id, req, ok := h.payment(w, r)
+	if !ok {
+		return
+	}
+
+	e, err := h.memberUsecase.Pay(r.Context(), staff, id, req)
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusCreated, e)
+}
+
+// Waive handles the HTTP POST request to waive debt.
+// @Summary Waive debt
+// @Description Writes off up to what a member owes. Members suspended for debt are reinstated once they owe no more than the threshold.
+// @Tags members
+// @Accept json
+// @Produce json
+// @Param id path integer true "User ID of the member"
+// @Param waiver body model.PaymentRequest true "Waiver"
+// @Success 201 {object} model.LedgerEntry "Posted entry"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 404 {string} string "Member not found"
+// @Failure 409 {string} string "Waiver exceeds the balance"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /members/{id}/waivers [post]
+func (h *MemberHandler) Waive(w http.ResponseWriter, r *http.Request) {
+	staff, id, req, ok := h.payment(w, r)
+	if !ok {
+		return
+	}
+
+	e, err := h.memberUsecase.Waive(r.Context(), staff, id, req)
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusCreated, e)
+}
+
+// GetAccounts handles the HTTP GET request to retrieve the ledger accounts.
+// @Summary Get ledger accounts
+// @Description Retrieves the balance of every ledger account, in minor currency units. Debits are positive and the balances sum to zero.
+// @Tags members
+// @Produce json
+// @Success 200 {array} model.AccountBalance "Accounts"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /ledger/accounts [get]
+func (h *MemberHandler) GetAccounts(w http.ResponseWriter, r *http.Request) {
+	accounts, err := h.memberUsecase.GetAccounts(r.Context())
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusOK, accounts)
+}
+
+// GetMyMembership handles the HTTP GET request to retrieve the membership of the caller.
+// @Summary Get my membership
+// @Description Retrieves the membership of the caller with its balance.
+// @Tags members
+// @Produce json
+// @Success 200 {object} model.Member "Member"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 404 {string} string "Member not found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /me/membership [get]
+func (h *MemberHandler) GetMyMembership(w http.ResponseWriter, r *http.Request) {
+	user, ok := auth.UserFromContext(r.Context())
+	if !ok {
+		response.ErrorResponse(w, http.StatusUnauthorized, "unauthorized", h.logger)
+		return
+	}
+
+	m, err := h.memberUsecase.GetMember(r.Context(), user.ID)
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusOK, m)
+}
+
+// GetMyBalance handles the HTTP GET request to retrieve the balance of the caller.
+// @Summary Get my balance
+// @Description Retrieves what the caller owes, in minor currency units, and the debt above which it is suspended.
+// @Tags members
+// @Produce json
+// @Success 200 {object} model.Balance "Balance"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 404 {string} string "Member not found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /me/balance [get]
+func (h *MemberHandler) GetMyBalance(w http.ResponseWriter, r *http.Request) {
+	user, ok := auth.UserFromContext(r.Context())
+	if !ok {
+		response.ErrorResponse(w, http.StatusUnauthorized, "unauthorized", h.logger)
+		return
+	}
+
+	h.getBalance(w, r, user.ID)
+}
+
+// GetMyStatement handles the HTTP GET request to retrieve the statement of the caller.
+// @Summary Get my statement
+// @Description Retrieves a page of the ledger entries of the caller, newest first, with the balance after each.
+// @Tags members
+// @Produce json
+// @Param limit query integer false "Page size (default 20, max 100)"
+// @Param offset query integer false "Number of entries to skip"
+// @Param cursor query string false "Cursor of the page to fetch"
+// @Param total query boolean false "Include the total number of entries"
+// @Success 200 {array} model.LedgerEntry "List of entries"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 404 {string} string "Member not found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /me/statement [get]
+func (h *MemberHandler) GetMyStatement(w http.ResponseWriter, r *http.Request) {
+	user, ok := auth.UserFromContext(r.Context())
+	if !ok {
+		response.ErrorResponse(w, http.StatusUnauthorized, "unauthorized", h.logger)
+		return
+	}
+
+	h.getStatement(w, r, user.ID)
+}
+
+func (h *MemberHandler) getBalance(w http.ResponseWriter, r *http.Request, id uint64) {
+	b, err := h.memberUsecase.GetBalance(r.Context(), id)
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusOK, b)
+}
+
+func (h *MemberHandler) getStatement(w http.ResponseWriter, r *http.Request, id uint64) {
+	params, err := pagination.ParseQuery(r.URL.Query())
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Bad query param", h.logger)
+		return
+	}
+
+	entries, page, err := h.memberUsecase.GetStatement(r.Context(), model.StatementFilter{MemberID: id, Params: params})
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.PageResponse(w, http.StatusOK, entries, page)
+}
+
+// member decodes and validates a membership from the request body.
+func (h *MemberHandler) member(w http.ResponseWriter, r *http.Request) (model.MemberRequest, bool) {
+	var req model.MemberRequest
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Corrupted request body", h.logger)
+		return model.MemberRequest{}, false
+	}
+
+	v := validator.New()
+	if err := v.Struct(req); err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Invalid request", h.logger)
+		return model.MemberRequest{}, false
+	}
+	return req, true
+}
+
+// payment reads the caller, the member ID and a payment or waiver.
+func (h *MemberHandler) payment(w http.ResponseWriter, r *http.Request) (model.User, uint64, model.PaymentRequest, bool) {
+	staff, ok := auth.UserFromContext(r.Context())
+	if !ok {
+		response.ErrorResponse(w, http.StatusUnauthorized, "unauthorized", h.logger)
+		return model.User{}, 0, model.PaymentRequest{}, false
+	}
+
+	id, ok := h.id(w, r)
+	if !ok {
+		return model.User{}, 0, model.PaymentRequest{}, false
+	}
+
+	var req model.PaymentRequest
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Corrupted request body", h.logger)
+		return model.User{}, 0, model.PaymentRequest{}, false
+	}
+
+	v := validator.New()
+	if err := v.Struct(req); err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Invalid request", h.logger)
+		return model.User{}, 0, model.PaymentRequest{}, false
+	}
+	return staff, id, req, true
+}
+
+// id reads the member ID from the path.
+func (h *MemberHandler) id(w http.ResponseWriter, r *http.Request) (uint64, bool) {
+	id, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, response.InvalidURLParameter, h.logger)
+		return 0, false
+	}
+	return id, true
+}
+
+// usecaseError maps errors returned by the member usecase onto HTTP responses.
+func (h *MemberHandler) usecaseError(w http.ResponseWriter, err error) {
+	var notFound *model.ErrNotFound
+	var conflict *model.ErrConflict
+
+	switch {
+	case errors.As(err, &notFound):
+		response.ErrorResponse(w, http.StatusNotFound, notFound.Error(), h.logger)
+	case errors.As(err, &conflict):
+		response.ErrorResponse(w, http.StatusConflict, conflict.Error(), h.logger)
+	default:
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusInternalServerError, "Internal server error", h.logger)
+	}
+}
@@ -0,0 +1,49 @@
+package member
+
+import (
+	"context"
+
+	"films_library/internal/model"
+	"films_library/pkg/pagination"
+)
+
+type (
+	// Usecase keeps library memberships and their ledgers. Charges are
+	// priced from the fee schedule; members whose debt passes its threshold
+	// are suspended, and reinstated once they pay it down.
+	Usecase interface {
+		GetMembers(ctx context.Context, filter model.MemberFilter) ([]model.Member, pagination.Page, error)
+		GetMember(ctx context.Context, id uint64) (model.Member, error)
+		AddMember(ctx context.Context, req model.MemberRequest) (model.Member, error)
+		UpdateMember(ctx context.Context, id uint64, req model.MemberRequest) (model.Member, error)
+		Suspend(ctx context.Context, id uint64) (model.Member, error)
+		Reinstate(ctx context.Context, id uint64) (model.Member, error)
+
+		GetBalance(ctx context.Context, id uint64) (model.Balance, error)
+		GetStatement(ctx context.Context, filter model.StatementFilter) ([]model.LedgerEntry, pagination.Page, error)
+		Charge(ctx context.Context, staff model.User, id uint64, req model.ChargeRequest) (model.LedgerEntry, error)
+		Pay(ctx context.Context, staff model.User, id uint64, req model.PaymentRequest) (model.LedgerEntry, error)
+		Waive(ctx context.Context, staff model.User, id uint64, req model.PaymentRequest) (model.LedgerEntry, error)
+		GetAccounts(ctx context.Context) ([]model.AccountBalance, error)
+	}
+
+	Repository interface {
+		GetMembers(ctx context.Context, filter model.MemberFilter) ([]model.Member, error)
+		CountMembers(ctx context.Context, filter model.MemberFilter) (int64, error)
+		GetMember(ctx context.Context, id uint64) (model.Member, error)
+		AddMember(ctx context.Context, req model.MemberRequest) (model.Member, error)
+		UpdateMember(ctx context.Context, id uint64, req model.MemberRequest) (model.Member, error)
+		Suspend(ctx context.Context, id uint64) (model.Member, error)
+		Reinstate(ctx context.Context, id uint64, suspendAbove int64) (model.Member, error)
+
+		// OverdueDays is how many days late a loan of the member was
+		// returned, or is by today.
+		OverdueDays(ctx context.Context, memberID, loanID uint64) (int64, error)
+		// PostEntry posts an entry with its lines and suspends or reinstates
+		// the member by its new balance.
+		PostEntry(ctx context.Context, entry model.LedgerEntry, suspendAbove int64) (model.LedgerEntry, error)
+		GetStatement(ctx context.Context, filter model.StatementFilter) ([]model.LedgerEntry, error)
+		CountStatement(ctx context.Context, filter model.StatementFilter) (int64, error)
+		GetAccounts(ctx context.Context) ([]model.AccountBalance, error)
+	}
+)
@@ -0,0 +1,422 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/member/member.go
+
+// Package mock_member is a generated GoMock package.
+package mock_member
+
+import (
+	context "context"
+	model "films_library/internal/model"
+	pagination "films_library/pkg/pagination"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUsecase is a mock of Usecase interface.
+type MockUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseMockRecorder
+}
+
+// MockUsecaseMockRecorder is the mock recorder for MockUsecase.
+type MockUsecaseMockRecorder struct {
+	mock *MockUsecase
+}
+
+// NewMockUsecase creates a new mock instance.
+func NewMockUsecase(ctrl *gomock.Controller) *MockUsecase {
+	mock := &MockUsecase{ctrl: ctrl}
+	mock.recorder = &MockUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
+	return m.recorder
+}
+
+// AddMember mocks base method.
+func (m *MockUsecase) AddMember(ctx context.Context, req model.MemberRequest) (model.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMember", ctx, req)
+	ret0, _ := ret[0].(model.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddMember indicates an expected call of AddMember.
+func (mr *MockUsecaseMockRecorder) AddMember(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMember", reflect.TypeOf((*MockUsecase)(nil).AddMember), ctx, req)
+}
+
+// Charge mocks base method.
+func (m *MockUsecase) Charge(ctx context.Context, staff model.User, id uint64, req model.ChargeRequest) (model.LedgerEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Charge", ctx, staff, id, req)
+	ret0, _ := ret[0].(model.LedgerEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Charge indicates an expected call of Charge.
+func (mr *MockUsecaseMockRecorder) Charge(ctx, staff, id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Charge", reflect.TypeOf((*MockUsecase)(nil).Charge), ctx, staff, id, req)
+}
+
+// GetAccounts mocks base method.
+func (m *MockUsecase) GetAccounts(ctx context.Context) ([]model.AccountBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccounts", ctx)
+	ret0, _ := ret[0].([]model.AccountBalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccounts indicates an expected call of GetAccounts.
+func (mr *MockUsecaseMockRecorder) GetAccounts(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccounts", reflect.TypeOf((*MockUsecase)(nil).GetAccounts), ctx)
+}
+
+// GetBalance mocks base method.
+func (m *MockUsecase) GetBalance(ctx context.Context, id uint64) (model.Balance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBalance", ctx, id)
+	ret0, _ := ret[0].(model.Balance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBalance indicates an expected call of GetBalance.
+func (mr *MockUsecaseMockRecorder) GetBalance(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalance", reflect.TypeOf((*MockUsecase)(nil).GetBalance), ctx, id)
+}
+
+// GetMember mocks base method.
+func (m *MockUsecase) GetMember(ctx context.Context, id uint64) (model.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMember", ctx, id)
+	ret0, _ := ret[0].(model.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMember indicates an expected call of GetMember.
+func (mr *MockUsecaseMockRecorder) GetMember(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMember", reflect.TypeOf((*MockUsecase)(nil).GetMember), ctx, id)
+}
+
+// GetMembers mocks base method.
+func (m *MockUsecase) GetMembers(ctx context.Context, filter model.MemberFilter) ([]model.Member, pagination.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMembers", ctx, filter)
+	ret0, _ := ret[0].([]model.Member)
+	ret1, _ := ret[1].(pagination.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetMembers indicates an expected call of GetMembers.
+func (mr *MockUsecaseMockRecorder) GetMembers(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMembers", reflect.TypeOf((*MockUsecase)(nil).GetMembers), ctx, filter)
+}
+
+// GetStatement mocks base method.
+func (m *MockUsecase) GetStatement(ctx context.Context, filter model.StatementFilter) ([]model.LedgerEntry, pagination.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatement", ctx, filter)
+	ret0, _ := ret[0].([]model.LedgerEntry)
+	ret1, _ := ret[1].(pagination.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetStatement indicates an expected call of GetStatement.
+func (mr *MockUsecaseMockRecorder) GetStatement(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatement", reflect.TypeOf((*MockUsecase)(nil).GetStatement), ctx, filter)
+}
+
+// Pay mocks base method.
+func (m *MockUsecase) Pay(ctx context.Context, staff model.User, id uint64, req model.PaymentRequest) (model.LedgerEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pay", ctx, staff, id, req)
+	ret0, _ := ret[0].(model.LedgerEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Pay indicates an expected call of Pay.
+func (mr *MockUsecaseMockRecorder) Pay(ctx, staff, id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pay", reflect.TypeOf((*MockUsecase)(nil).Pay), ctx, staff, id, req)
+}
+
+// Reinstate mocks base method.
+func (m *MockUsecase) Reinstate(ctx context.Context, id uint64) (model.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reinstate", ctx, id)
+	ret0, _ := ret[0].(model.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reinstate indicates an expected call of Reinstate.
+func (mr *MockUsecaseMockRecorder) Reinstate(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reinstate", reflect.TypeOf((*MockUsecase)(nil).Reinstate), ctx, id)
+}
+
+// Suspend mocks base method.
+func (m *MockUsecase) Suspend(ctx context.Context, id uint64) (model.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Suspend", ctx, id)
+	ret0, _ := ret[0].(model.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Suspend indicates an expected call of Suspend.
+func (mr *MockUsecaseMockRecorder) Suspend(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suspend", reflect.TypeOf((*MockUsecase)(nil).Suspend), ctx, id)
+}
+
+// UpdateMember mocks base method.
+func (m *MockUsecase) UpdateMember(ctx context.Context, id uint64, req model.MemberRequest) (model.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMember", ctx, id, req)
+	ret0, _ := ret[0].(model.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMember indicates an expected call of UpdateMember.
+func (mr *MockUsecaseMockRecorder) UpdateMember(ctx, id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMember", reflect.TypeOf((*MockUsecase)(nil).UpdateMember), ctx, id, req)
+}
+
+// Waive mocks base method.
+func (m *MockUsecase) Waive(ctx context.Context, staff model.User, id uint64, req model.PaymentRequest) (model.LedgerEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Waive", ctx, staff, id, req)
+	ret0, _ := ret[0].(model.LedgerEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Waive indicates an expected call of Waive.
+func (mr *MockUsecaseMockRecorder) Waive(ctx, staff, id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Waive", reflect.TypeOf((*MockUsecase)(nil).Waive), ctx, staff, id, req)
+}
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddMember mocks base method.
+func (m *MockRepository) AddMember(ctx context.Context, req model.MemberRequest) (model.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMember", ctx, req)
+	ret0, _ := ret[0].(model.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddMember indicates an expected call of AddMember.
+func (mr *MockRepositoryMockRecorder) AddMember(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMember", reflect.TypeOf((*MockRepository)(nil).AddMember), ctx, req)
+}
+
+// CountMembers mocks base method.
+func (m *MockRepository) CountMembers(ctx context.Context, filter model.MemberFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountMembers", ctx, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountMembers indicates an expected call of CountMembers.
+func (mr *MockRepositoryMockRecorder) CountMembers(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountMembers", reflect.TypeOf((*MockRepository)(nil).CountMembers), ctx, filter)
+}
+
+// CountStatement mocks base method.
+func (m *MockRepository) CountStatement(ctx context.Context, filter model.StatementFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountStatement", ctx, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountStatement indicates an expected call of CountStatement.
+func (mr *MockRepositoryMockRecorder) CountStatement(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountStatement", reflect.TypeOf((*MockRepository)(nil).CountStatement), ctx, filter)
+}
+
+// GetAccounts mocks base method.
+func (m *MockRepository) GetAccounts(ctx context.Context) ([]model.AccountBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccounts", ctx)
+	ret0, _ := ret[0].([]model.AccountBalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccounts indicates an expected call of GetAccounts.
+func (mr *MockRepositoryMockRecorder) GetAccounts(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccounts", reflect.TypeOf((*MockRepository)(nil).GetAccounts), ctx)
+}
+
+// GetMember mocks base method.
+func (m *MockRepository) GetMember(ctx context.Context, id uint64) (model.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMember", ctx, id)
+	ret0, _ := ret[0].(model.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMember indicates an expected call of GetMember.
+func (mr *MockRepositoryMockRecorder) GetMember(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMember", reflect.TypeOf((*MockRepository)(nil).GetMember), ctx, id)
+}
+
+// GetMembers mocks base method.
+func (m *MockRepository) GetMembers(ctx context.Context, filter model.MemberFilter) ([]model.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMembers", ctx, filter)
+	ret0, _ := ret[0].([]model.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMembers indicates an expected call of GetMembers.
+func (mr *MockRepositoryMockRecorder) GetMembers(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMembers", reflect.TypeOf((*MockRepository)(nil).GetMembers), ctx, filter)
+}
+
+// GetStatement mocks base method.
+func (m *MockRepository) GetStatement(ctx context.Context, filter model.StatementFilter) ([]model.LedgerEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatement", ctx, filter)
+	ret0, _ := ret[0].([]model.LedgerEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatement indicates an expected call of GetStatement.
+func (mr *MockRepositoryMockRecorder) GetStatement(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatement", reflect.TypeOf((*MockRepository)(nil).GetStatement), ctx, filter)
+}
+
+// OverdueDays mocks base method.
+func (m *MockRepository) OverdueDays(ctx context.Context, memberID, loanID uint64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OverdueDays", ctx, memberID, loanID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OverdueDays indicates an expected call of OverdueDays.
+func (mr *MockRepositoryMockRecorder) OverdueDays(ctx, memberID, loanID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OverdueDays", reflect.TypeOf((*MockRepository)(nil).OverdueDays), ctx, memberID, loanID)
+}
+
+// PostEntry mocks base method.
+func (m *MockRepository) PostEntry(ctx context.Context, entry model.LedgerEntry, suspendAbove int64) (model.LedgerEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostEntry", ctx, entry, suspendAbove)
+	ret0, _ := ret[0].(model.LedgerEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostEntry indicates an expected call of PostEntry.
+func (mr *MockRepositoryMockRecorder) PostEntry(ctx, entry, suspendAbove interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostEntry", reflect.TypeOf((*MockRepository)(nil).PostEntry), ctx, entry, suspendAbove)
+}
+
+// Reinstate mocks base method.
+func (m *MockRepository) Reinstate(ctx context.Context, id uint64, suspendAbove int64) (model.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reinstate", ctx, id, suspendAbove)
+	ret0, _ := ret[0].(model.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reinstate indicates an expected call of Reinstate.
+func (mr *MockRepositoryMockRecorder) Reinstate(ctx, id, suspendAbove interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reinstate", reflect.TypeOf((*MockRepository)(nil).Reinstate), ctx, id, suspendAbove)
+}
+
+// Suspend mocks base method.
+func (m *MockRepository) Suspend(ctx context.Context, id uint64) (model.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Suspend", ctx, id)
+	ret0, _ := ret[0].(model.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Suspend indicates an expected call of Suspend.
+func (mr *MockRepositoryMockRecorder) Suspend(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suspend", reflect.TypeOf((*MockRepository)(nil).Suspend), ctx, id)
+}
+
+// UpdateMember mocks base method.
+func (m *MockRepository) UpdateMember(ctx context.Context, id uint64, req model.MemberRequest) (model.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMember", ctx, id, req)
+	ret0, _ := ret[0].(model.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMember indicates an expected call of UpdateMember.
+func (mr *MockRepositoryMockRecorder) UpdateMember(ctx, id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMember", reflect.TypeOf((*MockRepository)(nil).UpdateMember), ctx, id, req)
+}
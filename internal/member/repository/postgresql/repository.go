@@ -0,0 +1,437 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"films_library/internal/model"
+	"films_library/pkg/pagination"
+	"films_library/pkg/postgres"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	foreignKeyViolation = "23503"
+	uniqueViolation     = "23505"
+)
+
+const memberColumns = `m.user_id, u.username, m.tier, to_char(m.expires_on, 'YYYY-MM-DD'), m.status,
+        COALESCE(m.suspension, ''), m.email, m.phone, m.address, b.balance, m.created_at, m.updated_at`
+
+// memberJoins sums the receivable lines of each member as b.balance.
+const memberJoins = ` FROM member m
+        JOIN users u ON u.user_id = m.user_id
+        CROSS JOIN LATERAL (
+            SELECT COALESCE(sum(ll.amount), 0)::bigint AS balance
+            FROM ledger_entry e JOIN ledger_line ll ON ll.entry_id = e.entry_id AND ll.account = 'receivable'
+            WHERE e.member_id = m.user_id) b`
+
+// statementEntries are the entries of member $1 with the balance after each.
+const statementEntries = `(
+            SELECT e.*, sum(CASE WHEN e.kind = 'charge' THEN e.amount ELSE -e.amount END)
+                OVER (ORDER BY e.entry_id)::bigint AS balance
+            FROM ledger_entry e WHERE e.member_id = $1) e`
+
+type Repository struct {
+	db postgres.DBConn
+}
+
+func NewRepository(db postgres.DBConn) *Repository {
+	return &Repository{db}
+}
+
+// GetMembers lists members in the order their users were created.
+func (r *Repository) GetMembers(ctx context.Context, filter model.MemberFilter) ([]model.Member, error) {
+	sqlQuery := `SELECT ` + memberColumns + memberJoins
+
+	backward := filter.Backward()
+
+	args, where := memberConditions(filter)
+	condition, clauses := filter.Keyset(pagination.Order{Key: "m.user_id"}, &args)
+	if condition != "" {
+		where = append(where, condition)
+	}
+	for i, condition := range where {
+		if i == 0 {
+			sqlQuery += " WHERE " + condition
+		} else {
+			sqlQuery += " AND " + condition
+		}
+	}
+	sqlQuery += clauses
+
+	rows, err := r.db.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var members []model.Member
+	for rows.Next() {
+		m, err := scanMember(rows)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if backward {
+		slices.Reverse(members)
+	}
+	return members, nil
+}
+
+func (r *Repository) CountMembers(ctx context.Context, filter model.MemberFilter) (int64, error) {
+	sqlQuery := `SELECT count(*) FROM member m`
+
+	args, where := memberConditions(filter)
+	for i, condition := range where {
+		if i == 0 {
+			sqlQuery += " WHERE " + condition
+		} else {
+			sqlQuery += " AND " + condition
+		}
+	}
+
+	var total int64
+	if err := r.db.QueryRow(ctx, sqlQuery, args...).Scan(&total); err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+func memberConditions(filter model.MemberFilter) ([]interface{}, []string) {
+	var args []interface{}
+	var where []string
+	if filter.Status != "" {
+		args = append(args, filter.Status)
+		where = append(where, fmt.Sprintf("m.status = $%d", len(args)))
+	}
+	if filter.Tier != "" {
+		args = append(args, filter.Tier)
+		where = append(where, fmt.Sprintf("m.tier = $%d", len(args)))
+	}
+	return args, where
+}
+
+func (r *Repository) GetMember(ctx context.Context, id uint64) (model.Member, error) {
+	return getMember(ctx, r.db, id)
+}
+
+func (r *Repository) AddMember(ctx context.Context, req model.MemberRequest) (model.Member, error) {
+	sqlQuery := `
+        INSERT INTO member (user_id, tier, expires_on, email, phone, address)
+        VALUES ($1, $2, $3::date, $4, $5, $6)`
+
+	_, err := r.db.Exec(ctx, sqlQuery, req.UserID, req.Tier, req.ExpiresOn, req.Email, req.Phone, req.Address)
+	if err != nil {
+		return model.Member{}, memberError(err)
+	}
+	return getMember(ctx, r.db, req.UserID)
+}
+
+func (r *Repository) UpdateMember(ctx context.Context, id uint64, req model.MemberRequest) (model.Member, error) {
+	sqlQuery := `
+        UPDATE member SET tier=$1, expires_on=$2::date, email=$3, phone=$4, address=$5, updated_at=now()
+        WHERE user_id=$6`
+
+	res, err := r.db.Exec(ctx, sqlQuery, req.Tier, req.ExpiresOn, req.Email, req.Phone, req.Address, id)
+	if err != nil {
+		return model.Member{}, err
+	}
+	if res.RowsAffected() == 0 {
+		return model.Member{}, &model.ErrNotFound{Message: "member not found"}
+	}
+	return getMember(ctx, r.db, id)
+}
+
+// Suspend suspends a member until staff reinstate it, whatever it owes.
+func (r *Repository) Suspend(ctx context.Context, id uint64) (model.Member, error) {
+	sqlQuery := `UPDATE member SET status='suspended', suspension='manual', updated_at=now() WHERE user_id=$1`
+
+	res, err := r.db.Exec(ctx, sqlQuery, id)
+	if err != nil {
+		return model.Member{}, err
+	}
+	if res.RowsAffected() == 0 {
+		return model.Member{}, &model.ErrNotFound{Message: "member not found"}
+	}
+	return getMember(ctx, r.db, id)
+}
+
+// Reinstate lifts the suspension of a member owing no more than suspendAbove.
+func (r *Repository) Reinstate(ctx context.Context, id uint64, suspendAbove int64) (model.Member, error) {
+	var reinstated model.Member
+	err := r.db.BeginTxFunc(ctx, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		if _, _, err := lockMember(ctx, tx, id); err != nil {
+			return err
+		}
+
+		balance, err := receivable(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if balance > suspendAbove {
+			return &model.ErrConflict{Message: "member owes more than the suspension threshold"}
+		}
+
+		_, err = tx.Exec(ctx, `UPDATE member SET status='active', suspension=NULL, updated_at=now() WHERE user_id=$1`, id)
+		if err != nil {
+			return err
+		}
+
+		reinstated, err = getMember(ctx, tx, id)
+		return err
+	})
+	if err != nil {
+		return model.Member{}, err
+	}
+	return reinstated, nil
+}
+
+func (r *Repository) OverdueDays(ctx context.Context, memberID, loanID uint64) (int64, error) {
+	sqlQuery := `
+        SELECT COALESCE(returned_at::date, current_date) - due_on
+        FROM loan WHERE loan_id=$1 AND borrower_id=$2`
+
+	var days int64
+	err := r.db.QueryRow(ctx, sqlQuery, loanID, memberID).Scan(&days)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, &model.ErrNotFound{Message: "loan not found"}
+		}
+		return 0, err
+	}
+	return days, nil
+}
+
+// PostEntry posts an entry and its lines. Waivers may not exceed what the
+// member owes. Active members owing more than suspendAbove afterwards are
+// suspended for debt; members suspended for debt owing no more are
+// reinstated.
+func (r *Repository) PostEntry(ctx context.Context, entry model.LedgerEntry, suspendAbove int64) (model.LedgerEntry, error) {
+	err := r.db.BeginTxFunc(ctx, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		status, suspension, err := lockMember(ctx, tx, entry.MemberID)
+		if err != nil {
+			return err
+		}
+
+		balance, err := receivable(ctx, tx, entry.MemberID)
+		if err != nil {
+			return err
+		}
+		if entry.Kind == model.EntryWaiver && entry.Amount > balance {
+			return &model.ErrConflict{Message: "waiver exceeds the balance"}
+		}
+
+		sqlQuery := `
+            INSERT INTO ledger_entry (member_id, kind, category, amount, loan_id, memo, posted_by)
+            VALUES ($1, $2, NULLIF($3, ''), $4, $5, $6, NULLIF($7, 0))
+            RETURNING entry_id, posted_at`
+
+		err = tx.QueryRow(ctx, sqlQuery, entry.MemberID, entry.Kind, entry.Category, entry.Amount, entry.LoanID, entry.Memo, entry.PostedBy).
+			Scan(&entry.ID, &entry.PostedAt)
+		if err != nil {
+			return entryError(err)
+		}
+
+		accounts := make([]string, 0, len(entry.Lines))
+		amounts := make([]int64, 0, len(entry.Lines))
+		for _, line := range entry.Lines {
+			accounts = append(accounts, line.Account)
+			amounts = append(amounts, line.Amount)
+			if line.Account == model.AccountReceivable {
+				balance += line.Amount
+			}
+		}
+		sqlQuery = `
+            INSERT INTO ledger_line (entry_id, account, amount)
+            SELECT $1, unnest($2::text[]), unnest($3::bigint[])`
+
+		if _, err := tx.Exec(ctx, sqlQuery, entry.ID, accounts, amounts); err != nil {
+			return err
+		}
+		entry.Balance = balance
+
+		switch {
+		case status == model.MemberActive && balance > suspendAbove:
+			_, err = tx.Exec(ctx, `UPDATE member SET status='suspended', suspension='debt', updated_at=now() WHERE user_id=$1`, entry.MemberID)
+		case suspension == model.SuspensionDebt && balance <= suspendAbove:
+			_, err = tx.Exec(ctx, `UPDATE member SET status='active', suspension=NULL, updated_at=now() WHERE user_id=$1`, entry.MemberID)
+		}
+		return err
+	})
+	if err != nil {
+		return model.LedgerEntry{}, err
+	}
+	return entry, nil
+}
+
+// GetStatement lists the entries of a member newest first.
+func (r *Repository) GetStatement(ctx context.Context, filter model.StatementFilter) ([]model.LedgerEntry, error) {
+	sqlQuery := `
+        SELECT e.entry_id, e.member_id, e.kind, COALESCE(e.category, ''), e.amount, e.loan_id, e.memo,
+            COALESCE(e.posted_by, 0), e.posted_at, e.balance
+        FROM ` + statementEntries
+
+	backward := filter.Backward()
+
+	args := []interface{}{filter.MemberID}
+	condition, clauses := filter.Keyset(pagination.Order{Key: "e.entry_id", Desc: true}, &args)
+	if condition != "" {
+		sqlQuery += " WHERE " + condition
+	}
+	sqlQuery += clauses
+
+	rows, err := r.db.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []model.LedgerEntry
+	for rows.Next() {
+		var e model.LedgerEntry
+		err := rows.Scan(&e.ID, &e.MemberID, &e.Kind, &e.Category, &e.Amount, &e.LoanID, &e.Memo, &e.PostedBy, &e.PostedAt, &e.Balance)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if backward {
+		slices.Reverse(entries)
+	}
+	return entries, nil
+}
+
+func (r *Repository) CountStatement(ctx context.Context, filter model.StatementFilter) (int64, error) {
+	var total int64
+	err := r.db.QueryRow(ctx, `SELECT count(*) FROM ledger_entry WHERE member_id=$1`, filter.MemberID).Scan(&total)
+	if err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+// GetAccounts sums the lines of every ledger account. The sums balance.
+func (r *Repository) GetAccounts(ctx context.Context) ([]model.AccountBalance, error) {
+	rows, err := r.db.Query(ctx, `SELECT account, sum(amount)::bigint FROM ledger_line GROUP BY account ORDER BY account`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	accounts := []model.AccountBalance{}
+	for rows.Next() {
+		var a model.AccountBalance
+		if err := rows.Scan(&a.Account, &a.Balance); err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, a)
+	}
+	return accounts, rows.Err()
+}
+
+// lockMember locks a member for posting and returns its status and suspension.
+func lockMember(ctx context.Context, tx pgx.Tx, id uint64) (string, string, error) {
+	var status, suspension string
+	err := tx.QueryRow(ctx, `SELECT status, COALESCE(suspension, '') FROM member WHERE user_id=$1 FOR UPDATE`, id).
+		Scan(&status, &suspension)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", "", &model.ErrNotFound{Message: "member not found"}
+		}
+		return "", "", err
+	}
+	return status, suspension, nil
+}
+
+// receivable is what a member owes.
+func receivable(ctx context.Context, tx pgx.Tx, id uint64) (int64, error) {
+	sqlQuery := `
+        SELECT COALESCE(sum(ll.amount), 0)::bigint
+        FROM ledger_entry e JOIN ledger_line ll ON ll.entry_id = e.entry_id AND ll.account = 'receivable'
+        WHERE e.member_id = $1`
+
+	var balance int64
+	err := tx.QueryRow(ctx, sqlQuery, id).Scan(&balance)
+	return balance, err
+}
+
+// querier is what getMember needs of a pool or a transaction.
+type querier interface {
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+}
+
+func getMember(ctx context.Context, q querier, id uint64) (model.Member, error) {
+	sqlQuery := `SELECT ` + memberColumns + memberJoins + ` WHERE m.user_id=$1`
+
+	m, err := scanMember(q.QueryRow(ctx, sqlQuery, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Member{}, &model.ErrNotFound{Message: "member not found"}
+		}
+		return model.Member{}, err
+	}
+	return m, nil
+}
+
+func scanMember(row pgx.Row) (model.Member, error) {
+	var m model.Member
+	err := row.Scan(
+		&m.UserID,
+		&m.Username,
+		&m.Tier,
+		&m.ExpiresOn,
+		&m.Status,
+		&m.Suspension,
+		&m.Email,
+		&m.Phone,
+		&m.Address,
+		&m.Balance,
+		&m.CreatedAt,
+		&m.UpdatedAt,
+	)
+	return m, err
+}
+
+func memberError(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+
+	switch pgErr.Code {
+	case foreignKeyViolation:
+		return &model.ErrNotFound{Message: "user not found"}
+	case uniqueViolation:
+		return &model.ErrConflict{Message: "user is already a member"}
+	}
+	return err
+}
+
+func entryError(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+
+	switch pgErr.Code {
+	case foreignKeyViolation:
+		return &model.ErrNotFound{Message: "loan not found"}
+	case uniqueViolation:
+		return &model.ErrConflict{Message: "loan is already fined"}
+	}
+	return err
+}
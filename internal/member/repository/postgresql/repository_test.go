@@ -0,0 +1,92 @@
+package postgresql
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"films_library/internal/model"
+
+	"github.com/pashagolub/pgxmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostEntrySuspendsForDebt(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mock.Close()
+
+	repo := NewRepository(mock)
+
+	entry := model.LedgerEntry{
+		MemberID: 2,
+		Kind:     model.EntryCharge,
+		Category: model.ChargeReplacement,
+		Amount:   2500,
+		PostedBy: 9,
+		Lines: []model.LedgerLine{
+			{Account: model.AccountReceivable, Amount: 2500},
+			{Account: model.AccountFeeIncome, Amount: -2500},
+		},
+	}
+	postedAt := time.Now()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT status, COALESCE(suspension, '') FROM member WHERE user_id=$1 FOR UPDATE`)).
+		WithArgs(uint64(2)).
+		WillReturnRows(pgxmock.NewRows([]string{"status", "suspension"}).AddRow(model.MemberActive, ""))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT COALESCE(sum(ll.amount), 0)::bigint`)).
+		WithArgs(uint64(2)).
+		WillReturnRows(pgxmock.NewRows([]string{"balance"}).AddRow(int64(100)))
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO ledger_entry`)).
+		WithArgs(uint64(2), model.EntryCharge, model.ChargeReplacement, int64(2500), (*uint64)(nil), "", uint64(9)).
+		WillReturnRows(pgxmock.NewRows([]string{"entry_id", "posted_at"}).AddRow(uint64(7), postedAt))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO ledger_line (entry_id, account, amount)`)).
+		WithArgs(uint64(7), []string{model.AccountReceivable, model.AccountFeeIncome}, []int64{2500, -2500}).
+		WillReturnResult(pgxmock.NewResult("INSERT", 2))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE member SET status='suspended', suspension='debt'`)).
+		WithArgs(uint64(2)).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	mock.ExpectCommit()
+
+	posted, err := repo.PostEntry(context.Background(), entry, 1000)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(7), posted.ID)
+	assert.Equal(t, int64(2600), posted.Balance)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestPostEntryLimitsWaivers(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mock.Close()
+
+	repo := NewRepository(mock)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT status, COALESCE(suspension, '') FROM member WHERE user_id=$1 FOR UPDATE`)).
+		WithArgs(uint64(2)).
+		WillReturnRows(pgxmock.NewRows([]string{"status", "suspension"}).AddRow(model.MemberActive, ""))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT COALESCE(sum(ll.amount), 0)::bigint`)).
+		WithArgs(uint64(2)).
+		WillReturnRows(pgxmock.NewRows([]string{"balance"}).AddRow(int64(100)))
+	// pgxmock's BeginTxFunc rolls back once on the error and again in its
+	// deferred cleanup.
+	mock.ExpectRollback()
+	mock.ExpectRollback()
+
+	_, err = repo.PostEntry(context.Background(), model.LedgerEntry{MemberID: 2, Kind: model.EntryWaiver, Amount: 500}, 1000)
+	assert.Equal(t, &model.ErrConflict{Message: "waiver exceeds the balance"}, err)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
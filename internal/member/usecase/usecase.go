@@ -0,0 +1,166 @@
+package usecase
+
+import (
+	"context"
+
+	"films_library/internal/member"
+	"films_library/internal/model"
+	"films_library/pkg/logger"
+	"films_library/pkg/pagination"
+)
+
+type MemberUsecase struct {
+	memberRepo member.Repository
+	fees       model.FeeSchedule
+	logger     logger.Interface
+}
+
+func NewMemberUsecase(mr member.Repository, fees model.FeeSchedule, l logger.Interface) *MemberUsecase {
+	return &MemberUsecase{mr, fees, l}
+}
+
+func (mu *MemberUsecase) GetMembers(ctx context.Context, filter model.MemberFilter) ([]model.Member, pagination.Page, error) {
+	members, err := mu.memberRepo.GetMembers(ctx, filter)
+	if err != nil {
+		return []model.Member{}, pagination.Page{}, err
+	}
+
+	members, page := pagination.Paginate(members, filter.Params, func(m model.Member) pagination.Cursor {
+		return pagination.Cursor{ID: m.UserID}
+	})
+
+	if filter.WithTotal {
+		total, err := mu.memberRepo.CountMembers(ctx, filter)
+		if err != nil {
+			return []model.Member{}, pagination.Page{}, err
+		}
+		page.Total = &total
+	}
+	return members, page, nil
+}
+
+func (mu *MemberUsecase) GetMember(ctx context.Context, id uint64) (model.Member, error) {
+	return mu.memberRepo.GetMember(ctx, id)
+}
+
+func (mu *MemberUsecase) AddMember(ctx context.Context, req model.MemberRequest) (model.Member, error) {
+	return mu.memberRepo.AddMember(ctx, req)
+}
+
+func (mu *MemberUsecase) UpdateMember(ctx context.Context, id uint64, req model.MemberRequest) (model.Member, error) {
+	return mu.memberRepo.UpdateMember(ctx, id, req)
+}
+
+func (mu *MemberUsecase) Suspend(ctx context.Context, id uint64) (model.Member, error) {
+	return mu.memberRepo.Suspend(ctx, id)
+}
+
+func (mu *MemberUsecase) Reinstate(ctx context.Context, id uint64) (model.Member, error) {
+	return mu.memberRepo.Reinstate(ctx, id, mu.fees.SuspendAbove)
+}
+
+func (mu *MemberUsecase) GetBalance(ctx context.Context, id uint64) (model.Balance, error) {
+	m, err := mu.memberRepo.GetMember(ctx, id)
+	if err != nil {
+		return model.Balance{}, err
+	}
+	return model.Balance{MemberID: m.UserID, Balance: m.Balance, SuspendAbove: mu.fees.SuspendAbove, Status: m.Status}, nil
+}
+
+func (mu *MemberUsecase) GetStatement(ctx context.Context, filter model.StatementFilter) ([]model.LedgerEntry, pagination.Page, error) {
+	if _, err := mu.memberRepo.GetMember(ctx, filter.MemberID); err != nil {
+		return []model.LedgerEntry{}, pagination.Page{}, err
+	}
+
+	entries, err := mu.memberRepo.GetStatement(ctx, filter)
+	if err != nil {
+		return []model.LedgerEntry{}, pagination.Page{}, err
+	}
+
+	entries, page := pagination.Paginate(entries, filter.Params, func(e model.LedgerEntry) pagination.Cursor {
+		return pagination.Cursor{ID: e.ID}
+	})
+
+	if filter.WithTotal {
+		total, err := mu.memberRepo.CountStatement(ctx, filter)
+		if err != nil {
+			return []model.LedgerEntry{}, pagination.Page{}, err
+		}
+		page.Total = &total
+	}
+	return entries, page, nil
+}
+
+// Charge prices a charge from the fee schedule and posts it. Overdue fines
+// accrue per day late, up to the cap.
+func (mu *MemberUsecase) Charge(ctx context.Context, staff model.User, id uint64, req model.ChargeRequest) (model.LedgerEntry, error) {
+	amount := req.Amount
+	switch req.Category {
+	case model.ChargeMembership:
+		amount = mu.fees.Membership
+	case model.ChargeReplacement:
+		amount = mu.fees.Replacement
+	case model.ChargeOverdue:
+		days, err := mu.memberRepo.OverdueDays(ctx, id, *req.LoanID)
+		if err != nil {
+			return model.LedgerEntry{}, err
+		}
+		if days <= 0 {
+			return model.LedgerEntry{}, &model.ErrConflict{Message: "loan is not overdue"}
+		}
+		amount = min(days*mu.fees.OverduePerDay, mu.fees.OverdueMax)
+	}
+	if amount <= 0 {
+		return model.LedgerEntry{}, &model.ErrConflict{Message: "charge has no rate"}
+	}
+
+	income := model.AccountFeeIncome
+	if req.Category == model.ChargeOverdue {
+		income = model.AccountFineIncome
+	}
+
+	return mu.memberRepo.PostEntry(ctx, model.LedgerEntry{
+		MemberID: id,
+		Kind:     model.EntryCharge,
+		Category: req.Category,
+		Amount:   amount,
+		LoanID:   req.LoanID,
+		Memo:     req.Memo,
+		PostedBy: staff.ID,
+		Lines:    entryLines(model.AccountReceivable, income, amount),
+	}, mu.fees.SuspendAbove)
+}
+
+func (mu *MemberUsecase) Pay(ctx context.Context, staff model.User, id uint64, req model.PaymentRequest) (model.LedgerEntry, error) {
+	return mu.memberRepo.PostEntry(ctx, model.LedgerEntry{
+		MemberID: id,
+		Kind:     model.EntryPayment,
+		Amount:   req.Amount,
+		Memo:     req.Memo,
+		PostedBy: staff.ID,
+		Lines:    entryLines(model.AccountCash, model.AccountReceivable, req.Amount),
+	}, mu.fees.SuspendAbove)
+}
+
+func (mu *MemberUsecase) Waive(ctx context.Context, staff model.User, id uint64, req model.PaymentRequest) (model.LedgerEntry, error) {
+	return mu.memberRepo.PostEntry(ctx, model.LedgerEntry{
+		MemberID: id,
+		Kind:     model.EntryWaiver,
+		Amount:   req.Amount,
+		Memo:     req.Memo,
+		PostedBy: staff.ID,
+		Lines:    entryLines(model.AccountWaivers, model.AccountReceivable, req.Amount),
+	}, mu.fees.SuspendAbove)
+}
+
+func (mu *MemberUsecase) GetAccounts(ctx context.Context) ([]model.AccountBalance, error) {
+	return mu.memberRepo.GetAccounts(ctx)
+}
+
+// entryLines debits one account and credits another with amount.
+func entryLines(debit, credit string, amount int64) []model.LedgerLine {
+	return []model.LedgerLine{
+		{Account: debit, Amount: amount},
+		{Account: credit, Amount: -amount},
+	}
+}
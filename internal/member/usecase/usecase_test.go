@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	mock_member "films_library/internal/member/mocks"
+	"films_library/internal/model"
+	"films_library/pkg/logger"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemberUsecase_Charge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	loggerMock := logger.NewMockInterface(ctrl)
+	memberRepo := mock_member.NewMockRepository(ctrl)
+	fees := model.FeeSchedule{Membership: 2000, Replacement: 2500, OverduePerDay: 25, OverdueMax: 1000, SuspendAbove: 1000}
+	usecase := NewMemberUsecase(memberRepo, fees, loggerMock)
+
+	ctx := context.Background()
+	staff := model.User{ID: 9}
+	loanID := uint64(4)
+
+	testCases := []struct {
+		name          string
+		req           model.ChargeRequest
+		overdueDays   int64
+		expectPost    bool
+		amount        int64
+		income        string
+		expectedError error
+	}{
+		{
+			name:       "Membership fee",
+			req:        model.ChargeRequest{Category: model.ChargeMembership},
+			expectPost: true,
+			amount:     2000,
+			income:     model.AccountFeeIncome,
+		},
+		{
+			name:        "Overdue fine per day",
+			req:         model.ChargeRequest{Category: model.ChargeOverdue, LoanID: &loanID},
+			overdueDays: 3,
+			expectPost:  true,
+			amount:      75,
+			income:      model.AccountFineIncome,
+		},
+		{
+			name:        "Overdue fine is capped",
+			req:         model.ChargeRequest{Category: model.ChargeOverdue, LoanID: &loanID},
+			overdueDays: 90,
+			expectPost:  true,
+			amount:      1000,
+			income:      model.AccountFineIncome,
+		},
+		{
+			name:          "Loan returned on time",
+			req:           model.ChargeRequest{Category: model.ChargeOverdue, LoanID: &loanID},
+			expectedError: &model.ErrConflict{Message: "loan is not overdue"},
+		},
+		{
+			name:       "Other charge names its amount",
+			req:        model.ChargeRequest{Category: model.ChargeOther, Amount: 300, Memo: "Lost case"},
+			expectPost: true,
+			amount:     300,
+			income:     model.AccountFeeIncome,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.req.Category == model.ChargeOverdue {
+				memberRepo.EXPECT().OverdueDays(ctx, uint64(2), loanID).Return(tc.overdueDays, nil)
+			}
+			if tc.expectPost {
+				entry := model.LedgerEntry{
+					MemberID: 2,
+					Kind:     model.EntryCharge,
+					Category: tc.req.Category,
+					Amount:   tc.amount,
+					LoanID:   tc.req.LoanID,
+					Memo:     tc.req.Memo,
+					PostedBy: staff.ID,
+					Lines: []model.LedgerLine{
+						{Account: model.AccountReceivable, Amount: tc.amount},
+						{Account: tc.income, Amount: -tc.amount},
+					},
+				}
+				memberRepo.EXPECT().PostEntry(ctx, entry, fees.SuspendAbove).Return(entry, nil)
+			}
+
+			e, err := usecase.Charge(ctx, staff, 2, tc.req)
+			assert.Equal(t, tc.expectedError, err)
+			assert.Equal(t, tc.amount, e.Amount)
+		})
+	}
+}
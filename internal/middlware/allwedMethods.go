@@ -1,60 +1,45 @@
 package middlware
 
 import (
-	"films_library/pkg/response"
 	"net/http"
 	"strings"
+
+	"films_library/pkg/response"
 )
 
-func AllowedMethod(next http.Handler) http.Handler {
-	allowedEndpoints := map[string]map[string]bool{
-		"/actor": {
-			"GET": true,
-		},
-		"/actor/add": {
-			"POST": true,
-		},
-		"/actor/update": {
-			"PUT": true,
-		},
-		"/actor/delete": {
-			"DELETE": true,
-		},
-		"/film/add": {
-			"POST": true,
-		},
-		"/film/update": {
-			"PUT": true,
-		},
-		"/film": {
-			"GET": true,
-		},
-		"/film/delete": {
-			"DELETE": true,
-		},
-		"/film/search": {
-			"GET": true,
-		},
-	}
+var routeMethods = []string{
+	http.MethodGet,
+	http.MethodPost,
+	http.MethodPut,
+	http.MethodPatch,
+	http.MethodDelete,
+}
 
+// AllowedMethod dispatches requests to the routes registered on mux and
+// answers with a JSON error when neither the path nor the method matches.
+func AllowedMethod(mux *http.ServeMux) http.Handler {
 	fn := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if strings.HasPrefix(r.URL.Path, "/swagger/") {
-			next.ServeHTTP(w, r)
+		if _, pattern := mux.Handler(r); pattern != "" {
+			mux.ServeHTTP(w, r)
 			return
 		}
 
-		allowedMethods, ok := allowedEndpoints[r.URL.Path]
-		if !ok {
-			response.ErrorResponse(w, http.StatusNotFound, "Not found", nil)
-			return
+		var allowed []string
+		for _, method := range routeMethods {
+			probe := r.WithContext(r.Context())
+			probe.Method = method
+			if _, pattern := mux.Handler(probe); pattern != "" {
+				allowed = append(allowed, method)
+			}
 		}
 
-		if !allowedMethods[r.Method] {
-			response.ErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed", nil)
+		if len(allowed) == 0 {
+			response.ErrorResponse(w, http.StatusNotFound, "Not found", nil)
 			return
 		}
 
-		next.ServeHTTP(w, r)
+		w.Header().Set("Allow", strings.Join(allowed, ", "))
+		response.ErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed", nil)
 	})
 
 	return http.HandlerFunc(fn)
@@ -0,0 +1,73 @@
+package middlware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"films_library/internal/auth"
+	authDelivery "films_library/internal/auth/delivery/http"
+	mock_auth "films_library/internal/auth/mocks"
+	"films_library/internal/model"
+	"films_library/pkg/logger"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthMiddleware_AuthRoutes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mock_auth.NewMockUsecase(ctrl)
+	mockLogger := logger.NewMockInterface(ctrl)
+
+	mux := http.NewServeMux()
+	authDelivery.NewAuthHandler(mux, mockUsecase, false, mockLogger)
+	policy := auth.NewPolicy(mux, authDelivery.AuthPermissions)
+
+	// The handlers are stubbed out: only the policy decision is under test.
+	var reached bool
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reached = true
+		w.WriteHeader(http.StatusNoContent)
+	})
+	handler := NewAuthMiddleware(mockUsecase, policy, false, mockLogger).Authentication(next)
+
+	user := model.User{ID: 7, Username: "neo", Role: model.RoleViewer}
+
+	testCases := []struct {
+		method, path string
+	}{
+		{http.MethodPost, "/auth/token"},
+		{http.MethodPost, "/auth/keys"},
+		{http.MethodGet, "/auth/keys"},
+		{http.MethodDelete, "/auth/keys/3"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.method+" "+tc.path, func(t *testing.T) {
+			mockUsecase.EXPECT().Authenticate(gomock.Any(), "session-token").Return(user, model.Session{}, nil)
+
+			reached = false
+			req := httptest.NewRequest(tc.method, tc.path, nil)
+			req.AddCookie(&http.Cookie{Name: auth.SessionCookie, Value: "session-token"})
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, http.StatusNoContent, rec.Code)
+			assert.True(t, reached)
+		})
+	}
+
+	t.Run("Without a session", func(t *testing.T) {
+		reached = false
+		rec := httptest.NewRecorder()
+
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/auth/token", nil))
+
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.False(t, reached)
+	})
+}
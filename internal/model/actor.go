@@ -1,23 +1,38 @@
 package model
 
-import "time"
+import (
+	"time"
+
+	"films_library/pkg/pagination"
+)
 
 type Actor struct {
 	ID        int    `json:"id"`
 	Name      string `json:"name" validate:"required"`
 	Sex       string `json:"sex" validate:"oneof=M W N"`
 	BirthDate string `json:"birth_date"`
+	// Headshot is set on actors read or exported only.
+	Headshot *ImageURLs `json:"headshot,omitempty"`
 }
 
 type ResponseActor struct {
-	ActorID   uint      `json:"actor_id"`
-	Name      string    `json:"name"`
-	Sex       string    `json:"sex"`
-	BirthDate time.Time `json:"birth_date"`
-	Films     []FilmObj `json:"film"`
+	ActorID     uint        `json:"actor_id"`
+	Name        string      `json:"name"`
+	Sex         string      `json:"sex"`
+	BirthDate   time.Time   `json:"birth_date"`
+	ExternalIDs ExternalIDs `json:"external_ids"`
+	Headshot    *ImageURLs  `json:"headshot,omitempty"`
+	Films       []FilmObj   `json:"film"`
+}
+
+type ActorFilter struct {
+	// IDs keeps the listed actors only. It is not read from the query.
+	IDs []uint
+	pagination.Params
 }
 
 type FilmObj struct {
 	Id    uint   `json:"film_id"`
 	Title string `json:"title"`
+	CastRole
 }
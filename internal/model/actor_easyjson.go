@@ -1,30 +1,558 @@
-// TEMPORARY AUTOGENERATED FILE: easyjson stub code to make the package
-// compilable during generation.
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
 
-package  model
+package model
 
 import (
-  "github.com/mailru/easyjson/jwriter"
-  "github.com/mailru/easyjson/jlexer"
+	json "encoding/json"
+	pagination "films_library/pkg/pagination"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
 )
 
-func ( Actor ) MarshalJSON() ([]byte, error) { return nil, nil }
-func (* Actor ) UnmarshalJSON([]byte) error { return nil }
-func ( Actor ) MarshalEasyJSON(w *jwriter.Writer) {}
-func (* Actor ) UnmarshalEasyJSON(l *jlexer.Lexer) {}
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson1a61c37dDecodeFilmsLibraryInternalModel(in *jlexer.Lexer, out *ResponseActor) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "actor_id":
+			out.ActorID = uint(in.Uint())
+		case "name":
+			out.Name = string(in.String())
+		case "sex":
+			out.Sex = string(in.String())
+		case "birth_date":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.BirthDate).UnmarshalJSON(data))
+			}
+		case "external_ids":
+			(out.ExternalIDs).UnmarshalEasyJSON(in)
+		case "headshot":
+			if in.IsNull() {
+				in.Skip()
+				out.Headshot = nil
+			} else {
+				if out.Headshot == nil {
+					out.Headshot = new(ImageURLs)
+				}
+				(*out.Headshot).UnmarshalEasyJSON(in)
+			}
+		case "film":
+			if in.IsNull() {
+				in.Skip()
+				out.Films = nil
+			} else {
+				in.Delim('[')
+				if out.Films == nil {
+					if !in.IsDelim(']') {
+						out.Films = make([]FilmObj, 0, 1)
+					} else {
+						out.Films = []FilmObj{}
+					}
+				} else {
+					out.Films = (out.Films)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 FilmObj
+					(v1).UnmarshalEasyJSON(in)
+					out.Films = append(out.Films, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson1a61c37dEncodeFilmsLibraryInternalModel(out *jwriter.Writer, in ResponseActor) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"actor_id\":"
+		out.RawString(prefix[1:])
+		out.Uint(uint(in.ActorID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"sex\":"
+		out.RawString(prefix)
+		out.String(string(in.Sex))
+	}
+	{
+		const prefix string = ",\"birth_date\":"
+		out.RawString(prefix)
+		out.Raw((in.BirthDate).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"external_ids\":"
+		out.RawString(prefix)
+		(in.ExternalIDs).MarshalEasyJSON(out)
+	}
+	if in.Headshot != nil {
+		const prefix string = ",\"headshot\":"
+		out.RawString(prefix)
+		(*in.Headshot).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"film\":"
+		out.RawString(prefix)
+		if in.Films == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Films {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				(v3).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponseActor) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson1a61c37dEncodeFilmsLibraryInternalModel(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponseActor) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson1a61c37dEncodeFilmsLibraryInternalModel(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponseActor) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson1a61c37dDecodeFilmsLibraryInternalModel(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponseActor) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson1a61c37dDecodeFilmsLibraryInternalModel(l, v)
+}
+func easyjson1a61c37dDecodeFilmsLibraryInternalModel1(in *jlexer.Lexer, out *FilmObj) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "film_id":
+			out.Id = uint(in.Uint())
+		case "title":
+			out.Title = string(in.String())
+		case "characters":
+			if in.IsNull() {
+				in.Skip()
+				out.Characters = nil
+			} else {
+				in.Delim('[')
+				if out.Characters == nil {
+					if !in.IsDelim(']') {
+						out.Characters = make([]string, 0, 4)
+					} else {
+						out.Characters = []string{}
+					}
+				} else {
+					out.Characters = (out.Characters)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 string
+					v4 = string(in.String())
+					out.Characters = append(out.Characters, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "billing":
+			if in.IsNull() {
+				in.Skip()
+				out.Billing = nil
+			} else {
+				if out.Billing == nil {
+					out.Billing = new(int)
+				}
+				*out.Billing = int(in.Int())
+			}
+		case "uncredited":
+			out.Uncredited = bool(in.Bool())
+		case "voice":
+			out.Voice = bool(in.Bool())
+		case "cameo":
+			out.Cameo = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson1a61c37dEncodeFilmsLibraryInternalModel1(out *jwriter.Writer, in FilmObj) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"film_id\":"
+		out.RawString(prefix[1:])
+		out.Uint(uint(in.Id))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"characters\":"
+		out.RawString(prefix)
+		if in.Characters == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v5, v6 := range in.Characters {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v6))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Billing != nil {
+		const prefix string = ",\"billing\":"
+		out.RawString(prefix)
+		out.Int(int(*in.Billing))
+	}
+	if in.Uncredited {
+		const prefix string = ",\"uncredited\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Uncredited))
+	}
+	if in.Voice {
+		const prefix string = ",\"voice\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Voice))
+	}
+	if in.Cameo {
+		const prefix string = ",\"cameo\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Cameo))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FilmObj) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson1a61c37dEncodeFilmsLibraryInternalModel1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FilmObj) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson1a61c37dEncodeFilmsLibraryInternalModel1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FilmObj) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson1a61c37dDecodeFilmsLibraryInternalModel1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FilmObj) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson1a61c37dDecodeFilmsLibraryInternalModel1(l, v)
+}
+func easyjson1a61c37dDecodeFilmsLibraryInternalModel2(in *jlexer.Lexer, out *ActorFilter) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "IDs":
+			if in.IsNull() {
+				in.Skip()
+				out.IDs = nil
+			} else {
+				in.Delim('[')
+				if out.IDs == nil {
+					if !in.IsDelim(']') {
+						out.IDs = make([]uint, 0, 8)
+					} else {
+						out.IDs = []uint{}
+					}
+				} else {
+					out.IDs = (out.IDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 uint
+					v7 = uint(in.Uint())
+					out.IDs = append(out.IDs, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Limit":
+			out.Limit = int(in.Int())
+		case "Offset":
+			out.Offset = int(in.Int())
+		case "Cursor":
+			if in.IsNull() {
+				in.Skip()
+				out.Cursor = nil
+			} else {
+				if out.Cursor == nil {
+					out.Cursor = new(pagination.Cursor)
+				}
+				(*out.Cursor).UnmarshalEasyJSON(in)
+			}
+		case "WithTotal":
+			out.WithTotal = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson1a61c37dEncodeFilmsLibraryInternalModel2(out *jwriter.Writer, in ActorFilter) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"IDs\":"
+		out.RawString(prefix[1:])
+		if in.IDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v8, v9 := range in.IDs {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				out.Uint(uint(v9))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Limit\":"
+		out.RawString(prefix)
+		out.Int(int(in.Limit))
+	}
+	{
+		const prefix string = ",\"Offset\":"
+		out.RawString(prefix)
+		out.Int(int(in.Offset))
+	}
+	{
+		const prefix string = ",\"Cursor\":"
+		out.RawString(prefix)
+		if in.Cursor == nil {
+			out.RawString("null")
+		} else {
+			(*in.Cursor).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"WithTotal\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.WithTotal))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ActorFilter) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson1a61c37dEncodeFilmsLibraryInternalModel2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ActorFilter) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson1a61c37dEncodeFilmsLibraryInternalModel2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ActorFilter) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson1a61c37dDecodeFilmsLibraryInternalModel2(&r, v)
+	return r.Error()
+}
 
-type EasyJSON_exporter_Actor *Actor
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ActorFilter) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson1a61c37dDecodeFilmsLibraryInternalModel2(l, v)
+}
+func easyjson1a61c37dDecodeFilmsLibraryInternalModel3(in *jlexer.Lexer, out *Actor) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "name":
+			out.Name = string(in.String())
+		case "sex":
+			out.Sex = string(in.String())
+		case "birth_date":
+			out.BirthDate = string(in.String())
+		case "headshot":
+			if in.IsNull() {
+				in.Skip()
+				out.Headshot = nil
+			} else {
+				if out.Headshot == nil {
+					out.Headshot = new(ImageURLs)
+				}
+				(*out.Headshot).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson1a61c37dEncodeFilmsLibraryInternalModel3(out *jwriter.Writer, in Actor) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"sex\":"
+		out.RawString(prefix)
+		out.String(string(in.Sex))
+	}
+	{
+		const prefix string = ",\"birth_date\":"
+		out.RawString(prefix)
+		out.String(string(in.BirthDate))
+	}
+	if in.Headshot != nil {
+		const prefix string = ",\"headshot\":"
+		out.RawString(prefix)
+		(*in.Headshot).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
 
-func ( FilmObj ) MarshalJSON() ([]byte, error) { return nil, nil }
-func (* FilmObj ) UnmarshalJSON([]byte) error { return nil }
-func ( FilmObj ) MarshalEasyJSON(w *jwriter.Writer) {}
-func (* FilmObj ) UnmarshalEasyJSON(l *jlexer.Lexer) {}
+// MarshalJSON supports json.Marshaler interface
+func (v Actor) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson1a61c37dEncodeFilmsLibraryInternalModel3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
 
-type EasyJSON_exporter_FilmObj *FilmObj
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Actor) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson1a61c37dEncodeFilmsLibraryInternalModel3(w, v)
+}
 
-func ( ResponseActor ) MarshalJSON() ([]byte, error) { return nil, nil }
-func (* ResponseActor ) UnmarshalJSON([]byte) error { return nil }
-func ( ResponseActor ) MarshalEasyJSON(w *jwriter.Writer) {}
-func (* ResponseActor ) UnmarshalEasyJSON(l *jlexer.Lexer) {}
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Actor) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson1a61c37dDecodeFilmsLibraryInternalModel3(&r, v)
+	return r.Error()
+}
 
-type EasyJSON_exporter_ResponseActor *ResponseActor
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Actor) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson1a61c37dDecodeFilmsLibraryInternalModel3(l, v)
+}
@@ -0,0 +1,60 @@
+package model
+
+import (
+	"time"
+
+	"films_library/pkg/pagination"
+)
+
+const (
+	VisibilityPublic  = "public"
+	VisibilityPrivate = "private"
+)
+
+// Collection is an ordered list of films curated by a user, such as a saga
+// or a set of picks. Private collections are seen by their owner only.
+type Collection struct {
+	ID          uint64    `json:"collection_id"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	OwnerID     uint64    `json:"owner_id"`
+	Owner       string    `json:"owner"`
+	Visibility  string    `json:"visibility"`
+	Films       int64     `json:"films"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// CollectionRequest is the editable part of a collection.
+type CollectionRequest struct {
+	Title       string `json:"title"       validate:"required,max=150"`
+	Description string `json:"description" validate:"max=1000"`
+	Visibility  string `json:"visibility"  validate:"required,oneof=public private"`
+}
+
+// CollectionFilm is a member of a collection. Collections are ordered by
+// Position, lowest first.
+type CollectionFilm struct {
+	Position int  `json:"position"`
+	Film     Film `json:"film"`
+}
+
+// ResponseCollection is a collection with its films in order.
+type ResponseCollection struct {
+	Collection
+	Members []CollectionFilm `json:"members"`
+}
+
+// CollectionObj is a collection as listed on the films it holds.
+type CollectionObj struct {
+	ID    uint64 `json:"collection_id"`
+	Title string `json:"title"`
+}
+
+// CollectionFilter lists the public collections and those of ViewerID,
+// narrowed to the collections of OwnerID when set.
+type CollectionFilter struct {
+	ViewerID uint64
+	OwnerID  uint64
+	pagination.Params
+}
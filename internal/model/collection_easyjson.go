@@ -0,0 +1,650 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package model
+
+import (
+	json "encoding/json"
+	pagination "films_library/pkg/pagination"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson31a05f68DecodeFilmsLibraryInternalModel(in *jlexer.Lexer, out *ResponseCollection) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "members":
+			if in.IsNull() {
+				in.Skip()
+				out.Members = nil
+			} else {
+				in.Delim('[')
+				if out.Members == nil {
+					if !in.IsDelim(']') {
+						out.Members = make([]CollectionFilm, 0, 0)
+					} else {
+						out.Members = []CollectionFilm{}
+					}
+				} else {
+					out.Members = (out.Members)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 CollectionFilm
+					(v1).UnmarshalEasyJSON(in)
+					out.Members = append(out.Members, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "collection_id":
+			out.ID = uint64(in.Uint64())
+		case "title":
+			out.Title = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "owner_id":
+			out.OwnerID = uint64(in.Uint64())
+		case "owner":
+			out.Owner = string(in.String())
+		case "visibility":
+			out.Visibility = string(in.String())
+		case "films":
+			out.Films = int64(in.Int64())
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson31a05f68EncodeFilmsLibraryInternalModel(out *jwriter.Writer, in ResponseCollection) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"members\":"
+		out.RawString(prefix[1:])
+		if in.Members == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Members {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				(v3).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"collection_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.ID))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"owner_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.OwnerID))
+	}
+	{
+		const prefix string = ",\"owner\":"
+		out.RawString(prefix)
+		out.String(string(in.Owner))
+	}
+	{
+		const prefix string = ",\"visibility\":"
+		out.RawString(prefix)
+		out.String(string(in.Visibility))
+	}
+	{
+		const prefix string = ",\"films\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Films))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponseCollection) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson31a05f68EncodeFilmsLibraryInternalModel(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponseCollection) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson31a05f68EncodeFilmsLibraryInternalModel(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponseCollection) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson31a05f68DecodeFilmsLibraryInternalModel(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponseCollection) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson31a05f68DecodeFilmsLibraryInternalModel(l, v)
+}
+func easyjson31a05f68DecodeFilmsLibraryInternalModel1(in *jlexer.Lexer, out *CollectionRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "title":
+			out.Title = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "visibility":
+			out.Visibility = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson31a05f68EncodeFilmsLibraryInternalModel1(out *jwriter.Writer, in CollectionRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"visibility\":"
+		out.RawString(prefix)
+		out.String(string(in.Visibility))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CollectionRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson31a05f68EncodeFilmsLibraryInternalModel1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CollectionRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson31a05f68EncodeFilmsLibraryInternalModel1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CollectionRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson31a05f68DecodeFilmsLibraryInternalModel1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CollectionRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson31a05f68DecodeFilmsLibraryInternalModel1(l, v)
+}
+func easyjson31a05f68DecodeFilmsLibraryInternalModel2(in *jlexer.Lexer, out *CollectionObj) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "collection_id":
+			out.ID = uint64(in.Uint64())
+		case "title":
+			out.Title = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson31a05f68EncodeFilmsLibraryInternalModel2(out *jwriter.Writer, in CollectionObj) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"collection_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.ID))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CollectionObj) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson31a05f68EncodeFilmsLibraryInternalModel2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CollectionObj) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson31a05f68EncodeFilmsLibraryInternalModel2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CollectionObj) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson31a05f68DecodeFilmsLibraryInternalModel2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CollectionObj) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson31a05f68DecodeFilmsLibraryInternalModel2(l, v)
+}
+func easyjson31a05f68DecodeFilmsLibraryInternalModel3(in *jlexer.Lexer, out *CollectionFilter) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "ViewerID":
+			out.ViewerID = uint64(in.Uint64())
+		case "OwnerID":
+			out.OwnerID = uint64(in.Uint64())
+		case "Limit":
+			out.Limit = int(in.Int())
+		case "Offset":
+			out.Offset = int(in.Int())
+		case "Cursor":
+			if in.IsNull() {
+				in.Skip()
+				out.Cursor = nil
+			} else {
+				if out.Cursor == nil {
+					out.Cursor = new(pagination.Cursor)
+				}
+				(*out.Cursor).UnmarshalEasyJSON(in)
+			}
+		case "WithTotal":
+			out.WithTotal = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson31a05f68EncodeFilmsLibraryInternalModel3(out *jwriter.Writer, in CollectionFilter) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"ViewerID\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.ViewerID))
+	}
+	{
+		const prefix string = ",\"OwnerID\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.OwnerID))
+	}
+	{
+		const prefix string = ",\"Limit\":"
+		out.RawString(prefix)
+		out.Int(int(in.Limit))
+	}
+	{
+		const prefix string = ",\"Offset\":"
+		out.RawString(prefix)
+		out.Int(int(in.Offset))
+	}
+	{
+		const prefix string = ",\"Cursor\":"
+		out.RawString(prefix)
+		if in.Cursor == nil {
+			out.RawString("null")
+		} else {
+			(*in.Cursor).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"WithTotal\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.WithTotal))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CollectionFilter) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson31a05f68EncodeFilmsLibraryInternalModel3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CollectionFilter) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson31a05f68EncodeFilmsLibraryInternalModel3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CollectionFilter) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson31a05f68DecodeFilmsLibraryInternalModel3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CollectionFilter) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson31a05f68DecodeFilmsLibraryInternalModel3(l, v)
+}
+func easyjson31a05f68DecodeFilmsLibraryInternalModel4(in *jlexer.Lexer, out *CollectionFilm) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "position":
+			out.Position = int(in.Int())
+		case "film":
+			(out.Film).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson31a05f68EncodeFilmsLibraryInternalModel4(out *jwriter.Writer, in CollectionFilm) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"position\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Position))
+	}
+	{
+		const prefix string = ",\"film\":"
+		out.RawString(prefix)
+		(in.Film).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CollectionFilm) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson31a05f68EncodeFilmsLibraryInternalModel4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CollectionFilm) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson31a05f68EncodeFilmsLibraryInternalModel4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CollectionFilm) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson31a05f68DecodeFilmsLibraryInternalModel4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CollectionFilm) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson31a05f68DecodeFilmsLibraryInternalModel4(l, v)
+}
+func easyjson31a05f68DecodeFilmsLibraryInternalModel5(in *jlexer.Lexer, out *Collection) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "collection_id":
+			out.ID = uint64(in.Uint64())
+		case "title":
+			out.Title = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "owner_id":
+			out.OwnerID = uint64(in.Uint64())
+		case "owner":
+			out.Owner = string(in.String())
+		case "visibility":
+			out.Visibility = string(in.String())
+		case "films":
+			out.Films = int64(in.Int64())
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson31a05f68EncodeFilmsLibraryInternalModel5(out *jwriter.Writer, in Collection) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"collection_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.ID))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"owner_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.OwnerID))
+	}
+	{
+		const prefix string = ",\"owner\":"
+		out.RawString(prefix)
+		out.String(string(in.Owner))
+	}
+	{
+		const prefix string = ",\"visibility\":"
+		out.RawString(prefix)
+		out.String(string(in.Visibility))
+	}
+	{
+		const prefix string = ",\"films\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Films))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Collection) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson31a05f68EncodeFilmsLibraryInternalModel5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Collection) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson31a05f68EncodeFilmsLibraryInternalModel5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Collection) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson31a05f68DecodeFilmsLibraryInternalModel5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Collection) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson31a05f68DecodeFilmsLibraryInternalModel5(l, v)
+}
@@ -0,0 +1,42 @@
+package model
+
+import "films_library/pkg/pagination"
+
+const (
+	CrewDirector        = "director"
+	CrewWriter          = "writer"
+	CrewComposer        = "composer"
+	CrewProducer        = "producer"
+	CrewCinematographer = "cinematographer"
+)
+
+// Person is someone credited on the crew of a film.
+type Person struct {
+	ID        uint64 `json:"person_id"`
+	Name      string `json:"name"       validate:"required,max=100"`
+	BirthDate string `json:"birth_date" validate:"omitempty,datetime=2006-01-02"`
+}
+
+type ResponsePerson struct {
+	Person
+	Credits []CrewCredit `json:"credits"`
+}
+
+// CrewCredit is one job of a person on a film.
+type CrewCredit struct {
+	FilmID uint64 `json:"film_id"`
+	Title  string `json:"title"`
+	Role   string `json:"role"`
+}
+
+// CrewObj is one member of the crew of a film.
+type CrewObj struct {
+	PersonID uint64 `json:"person_id"`
+	Name     string `json:"name"`
+	Role     string `json:"role"`
+}
+
+type CrewFilter struct {
+	Role string `validate:"omitempty,oneof=director writer composer producer cinematographer"`
+	pagination.Params
+}
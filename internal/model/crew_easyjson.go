@@ -0,0 +1,485 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package model
+
+import (
+	json "encoding/json"
+	pagination "films_library/pkg/pagination"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson15707de7DecodeFilmsLibraryInternalModel(in *jlexer.Lexer, out *ResponsePerson) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "credits":
+			if in.IsNull() {
+				in.Skip()
+				out.Credits = nil
+			} else {
+				in.Delim('[')
+				if out.Credits == nil {
+					if !in.IsDelim(']') {
+						out.Credits = make([]CrewCredit, 0, 1)
+					} else {
+						out.Credits = []CrewCredit{}
+					}
+				} else {
+					out.Credits = (out.Credits)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 CrewCredit
+					(v1).UnmarshalEasyJSON(in)
+					out.Credits = append(out.Credits, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "person_id":
+			out.ID = uint64(in.Uint64())
+		case "name":
+			out.Name = string(in.String())
+		case "birth_date":
+			out.BirthDate = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson15707de7EncodeFilmsLibraryInternalModel(out *jwriter.Writer, in ResponsePerson) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"credits\":"
+		out.RawString(prefix[1:])
+		if in.Credits == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Credits {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				(v3).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"person_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.ID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"birth_date\":"
+		out.RawString(prefix)
+		out.String(string(in.BirthDate))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponsePerson) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson15707de7EncodeFilmsLibraryInternalModel(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponsePerson) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson15707de7EncodeFilmsLibraryInternalModel(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponsePerson) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson15707de7DecodeFilmsLibraryInternalModel(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponsePerson) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson15707de7DecodeFilmsLibraryInternalModel(l, v)
+}
+func easyjson15707de7DecodeFilmsLibraryInternalModel1(in *jlexer.Lexer, out *Person) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "person_id":
+			out.ID = uint64(in.Uint64())
+		case "name":
+			out.Name = string(in.String())
+		case "birth_date":
+			out.BirthDate = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson15707de7EncodeFilmsLibraryInternalModel1(out *jwriter.Writer, in Person) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"person_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.ID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"birth_date\":"
+		out.RawString(prefix)
+		out.String(string(in.BirthDate))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Person) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson15707de7EncodeFilmsLibraryInternalModel1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Person) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson15707de7EncodeFilmsLibraryInternalModel1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Person) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson15707de7DecodeFilmsLibraryInternalModel1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Person) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson15707de7DecodeFilmsLibraryInternalModel1(l, v)
+}
+func easyjson15707de7DecodeFilmsLibraryInternalModel2(in *jlexer.Lexer, out *CrewObj) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "person_id":
+			out.PersonID = uint64(in.Uint64())
+		case "name":
+			out.Name = string(in.String())
+		case "role":
+			out.Role = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson15707de7EncodeFilmsLibraryInternalModel2(out *jwriter.Writer, in CrewObj) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"person_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.PersonID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"role\":"
+		out.RawString(prefix)
+		out.String(string(in.Role))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CrewObj) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson15707de7EncodeFilmsLibraryInternalModel2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CrewObj) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson15707de7EncodeFilmsLibraryInternalModel2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CrewObj) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson15707de7DecodeFilmsLibraryInternalModel2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CrewObj) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson15707de7DecodeFilmsLibraryInternalModel2(l, v)
+}
+func easyjson15707de7DecodeFilmsLibraryInternalModel3(in *jlexer.Lexer, out *CrewFilter) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Role":
+			out.Role = string(in.String())
+		case "Limit":
+			out.Limit = int(in.Int())
+		case "Offset":
+			out.Offset = int(in.Int())
+		case "Cursor":
+			if in.IsNull() {
+				in.Skip()
+				out.Cursor = nil
+			} else {
+				if out.Cursor == nil {
+					out.Cursor = new(pagination.Cursor)
+				}
+				(*out.Cursor).UnmarshalEasyJSON(in)
+			}
+		case "WithTotal":
+			out.WithTotal = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson15707de7EncodeFilmsLibraryInternalModel3(out *jwriter.Writer, in CrewFilter) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Role\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Role))
+	}
+	{
+		const prefix string = ",\"Limit\":"
+		out.RawString(prefix)
+		out.Int(int(in.Limit))
+	}
+	{
+		const prefix string = ",\"Offset\":"
+		out.RawString(prefix)
+		out.Int(int(in.Offset))
+	}
+	{
+		const prefix string = ",\"Cursor\":"
+		out.RawString(prefix)
+		if in.Cursor == nil {
+			out.RawString("null")
+		} else {
+			(*in.Cursor).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"WithTotal\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.WithTotal))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CrewFilter) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson15707de7EncodeFilmsLibraryInternalModel3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CrewFilter) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson15707de7EncodeFilmsLibraryInternalModel3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CrewFilter) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson15707de7DecodeFilmsLibraryInternalModel3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CrewFilter) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson15707de7DecodeFilmsLibraryInternalModel3(l, v)
+}
+func easyjson15707de7DecodeFilmsLibraryInternalModel4(in *jlexer.Lexer, out *CrewCredit) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "film_id":
+			out.FilmID = uint64(in.Uint64())
+		case "title":
+			out.Title = string(in.String())
+		case "role":
+			out.Role = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson15707de7EncodeFilmsLibraryInternalModel4(out *jwriter.Writer, in CrewCredit) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"film_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.FilmID))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"role\":"
+		out.RawString(prefix)
+		out.String(string(in.Role))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CrewCredit) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson15707de7EncodeFilmsLibraryInternalModel4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CrewCredit) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson15707de7EncodeFilmsLibraryInternalModel4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CrewCredit) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson15707de7DecodeFilmsLibraryInternalModel4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CrewCredit) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson15707de7DecodeFilmsLibraryInternalModel4(l, v)
+}
@@ -7,3 +7,35 @@ type ErrNotFound struct {
 func (e *ErrNotFound) Error() string {
 	return e.Message
 }
+
+type ErrConflict struct {
+	Message string
+}
+
+func (e *ErrConflict) Error() string {
+	return e.Message
+}
+
+type ErrUnauthorized struct {
+	Message string
+}
+
+func (e *ErrUnauthorized) Error() string {
+	return e.Message
+}
+
+type ErrForbidden struct {
+	Message string
+}
+
+func (e *ErrForbidden) Error() string {
+	return e.Message
+}
+
+type ErrBadRequest struct {
+	Message string
+}
+
+func (e *ErrBadRequest) Error() string {
+	return e.Message
+}
@@ -0,0 +1,35 @@
+package model
+
+import "time"
+
+// Catalogues whose IDs are kept on films and actors.
+const (
+	ProviderIMDb     = "imdb"
+	ProviderTMDb     = "tmdb"
+	ProviderWikidata = "wikidata"
+)
+
+// Entities that carry external IDs.
+const (
+	EntityFilm  = "film"
+	EntityActor = "actor"
+)
+
+// ExternalID is the ID of a film or actor in another catalogue. A film or
+// actor has at most one ID per provider, and no two share one.
+type ExternalID struct {
+	Provider  string    `json:"provider"`
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ExternalIDs are the IDs of a film or actor with each provider.
+type ExternalIDs struct {
+	IMDb     string `json:"imdb,omitempty"`
+	TMDb     string `json:"tmdb,omitempty"`
+	Wikidata string `json:"wikidata,omitempty"`
+}
+
+type ExternalIDRequest struct {
+	ID string `json:"id" validate:"required,max=32"`
+}
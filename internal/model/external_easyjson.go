@@ -0,0 +1,258 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package model
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson465fe315DecodeFilmsLibraryInternalModel(in *jlexer.Lexer, out *ExternalIDs) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "imdb":
+			out.IMDb = string(in.String())
+		case "tmdb":
+			out.TMDb = string(in.String())
+		case "wikidata":
+			out.Wikidata = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson465fe315EncodeFilmsLibraryInternalModel(out *jwriter.Writer, in ExternalIDs) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.IMDb != "" {
+		const prefix string = ",\"imdb\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.IMDb))
+	}
+	if in.TMDb != "" {
+		const prefix string = ",\"tmdb\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.TMDb))
+	}
+	if in.Wikidata != "" {
+		const prefix string = ",\"wikidata\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Wikidata))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ExternalIDs) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson465fe315EncodeFilmsLibraryInternalModel(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ExternalIDs) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson465fe315EncodeFilmsLibraryInternalModel(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ExternalIDs) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson465fe315DecodeFilmsLibraryInternalModel(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ExternalIDs) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson465fe315DecodeFilmsLibraryInternalModel(l, v)
+}
+func easyjson465fe315DecodeFilmsLibraryInternalModel1(in *jlexer.Lexer, out *ExternalIDRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson465fe315EncodeFilmsLibraryInternalModel1(out *jwriter.Writer, in ExternalIDRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ExternalIDRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson465fe315EncodeFilmsLibraryInternalModel1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ExternalIDRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson465fe315EncodeFilmsLibraryInternalModel1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ExternalIDRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson465fe315DecodeFilmsLibraryInternalModel1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ExternalIDRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson465fe315DecodeFilmsLibraryInternalModel1(l, v)
+}
+func easyjson465fe315DecodeFilmsLibraryInternalModel2(in *jlexer.Lexer, out *ExternalID) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "provider":
+			out.Provider = string(in.String())
+		case "id":
+			out.ID = string(in.String())
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson465fe315EncodeFilmsLibraryInternalModel2(out *jwriter.Writer, in ExternalID) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"provider\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Provider))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ExternalID) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson465fe315EncodeFilmsLibraryInternalModel2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ExternalID) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson465fe315EncodeFilmsLibraryInternalModel2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ExternalID) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson465fe315DecodeFilmsLibraryInternalModel2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ExternalID) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson465fe315DecodeFilmsLibraryInternalModel2(l, v)
+}
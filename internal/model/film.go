@@ -1,6 +1,12 @@
 package model
 
-import "time"
+import (
+	"time"
+
+	"films_library/pkg/pagination"
+
+	"github.com/go-playground/validator/v10"
+)
 
 type Film struct {
 	ID          uint64    `json:"film_id" validate:"required"`
@@ -8,6 +14,23 @@ type Film struct {
 	Description string    `json:"description" validate:"max=1000"`
 	ReleaseDate time.Time `json:"release_date"`
 	Rating      int       `json:"rating" validate:"min=-1,max=10"`
+	// CommunityScore is the Bayesian average of the RatingCount user ratings.
+	// Rating is the editorial rating.
+	CommunityScore float64 `json:"community_score"`
+	RatingCount    int64   `json:"rating_count"`
+	RatingSum      int64   `json:"-"`
+	// Availability, ExternalIDs and Poster are set on film listings and
+	// details only. Poster is nil for films without one.
+	Availability *Availability `json:"availability,omitempty"`
+	ExternalIDs  *ExternalIDs  `json:"external_ids,omitempty"`
+	Poster       *ImageURLs    `json:"poster,omitempty"`
+}
+
+// ExportFilm is a film as exported, with the IDs of its actors in billing
+// order.
+type ExportFilm struct {
+	Film
+	Actors []uint `json:"actors"`
 }
 
 type AddFilmRequest struct {
@@ -18,15 +41,84 @@ type AddFilmRequest struct {
 	Actors      []uint    `json:"actors"`
 }
 
+type UpdateFilmRequest struct {
+	Film
+	Actors []uint `json:"actors"`
+}
+
 type FilmFilter struct {
-	SortBy    string `validate:"oneof=rating release_date title"`
-	SortOrder string `validate:"oneof=asc desc"`
+	SortBy         string     `validate:"oneof=rating release_date title"`
+	SortOrder      string     `validate:"oneof=asc desc"`
+	MinRating      *int       `validate:"omitempty,min=0,max=10"`
+	MaxRating      *int       `validate:"omitempty,min=0,max=10"`
+	ReleasedAfter  *time.Time `validate:"omitempty"`
+	ReleasedBefore *time.Time `validate:"omitempty"`
+	ActorIDs       []uint     `validate:"max=20,dive,min=1"`
+	TitlePrefix    string     `validate:"max=150"`
+	CrewIDs        []uint64   `validate:"max=20,dive,min=1"`
+	CrewRole       string     `validate:"omitempty,oneof=director writer composer producer cinematographer"`
+
+	// GenreIDs and TagIDs keep films classified under any (the default) or
+	// all of them, as set by GenreMatch and TagMatch. Films under any of the
+	// excluded IDs are dropped.
+	GenreIDs        []uint64 `validate:"max=20,dive,min=1"`
+	GenreMatch      string   `validate:"omitempty,oneof=any all"`
+	ExcludeGenreIDs []uint64 `validate:"max=20,dive,min=1"`
+	TagIDs          []uint64 `validate:"max=20,dive,min=1"`
+	TagMatch        string   `validate:"omitempty,oneof=any all"`
+	ExcludeTagIDs   []uint64 `validate:"max=20,dive,min=1"`
+
+	// IDs keeps the listed films only. It is not read from the query.
+	IDs []uint64
+	pagination.Params
 }
 
-// type ResponseFilm struct {
-// 	MovieID     int       `json:"film_id"`
-// 	Title       string    `json:"title"`
-// 	Description string    `json:"description"`
-// 	ReleaseDate time.Time `json:"release_date"`
-// 	Rating      int       `json:"rating"`
-// }
+// ValidateFilmFilter checks that the rating and release date ranges are not
+// inverted and that a crew role comes with crew IDs. Register it with
+// validator.RegisterStructValidation.
+func ValidateFilmFilter(sl validator.StructLevel) {
+	filter := sl.Current().Interface().(FilmFilter)
+
+	if filter.MinRating != nil && filter.MaxRating != nil && *filter.MinRating > *filter.MaxRating {
+		sl.ReportError(filter.MaxRating, "MaxRating", "MaxRating", "gtefield", "MinRating")
+	}
+
+	if filter.ReleasedAfter != nil && filter.ReleasedBefore != nil && filter.ReleasedBefore.Before(*filter.ReleasedAfter) {
+		sl.ReportError(filter.ReleasedBefore, "ReleasedBefore", "ReleasedBefore", "gtefield", "ReleasedAfter")
+	}
+
+	if filter.CrewRole != "" && len(filter.CrewIDs) == 0 {
+		sl.ReportError(filter.CrewRole, "CrewRole", "CrewRole", "required_with", "CrewIDs")
+	}
+}
+
+type SearchFilter struct {
+	Search string
+	pagination.Params
+}
+
+type ResponseFilm struct {
+	Film
+	Actors []ActorObj `json:"actors"`
+	Crew   []CrewObj  `json:"crew"`
+	Genres []Term     `json:"genres"`
+	Tags   []Term     `json:"tags"`
+
+	Collections []CollectionObj `json:"collections"`
+}
+
+type ActorObj struct {
+	Id   uint   `json:"actor_id"`
+	Name string `json:"name"`
+	CastRole
+}
+
+// CastRole is how an actor appears in a film. Billing is the position in the
+// credits; cast lists are sorted by it, with unbilled actors last.
+type CastRole struct {
+	Characters []string `json:"characters"           validate:"max=10,dive,required,max=200"`
+	Billing    *int     `json:"billing,omitempty"    validate:"omitempty,min=1,max=1000"`
+	Uncredited bool     `json:"uncredited,omitempty"`
+	Voice      bool     `json:"voice,omitempty"`
+	Cameo      bool     `json:"cameo,omitempty"`
+}
@@ -4,9 +4,11 @@ package model
 
 import (
 	json "encoding/json"
+	pagination "films_library/pkg/pagination"
 	easyjson "github.com/mailru/easyjson"
 	jlexer "github.com/mailru/easyjson/jlexer"
 	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
 )
 
 // suppress unused package warning
@@ -17,7 +19,720 @@ var (
 	_ easyjson.Marshaler
 )
 
-func easyjson14b8084aDecodeFilmsLibraryInternalModel(in *jlexer.Lexer, out *FilmFilter) {
+func easyjson14b8084aDecodeFilmsLibraryInternalModel(in *jlexer.Lexer, out *UpdateFilmRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "actors":
+			if in.IsNull() {
+				in.Skip()
+				out.Actors = nil
+			} else {
+				in.Delim('[')
+				if out.Actors == nil {
+					if !in.IsDelim(']') {
+						out.Actors = make([]uint, 0, 8)
+					} else {
+						out.Actors = []uint{}
+					}
+				} else {
+					out.Actors = (out.Actors)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 uint
+					v1 = uint(in.Uint())
+					out.Actors = append(out.Actors, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "film_id":
+			out.ID = uint64(in.Uint64())
+		case "title":
+			out.Title = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "release_date":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ReleaseDate).UnmarshalJSON(data))
+			}
+		case "rating":
+			out.Rating = int(in.Int())
+		case "community_score":
+			out.CommunityScore = float64(in.Float64())
+		case "rating_count":
+			out.RatingCount = int64(in.Int64())
+		case "availability":
+			if in.IsNull() {
+				in.Skip()
+				out.Availability = nil
+			} else {
+				if out.Availability == nil {
+					out.Availability = new(Availability)
+				}
+				(*out.Availability).UnmarshalEasyJSON(in)
+			}
+		case "external_ids":
+			if in.IsNull() {
+				in.Skip()
+				out.ExternalIDs = nil
+			} else {
+				if out.ExternalIDs == nil {
+					out.ExternalIDs = new(ExternalIDs)
+				}
+				(*out.ExternalIDs).UnmarshalEasyJSON(in)
+			}
+		case "poster":
+			if in.IsNull() {
+				in.Skip()
+				out.Poster = nil
+			} else {
+				if out.Poster == nil {
+					out.Poster = new(ImageURLs)
+				}
+				easyjson14b8084aDecodeFilmsLibraryInternalModel1(in, out.Poster)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson14b8084aEncodeFilmsLibraryInternalModel(out *jwriter.Writer, in UpdateFilmRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"actors\":"
+		out.RawString(prefix[1:])
+		if in.Actors == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Actors {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				out.Uint(uint(v3))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"film_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.ID))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"release_date\":"
+		out.RawString(prefix)
+		out.Raw((in.ReleaseDate).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"rating\":"
+		out.RawString(prefix)
+		out.Int(int(in.Rating))
+	}
+	{
+		const prefix string = ",\"community_score\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.CommunityScore))
+	}
+	{
+		const prefix string = ",\"rating_count\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.RatingCount))
+	}
+	if in.Availability != nil {
+		const prefix string = ",\"availability\":"
+		out.RawString(prefix)
+		(*in.Availability).MarshalEasyJSON(out)
+	}
+	if in.ExternalIDs != nil {
+		const prefix string = ",\"external_ids\":"
+		out.RawString(prefix)
+		(*in.ExternalIDs).MarshalEasyJSON(out)
+	}
+	if in.Poster != nil {
+		const prefix string = ",\"poster\":"
+		out.RawString(prefix)
+		easyjson14b8084aEncodeFilmsLibraryInternalModel1(out, *in.Poster)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v UpdateFilmRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson14b8084aEncodeFilmsLibraryInternalModel(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UpdateFilmRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson14b8084aEncodeFilmsLibraryInternalModel(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *UpdateFilmRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson14b8084aDecodeFilmsLibraryInternalModel(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UpdateFilmRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson14b8084aDecodeFilmsLibraryInternalModel(l, v)
+}
+func easyjson14b8084aDecodeFilmsLibraryInternalModel1(in *jlexer.Lexer, out *ImageURLs) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "original":
+			out.Original = string(in.String())
+		case "w92":
+			out.W92 = string(in.String())
+		case "w185":
+			out.W185 = string(in.String())
+		case "w500":
+			out.W500 = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson14b8084aEncodeFilmsLibraryInternalModel1(out *jwriter.Writer, in ImageURLs) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"original\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Original))
+	}
+	{
+		const prefix string = ",\"w92\":"
+		out.RawString(prefix)
+		out.String(string(in.W92))
+	}
+	{
+		const prefix string = ",\"w185\":"
+		out.RawString(prefix)
+		out.String(string(in.W185))
+	}
+	{
+		const prefix string = ",\"w500\":"
+		out.RawString(prefix)
+		out.String(string(in.W500))
+	}
+	out.RawByte('}')
+}
+func easyjson14b8084aDecodeFilmsLibraryInternalModel2(in *jlexer.Lexer, out *SearchFilter) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Search":
+			out.Search = string(in.String())
+		case "Limit":
+			out.Limit = int(in.Int())
+		case "Offset":
+			out.Offset = int(in.Int())
+		case "Cursor":
+			if in.IsNull() {
+				in.Skip()
+				out.Cursor = nil
+			} else {
+				if out.Cursor == nil {
+					out.Cursor = new(pagination.Cursor)
+				}
+				(*out.Cursor).UnmarshalEasyJSON(in)
+			}
+		case "WithTotal":
+			out.WithTotal = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson14b8084aEncodeFilmsLibraryInternalModel2(out *jwriter.Writer, in SearchFilter) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Search\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Search))
+	}
+	{
+		const prefix string = ",\"Limit\":"
+		out.RawString(prefix)
+		out.Int(int(in.Limit))
+	}
+	{
+		const prefix string = ",\"Offset\":"
+		out.RawString(prefix)
+		out.Int(int(in.Offset))
+	}
+	{
+		const prefix string = ",\"Cursor\":"
+		out.RawString(prefix)
+		if in.Cursor == nil {
+			out.RawString("null")
+		} else {
+			(*in.Cursor).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"WithTotal\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.WithTotal))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SearchFilter) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson14b8084aEncodeFilmsLibraryInternalModel2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SearchFilter) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson14b8084aEncodeFilmsLibraryInternalModel2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SearchFilter) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson14b8084aDecodeFilmsLibraryInternalModel2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SearchFilter) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson14b8084aDecodeFilmsLibraryInternalModel2(l, v)
+}
+func easyjson14b8084aDecodeFilmsLibraryInternalModel3(in *jlexer.Lexer, out *ResponseFilm) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "actors":
+			if in.IsNull() {
+				in.Skip()
+				out.Actors = nil
+			} else {
+				in.Delim('[')
+				if out.Actors == nil {
+					if !in.IsDelim(']') {
+						out.Actors = make([]ActorObj, 0, 1)
+					} else {
+						out.Actors = []ActorObj{}
+					}
+				} else {
+					out.Actors = (out.Actors)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 ActorObj
+					(v4).UnmarshalEasyJSON(in)
+					out.Actors = append(out.Actors, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "crew":
+			if in.IsNull() {
+				in.Skip()
+				out.Crew = nil
+			} else {
+				in.Delim('[')
+				if out.Crew == nil {
+					if !in.IsDelim(']') {
+						out.Crew = make([]CrewObj, 0, 1)
+					} else {
+						out.Crew = []CrewObj{}
+					}
+				} else {
+					out.Crew = (out.Crew)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v5 CrewObj
+					(v5).UnmarshalEasyJSON(in)
+					out.Crew = append(out.Crew, v5)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "genres":
+			if in.IsNull() {
+				in.Skip()
+				out.Genres = nil
+			} else {
+				in.Delim('[')
+				if out.Genres == nil {
+					if !in.IsDelim(']') {
+						out.Genres = make([]Term, 0, 2)
+					} else {
+						out.Genres = []Term{}
+					}
+				} else {
+					out.Genres = (out.Genres)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v6 Term
+					(v6).UnmarshalEasyJSON(in)
+					out.Genres = append(out.Genres, v6)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "tags":
+			if in.IsNull() {
+				in.Skip()
+				out.Tags = nil
+			} else {
+				in.Delim('[')
+				if out.Tags == nil {
+					if !in.IsDelim(']') {
+						out.Tags = make([]Term, 0, 2)
+					} else {
+						out.Tags = []Term{}
+					}
+				} else {
+					out.Tags = (out.Tags)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 Term
+					(v7).UnmarshalEasyJSON(in)
+					out.Tags = append(out.Tags, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "collections":
+			if in.IsNull() {
+				in.Skip()
+				out.Collections = nil
+			} else {
+				in.Delim('[')
+				if out.Collections == nil {
+					if !in.IsDelim(']') {
+						out.Collections = make([]CollectionObj, 0, 2)
+					} else {
+						out.Collections = []CollectionObj{}
+					}
+				} else {
+					out.Collections = (out.Collections)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v8 CollectionObj
+					(v8).UnmarshalEasyJSON(in)
+					out.Collections = append(out.Collections, v8)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "film_id":
+			out.ID = uint64(in.Uint64())
+		case "title":
+			out.Title = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "release_date":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ReleaseDate).UnmarshalJSON(data))
+			}
+		case "rating":
+			out.Rating = int(in.Int())
+		case "community_score":
+			out.CommunityScore = float64(in.Float64())
+		case "rating_count":
+			out.RatingCount = int64(in.Int64())
+		case "availability":
+			if in.IsNull() {
+				in.Skip()
+				out.Availability = nil
+			} else {
+				if out.Availability == nil {
+					out.Availability = new(Availability)
+				}
+				(*out.Availability).UnmarshalEasyJSON(in)
+			}
+		case "external_ids":
+			if in.IsNull() {
+				in.Skip()
+				out.ExternalIDs = nil
+			} else {
+				if out.ExternalIDs == nil {
+					out.ExternalIDs = new(ExternalIDs)
+				}
+				(*out.ExternalIDs).UnmarshalEasyJSON(in)
+			}
+		case "poster":
+			if in.IsNull() {
+				in.Skip()
+				out.Poster = nil
+			} else {
+				if out.Poster == nil {
+					out.Poster = new(ImageURLs)
+				}
+				easyjson14b8084aDecodeFilmsLibraryInternalModel1(in, out.Poster)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson14b8084aEncodeFilmsLibraryInternalModel3(out *jwriter.Writer, in ResponseFilm) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"actors\":"
+		out.RawString(prefix[1:])
+		if in.Actors == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v9, v10 := range in.Actors {
+				if v9 > 0 {
+					out.RawByte(',')
+				}
+				(v10).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"crew\":"
+		out.RawString(prefix)
+		if in.Crew == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v11, v12 := range in.Crew {
+				if v11 > 0 {
+					out.RawByte(',')
+				}
+				(v12).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"genres\":"
+		out.RawString(prefix)
+		if in.Genres == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v13, v14 := range in.Genres {
+				if v13 > 0 {
+					out.RawByte(',')
+				}
+				(v14).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"tags\":"
+		out.RawString(prefix)
+		if in.Tags == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v15, v16 := range in.Tags {
+				if v15 > 0 {
+					out.RawByte(',')
+				}
+				(v16).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"collections\":"
+		out.RawString(prefix)
+		if in.Collections == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v17, v18 := range in.Collections {
+				if v17 > 0 {
+					out.RawByte(',')
+				}
+				(v18).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"film_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.ID))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"release_date\":"
+		out.RawString(prefix)
+		out.Raw((in.ReleaseDate).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"rating\":"
+		out.RawString(prefix)
+		out.Int(int(in.Rating))
+	}
+	{
+		const prefix string = ",\"community_score\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.CommunityScore))
+	}
+	{
+		const prefix string = ",\"rating_count\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.RatingCount))
+	}
+	if in.Availability != nil {
+		const prefix string = ",\"availability\":"
+		out.RawString(prefix)
+		(*in.Availability).MarshalEasyJSON(out)
+	}
+	if in.ExternalIDs != nil {
+		const prefix string = ",\"external_ids\":"
+		out.RawString(prefix)
+		(*in.ExternalIDs).MarshalEasyJSON(out)
+	}
+	if in.Poster != nil {
+		const prefix string = ",\"poster\":"
+		out.RawString(prefix)
+		easyjson14b8084aEncodeFilmsLibraryInternalModel1(out, *in.Poster)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponseFilm) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson14b8084aEncodeFilmsLibraryInternalModel3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponseFilm) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson14b8084aEncodeFilmsLibraryInternalModel3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponseFilm) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson14b8084aDecodeFilmsLibraryInternalModel3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponseFilm) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson14b8084aDecodeFilmsLibraryInternalModel3(l, v)
+}
+func easyjson14b8084aDecodeFilmsLibraryInternalModel4(in *jlexer.Lexer, out *FilmFilter) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -40,6 +755,720 @@ func easyjson14b8084aDecodeFilmsLibraryInternalModel(in *jlexer.Lexer, out *Film
 			out.SortBy = string(in.String())
 		case "SortOrder":
 			out.SortOrder = string(in.String())
+		case "MinRating":
+			if in.IsNull() {
+				in.Skip()
+				out.MinRating = nil
+			} else {
+				if out.MinRating == nil {
+					out.MinRating = new(int)
+				}
+				*out.MinRating = int(in.Int())
+			}
+		case "MaxRating":
+			if in.IsNull() {
+				in.Skip()
+				out.MaxRating = nil
+			} else {
+				if out.MaxRating == nil {
+					out.MaxRating = new(int)
+				}
+				*out.MaxRating = int(in.Int())
+			}
+		case "ReleasedAfter":
+			if in.IsNull() {
+				in.Skip()
+				out.ReleasedAfter = nil
+			} else {
+				if out.ReleasedAfter == nil {
+					out.ReleasedAfter = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ReleasedAfter).UnmarshalJSON(data))
+				}
+			}
+		case "ReleasedBefore":
+			if in.IsNull() {
+				in.Skip()
+				out.ReleasedBefore = nil
+			} else {
+				if out.ReleasedBefore == nil {
+					out.ReleasedBefore = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ReleasedBefore).UnmarshalJSON(data))
+				}
+			}
+		case "ActorIDs":
+			if in.IsNull() {
+				in.Skip()
+				out.ActorIDs = nil
+			} else {
+				in.Delim('[')
+				if out.ActorIDs == nil {
+					if !in.IsDelim(']') {
+						out.ActorIDs = make([]uint, 0, 8)
+					} else {
+						out.ActorIDs = []uint{}
+					}
+				} else {
+					out.ActorIDs = (out.ActorIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v19 uint
+					v19 = uint(in.Uint())
+					out.ActorIDs = append(out.ActorIDs, v19)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "TitlePrefix":
+			out.TitlePrefix = string(in.String())
+		case "CrewIDs":
+			if in.IsNull() {
+				in.Skip()
+				out.CrewIDs = nil
+			} else {
+				in.Delim('[')
+				if out.CrewIDs == nil {
+					if !in.IsDelim(']') {
+						out.CrewIDs = make([]uint64, 0, 8)
+					} else {
+						out.CrewIDs = []uint64{}
+					}
+				} else {
+					out.CrewIDs = (out.CrewIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v20 uint64
+					v20 = uint64(in.Uint64())
+					out.CrewIDs = append(out.CrewIDs, v20)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "CrewRole":
+			out.CrewRole = string(in.String())
+		case "GenreIDs":
+			if in.IsNull() {
+				in.Skip()
+				out.GenreIDs = nil
+			} else {
+				in.Delim('[')
+				if out.GenreIDs == nil {
+					if !in.IsDelim(']') {
+						out.GenreIDs = make([]uint64, 0, 8)
+					} else {
+						out.GenreIDs = []uint64{}
+					}
+				} else {
+					out.GenreIDs = (out.GenreIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v21 uint64
+					v21 = uint64(in.Uint64())
+					out.GenreIDs = append(out.GenreIDs, v21)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "GenreMatch":
+			out.GenreMatch = string(in.String())
+		case "ExcludeGenreIDs":
+			if in.IsNull() {
+				in.Skip()
+				out.ExcludeGenreIDs = nil
+			} else {
+				in.Delim('[')
+				if out.ExcludeGenreIDs == nil {
+					if !in.IsDelim(']') {
+						out.ExcludeGenreIDs = make([]uint64, 0, 8)
+					} else {
+						out.ExcludeGenreIDs = []uint64{}
+					}
+				} else {
+					out.ExcludeGenreIDs = (out.ExcludeGenreIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v22 uint64
+					v22 = uint64(in.Uint64())
+					out.ExcludeGenreIDs = append(out.ExcludeGenreIDs, v22)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "TagIDs":
+			if in.IsNull() {
+				in.Skip()
+				out.TagIDs = nil
+			} else {
+				in.Delim('[')
+				if out.TagIDs == nil {
+					if !in.IsDelim(']') {
+						out.TagIDs = make([]uint64, 0, 8)
+					} else {
+						out.TagIDs = []uint64{}
+					}
+				} else {
+					out.TagIDs = (out.TagIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v23 uint64
+					v23 = uint64(in.Uint64())
+					out.TagIDs = append(out.TagIDs, v23)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "TagMatch":
+			out.TagMatch = string(in.String())
+		case "ExcludeTagIDs":
+			if in.IsNull() {
+				in.Skip()
+				out.ExcludeTagIDs = nil
+			} else {
+				in.Delim('[')
+				if out.ExcludeTagIDs == nil {
+					if !in.IsDelim(']') {
+						out.ExcludeTagIDs = make([]uint64, 0, 8)
+					} else {
+						out.ExcludeTagIDs = []uint64{}
+					}
+				} else {
+					out.ExcludeTagIDs = (out.ExcludeTagIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v24 uint64
+					v24 = uint64(in.Uint64())
+					out.ExcludeTagIDs = append(out.ExcludeTagIDs, v24)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "IDs":
+			if in.IsNull() {
+				in.Skip()
+				out.IDs = nil
+			} else {
+				in.Delim('[')
+				if out.IDs == nil {
+					if !in.IsDelim(']') {
+						out.IDs = make([]uint64, 0, 8)
+					} else {
+						out.IDs = []uint64{}
+					}
+				} else {
+					out.IDs = (out.IDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v25 uint64
+					v25 = uint64(in.Uint64())
+					out.IDs = append(out.IDs, v25)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Limit":
+			out.Limit = int(in.Int())
+		case "Offset":
+			out.Offset = int(in.Int())
+		case "Cursor":
+			if in.IsNull() {
+				in.Skip()
+				out.Cursor = nil
+			} else {
+				if out.Cursor == nil {
+					out.Cursor = new(pagination.Cursor)
+				}
+				(*out.Cursor).UnmarshalEasyJSON(in)
+			}
+		case "WithTotal":
+			out.WithTotal = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson14b8084aEncodeFilmsLibraryInternalModel4(out *jwriter.Writer, in FilmFilter) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"SortBy\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.SortBy))
+	}
+	{
+		const prefix string = ",\"SortOrder\":"
+		out.RawString(prefix)
+		out.String(string(in.SortOrder))
+	}
+	{
+		const prefix string = ",\"MinRating\":"
+		out.RawString(prefix)
+		if in.MinRating == nil {
+			out.RawString("null")
+		} else {
+			out.Int(int(*in.MinRating))
+		}
+	}
+	{
+		const prefix string = ",\"MaxRating\":"
+		out.RawString(prefix)
+		if in.MaxRating == nil {
+			out.RawString("null")
+		} else {
+			out.Int(int(*in.MaxRating))
+		}
+	}
+	{
+		const prefix string = ",\"ReleasedAfter\":"
+		out.RawString(prefix)
+		if in.ReleasedAfter == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.ReleasedAfter).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"ReleasedBefore\":"
+		out.RawString(prefix)
+		if in.ReleasedBefore == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.ReleasedBefore).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"ActorIDs\":"
+		out.RawString(prefix)
+		if in.ActorIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v26, v27 := range in.ActorIDs {
+				if v26 > 0 {
+					out.RawByte(',')
+				}
+				out.Uint(uint(v27))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"TitlePrefix\":"
+		out.RawString(prefix)
+		out.String(string(in.TitlePrefix))
+	}
+	{
+		const prefix string = ",\"CrewIDs\":"
+		out.RawString(prefix)
+		if in.CrewIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v28, v29 := range in.CrewIDs {
+				if v28 > 0 {
+					out.RawByte(',')
+				}
+				out.Uint64(uint64(v29))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"CrewRole\":"
+		out.RawString(prefix)
+		out.String(string(in.CrewRole))
+	}
+	{
+		const prefix string = ",\"GenreIDs\":"
+		out.RawString(prefix)
+		if in.GenreIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v30, v31 := range in.GenreIDs {
+				if v30 > 0 {
+					out.RawByte(',')
+				}
+				out.Uint64(uint64(v31))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"GenreMatch\":"
+		out.RawString(prefix)
+		out.String(string(in.GenreMatch))
+	}
+	{
+		const prefix string = ",\"ExcludeGenreIDs\":"
+		out.RawString(prefix)
+		if in.ExcludeGenreIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v32, v33 := range in.ExcludeGenreIDs {
+				if v32 > 0 {
+					out.RawByte(',')
+				}
+				out.Uint64(uint64(v33))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"TagIDs\":"
+		out.RawString(prefix)
+		if in.TagIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v34, v35 := range in.TagIDs {
+				if v34 > 0 {
+					out.RawByte(',')
+				}
+				out.Uint64(uint64(v35))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"TagMatch\":"
+		out.RawString(prefix)
+		out.String(string(in.TagMatch))
+	}
+	{
+		const prefix string = ",\"ExcludeTagIDs\":"
+		out.RawString(prefix)
+		if in.ExcludeTagIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v36, v37 := range in.ExcludeTagIDs {
+				if v36 > 0 {
+					out.RawByte(',')
+				}
+				out.Uint64(uint64(v37))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"IDs\":"
+		out.RawString(prefix)
+		if in.IDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v38, v39 := range in.IDs {
+				if v38 > 0 {
+					out.RawByte(',')
+				}
+				out.Uint64(uint64(v39))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Limit\":"
+		out.RawString(prefix)
+		out.Int(int(in.Limit))
+	}
+	{
+		const prefix string = ",\"Offset\":"
+		out.RawString(prefix)
+		out.Int(int(in.Offset))
+	}
+	{
+		const prefix string = ",\"Cursor\":"
+		out.RawString(prefix)
+		if in.Cursor == nil {
+			out.RawString("null")
+		} else {
+			(*in.Cursor).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"WithTotal\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.WithTotal))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FilmFilter) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson14b8084aEncodeFilmsLibraryInternalModel4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FilmFilter) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson14b8084aEncodeFilmsLibraryInternalModel4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FilmFilter) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson14b8084aDecodeFilmsLibraryInternalModel4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FilmFilter) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson14b8084aDecodeFilmsLibraryInternalModel4(l, v)
+}
+func easyjson14b8084aDecodeFilmsLibraryInternalModel5(in *jlexer.Lexer, out *Film) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "film_id":
+			out.ID = uint64(in.Uint64())
+		case "title":
+			out.Title = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "release_date":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ReleaseDate).UnmarshalJSON(data))
+			}
+		case "rating":
+			out.Rating = int(in.Int())
+		case "community_score":
+			out.CommunityScore = float64(in.Float64())
+		case "rating_count":
+			out.RatingCount = int64(in.Int64())
+		case "availability":
+			if in.IsNull() {
+				in.Skip()
+				out.Availability = nil
+			} else {
+				if out.Availability == nil {
+					out.Availability = new(Availability)
+				}
+				(*out.Availability).UnmarshalEasyJSON(in)
+			}
+		case "external_ids":
+			if in.IsNull() {
+				in.Skip()
+				out.ExternalIDs = nil
+			} else {
+				if out.ExternalIDs == nil {
+					out.ExternalIDs = new(ExternalIDs)
+				}
+				(*out.ExternalIDs).UnmarshalEasyJSON(in)
+			}
+		case "poster":
+			if in.IsNull() {
+				in.Skip()
+				out.Poster = nil
+			} else {
+				if out.Poster == nil {
+					out.Poster = new(ImageURLs)
+				}
+				easyjson14b8084aDecodeFilmsLibraryInternalModel1(in, out.Poster)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson14b8084aEncodeFilmsLibraryInternalModel5(out *jwriter.Writer, in Film) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"film_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.ID))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"release_date\":"
+		out.RawString(prefix)
+		out.Raw((in.ReleaseDate).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"rating\":"
+		out.RawString(prefix)
+		out.Int(int(in.Rating))
+	}
+	{
+		const prefix string = ",\"community_score\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.CommunityScore))
+	}
+	{
+		const prefix string = ",\"rating_count\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.RatingCount))
+	}
+	if in.Availability != nil {
+		const prefix string = ",\"availability\":"
+		out.RawString(prefix)
+		(*in.Availability).MarshalEasyJSON(out)
+	}
+	if in.ExternalIDs != nil {
+		const prefix string = ",\"external_ids\":"
+		out.RawString(prefix)
+		(*in.ExternalIDs).MarshalEasyJSON(out)
+	}
+	if in.Poster != nil {
+		const prefix string = ",\"poster\":"
+		out.RawString(prefix)
+		easyjson14b8084aEncodeFilmsLibraryInternalModel1(out, *in.Poster)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Film) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson14b8084aEncodeFilmsLibraryInternalModel5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Film) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson14b8084aEncodeFilmsLibraryInternalModel5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Film) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson14b8084aDecodeFilmsLibraryInternalModel5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Film) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson14b8084aDecodeFilmsLibraryInternalModel5(l, v)
+}
+func easyjson14b8084aDecodeFilmsLibraryInternalModel6(in *jlexer.Lexer, out *ExportFilm) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "actors":
+			if in.IsNull() {
+				in.Skip()
+				out.Actors = nil
+			} else {
+				in.Delim('[')
+				if out.Actors == nil {
+					if !in.IsDelim(']') {
+						out.Actors = make([]uint, 0, 8)
+					} else {
+						out.Actors = []uint{}
+					}
+				} else {
+					out.Actors = (out.Actors)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v40 uint
+					v40 = uint(in.Uint())
+					out.Actors = append(out.Actors, v40)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "film_id":
+			out.ID = uint64(in.Uint64())
+		case "title":
+			out.Title = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "release_date":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ReleaseDate).UnmarshalJSON(data))
+			}
+		case "rating":
+			out.Rating = int(in.Int())
+		case "community_score":
+			out.CommunityScore = float64(in.Float64())
+		case "rating_count":
+			out.RatingCount = int64(in.Int64())
+		case "availability":
+			if in.IsNull() {
+				in.Skip()
+				out.Availability = nil
+			} else {
+				if out.Availability == nil {
+					out.Availability = new(Availability)
+				}
+				(*out.Availability).UnmarshalEasyJSON(in)
+			}
+		case "external_ids":
+			if in.IsNull() {
+				in.Skip()
+				out.ExternalIDs = nil
+			} else {
+				if out.ExternalIDs == nil {
+					out.ExternalIDs = new(ExternalIDs)
+				}
+				(*out.ExternalIDs).UnmarshalEasyJSON(in)
+			}
+		case "poster":
+			if in.IsNull() {
+				in.Skip()
+				out.Poster = nil
+			} else {
+				if out.Poster == nil {
+					out.Poster = new(ImageURLs)
+				}
+				easyjson14b8084aDecodeFilmsLibraryInternalModel1(in, out.Poster)
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -50,47 +1479,103 @@ func easyjson14b8084aDecodeFilmsLibraryInternalModel(in *jlexer.Lexer, out *Film
 		in.Consumed()
 	}
 }
-func easyjson14b8084aEncodeFilmsLibraryInternalModel(out *jwriter.Writer, in FilmFilter) {
+func easyjson14b8084aEncodeFilmsLibraryInternalModel6(out *jwriter.Writer, in ExportFilm) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"SortBy\":"
+		const prefix string = ",\"actors\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.SortBy))
+		if in.Actors == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v41, v42 := range in.Actors {
+				if v41 > 0 {
+					out.RawByte(',')
+				}
+				out.Uint(uint(v42))
+			}
+			out.RawByte(']')
+		}
 	}
 	{
-		const prefix string = ",\"SortOrder\":"
+		const prefix string = ",\"film_id\":"
 		out.RawString(prefix)
-		out.String(string(in.SortOrder))
+		out.Uint64(uint64(in.ID))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"release_date\":"
+		out.RawString(prefix)
+		out.Raw((in.ReleaseDate).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"rating\":"
+		out.RawString(prefix)
+		out.Int(int(in.Rating))
+	}
+	{
+		const prefix string = ",\"community_score\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.CommunityScore))
+	}
+	{
+		const prefix string = ",\"rating_count\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.RatingCount))
+	}
+	if in.Availability != nil {
+		const prefix string = ",\"availability\":"
+		out.RawString(prefix)
+		(*in.Availability).MarshalEasyJSON(out)
+	}
+	if in.ExternalIDs != nil {
+		const prefix string = ",\"external_ids\":"
+		out.RawString(prefix)
+		(*in.ExternalIDs).MarshalEasyJSON(out)
+	}
+	if in.Poster != nil {
+		const prefix string = ",\"poster\":"
+		out.RawString(prefix)
+		easyjson14b8084aEncodeFilmsLibraryInternalModel1(out, *in.Poster)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v FilmFilter) MarshalJSON() ([]byte, error) {
+func (v ExportFilm) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson14b8084aEncodeFilmsLibraryInternalModel(&w, v)
+	easyjson14b8084aEncodeFilmsLibraryInternalModel6(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v FilmFilter) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson14b8084aEncodeFilmsLibraryInternalModel(w, v)
+func (v ExportFilm) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson14b8084aEncodeFilmsLibraryInternalModel6(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *FilmFilter) UnmarshalJSON(data []byte) error {
+func (v *ExportFilm) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson14b8084aDecodeFilmsLibraryInternalModel(&r, v)
+	easyjson14b8084aDecodeFilmsLibraryInternalModel6(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *FilmFilter) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson14b8084aDecodeFilmsLibraryInternalModel(l, v)
+func (v *ExportFilm) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson14b8084aDecodeFilmsLibraryInternalModel6(l, v)
 }
-func easyjson14b8084aDecodeFilmsLibraryInternalModel1(in *jlexer.Lexer, out *Film) {
+func easyjson14b8084aDecodeFilmsLibraryInternalModel7(in *jlexer.Lexer, out *CastRole) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -109,18 +1594,45 @@ func easyjson14b8084aDecodeFilmsLibraryInternalModel1(in *jlexer.Lexer, out *Fil
 			continue
 		}
 		switch key {
-		case "film_id":
-			out.ID = uint64(in.Uint64())
-		case "title":
-			out.Title = string(in.String())
-		case "description":
-			out.Description = string(in.String())
-		case "release_date":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.ReleaseDate).UnmarshalJSON(data))
+		case "characters":
+			if in.IsNull() {
+				in.Skip()
+				out.Characters = nil
+			} else {
+				in.Delim('[')
+				if out.Characters == nil {
+					if !in.IsDelim(']') {
+						out.Characters = make([]string, 0, 4)
+					} else {
+						out.Characters = []string{}
+					}
+				} else {
+					out.Characters = (out.Characters)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v43 string
+					v43 = string(in.String())
+					out.Characters = append(out.Characters, v43)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
-		case "rating":
-			out.Rating = int(in.Int())
+		case "billing":
+			if in.IsNull() {
+				in.Skip()
+				out.Billing = nil
+			} else {
+				if out.Billing == nil {
+					out.Billing = new(int)
+				}
+				*out.Billing = int(in.Int())
+			}
+		case "uncredited":
+			out.Uncredited = bool(in.Bool())
+		case "voice":
+			out.Voice = bool(in.Bool())
+		case "cameo":
+			out.Cameo = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -131,62 +1643,73 @@ func easyjson14b8084aDecodeFilmsLibraryInternalModel1(in *jlexer.Lexer, out *Fil
 		in.Consumed()
 	}
 }
-func easyjson14b8084aEncodeFilmsLibraryInternalModel1(out *jwriter.Writer, in Film) {
+func easyjson14b8084aEncodeFilmsLibraryInternalModel7(out *jwriter.Writer, in CastRole) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"film_id\":"
+		const prefix string = ",\"characters\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.ID))
+		if in.Characters == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v44, v45 := range in.Characters {
+				if v44 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v45))
+			}
+			out.RawByte(']')
+		}
 	}
-	{
-		const prefix string = ",\"title\":"
+	if in.Billing != nil {
+		const prefix string = ",\"billing\":"
 		out.RawString(prefix)
-		out.String(string(in.Title))
+		out.Int(int(*in.Billing))
 	}
-	{
-		const prefix string = ",\"description\":"
+	if in.Uncredited {
+		const prefix string = ",\"uncredited\":"
 		out.RawString(prefix)
-		out.String(string(in.Description))
+		out.Bool(bool(in.Uncredited))
 	}
-	{
-		const prefix string = ",\"release_date\":"
+	if in.Voice {
+		const prefix string = ",\"voice\":"
 		out.RawString(prefix)
-		out.Raw((in.ReleaseDate).MarshalJSON())
+		out.Bool(bool(in.Voice))
 	}
-	{
-		const prefix string = ",\"rating\":"
+	if in.Cameo {
+		const prefix string = ",\"cameo\":"
 		out.RawString(prefix)
-		out.Int(int(in.Rating))
+		out.Bool(bool(in.Cameo))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Film) MarshalJSON() ([]byte, error) {
+func (v CastRole) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson14b8084aEncodeFilmsLibraryInternalModel1(&w, v)
+	easyjson14b8084aEncodeFilmsLibraryInternalModel7(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Film) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson14b8084aEncodeFilmsLibraryInternalModel1(w, v)
+func (v CastRole) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson14b8084aEncodeFilmsLibraryInternalModel7(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Film) UnmarshalJSON(data []byte) error {
+func (v *CastRole) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson14b8084aDecodeFilmsLibraryInternalModel1(&r, v)
+	easyjson14b8084aDecodeFilmsLibraryInternalModel7(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Film) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson14b8084aDecodeFilmsLibraryInternalModel1(l, v)
+func (v *CastRole) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson14b8084aDecodeFilmsLibraryInternalModel7(l, v)
 }
-func easyjson14b8084aDecodeFilmsLibraryInternalModel2(in *jlexer.Lexer, out *AddFilmRequest) {
+func easyjson14b8084aDecodeFilmsLibraryInternalModel8(in *jlexer.Lexer, out *AddFilmRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -231,9 +1754,9 @@ func easyjson14b8084aDecodeFilmsLibraryInternalModel2(in *jlexer.Lexer, out *Add
 					out.Actors = (out.Actors)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v1 uint
-					v1 = uint(in.Uint())
-					out.Actors = append(out.Actors, v1)
+					var v46 uint
+					v46 = uint(in.Uint())
+					out.Actors = append(out.Actors, v46)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -248,7 +1771,7 @@ func easyjson14b8084aDecodeFilmsLibraryInternalModel2(in *jlexer.Lexer, out *Add
 		in.Consumed()
 	}
 }
-func easyjson14b8084aEncodeFilmsLibraryInternalModel2(out *jwriter.Writer, in AddFilmRequest) {
+func easyjson14b8084aEncodeFilmsLibraryInternalModel8(out *jwriter.Writer, in AddFilmRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -279,11 +1802,11 @@ func easyjson14b8084aEncodeFilmsLibraryInternalModel2(out *jwriter.Writer, in Ad
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v2, v3 := range in.Actors {
-				if v2 > 0 {
+			for v47, v48 := range in.Actors {
+				if v47 > 0 {
 					out.RawByte(',')
 				}
-				out.Uint(uint(v3))
+				out.Uint(uint(v48))
 			}
 			out.RawByte(']')
 		}
@@ -294,23 +1817,171 @@ func easyjson14b8084aEncodeFilmsLibraryInternalModel2(out *jwriter.Writer, in Ad
 // MarshalJSON supports json.Marshaler interface
 func (v AddFilmRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson14b8084aEncodeFilmsLibraryInternalModel2(&w, v)
+	easyjson14b8084aEncodeFilmsLibraryInternalModel8(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AddFilmRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson14b8084aEncodeFilmsLibraryInternalModel2(w, v)
+	easyjson14b8084aEncodeFilmsLibraryInternalModel8(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AddFilmRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson14b8084aDecodeFilmsLibraryInternalModel2(&r, v)
+	easyjson14b8084aDecodeFilmsLibraryInternalModel8(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AddFilmRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson14b8084aDecodeFilmsLibraryInternalModel2(l, v)
+	easyjson14b8084aDecodeFilmsLibraryInternalModel8(l, v)
+}
+func easyjson14b8084aDecodeFilmsLibraryInternalModel9(in *jlexer.Lexer, out *ActorObj) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "actor_id":
+			out.Id = uint(in.Uint())
+		case "name":
+			out.Name = string(in.String())
+		case "characters":
+			if in.IsNull() {
+				in.Skip()
+				out.Characters = nil
+			} else {
+				in.Delim('[')
+				if out.Characters == nil {
+					if !in.IsDelim(']') {
+						out.Characters = make([]string, 0, 4)
+					} else {
+						out.Characters = []string{}
+					}
+				} else {
+					out.Characters = (out.Characters)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v49 string
+					v49 = string(in.String())
+					out.Characters = append(out.Characters, v49)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "billing":
+			if in.IsNull() {
+				in.Skip()
+				out.Billing = nil
+			} else {
+				if out.Billing == nil {
+					out.Billing = new(int)
+				}
+				*out.Billing = int(in.Int())
+			}
+		case "uncredited":
+			out.Uncredited = bool(in.Bool())
+		case "voice":
+			out.Voice = bool(in.Bool())
+		case "cameo":
+			out.Cameo = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson14b8084aEncodeFilmsLibraryInternalModel9(out *jwriter.Writer, in ActorObj) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"actor_id\":"
+		out.RawString(prefix[1:])
+		out.Uint(uint(in.Id))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"characters\":"
+		out.RawString(prefix)
+		if in.Characters == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v50, v51 := range in.Characters {
+				if v50 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v51))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Billing != nil {
+		const prefix string = ",\"billing\":"
+		out.RawString(prefix)
+		out.Int(int(*in.Billing))
+	}
+	if in.Uncredited {
+		const prefix string = ",\"uncredited\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Uncredited))
+	}
+	if in.Voice {
+		const prefix string = ",\"voice\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Voice))
+	}
+	if in.Cameo {
+		const prefix string = ",\"cameo\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Cameo))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ActorObj) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson14b8084aEncodeFilmsLibraryInternalModel9(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ActorObj) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson14b8084aEncodeFilmsLibraryInternalModel9(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ActorObj) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson14b8084aDecodeFilmsLibraryInternalModel9(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ActorObj) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson14b8084aDecodeFilmsLibraryInternalModel9(l, v)
 }
@@ -0,0 +1,41 @@
+package model
+
+import (
+	"time"
+
+	"films_library/pkg/pagination"
+)
+
+// Holds wait in a FIFO queue per film until a copy is set aside for them.
+// Ready holds are collected by checking the copy out to their user, or
+// expire after the pickup window.
+const (
+	HoldWaiting   = "waiting"
+	HoldReady     = "ready"
+	HoldCollected = "collected"
+	HoldExpired   = "expired"
+	HoldCancelled = "cancelled"
+)
+
+// Hold is the reservation of a film by a user. Position is the place of a
+// waiting hold in the queue of its film, starting at 1.
+type Hold struct {
+	ID        uint64     `json:"hold_id"`
+	FilmID    uint64     `json:"film_id"`
+	Title     string     `json:"title"`
+	UserID    uint64     `json:"user_id"`
+	Username  string     `json:"username"`
+	Status    string     `json:"status"`
+	Position  int        `json:"position,omitempty"`
+	CopyID    *uint64    `json:"copy_id,omitempty"`
+	PlacedAt  time.Time  `json:"placed_at"`
+	ReadyAt   *time.Time `json:"ready_at,omitempty"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+type HoldFilter struct {
+	FilmID uint64
+	UserID uint64
+	Status string `validate:"omitempty,oneof=waiting ready collected expired cancelled"`
+	pagination.Params
+}
@@ -0,0 +1,307 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package model
+
+import (
+	json "encoding/json"
+	pagination "films_library/pkg/pagination"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson725dd887DecodeFilmsLibraryInternalModel(in *jlexer.Lexer, out *HoldFilter) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "FilmID":
+			out.FilmID = uint64(in.Uint64())
+		case "UserID":
+			out.UserID = uint64(in.Uint64())
+		case "Status":
+			out.Status = string(in.String())
+		case "Limit":
+			out.Limit = int(in.Int())
+		case "Offset":
+			out.Offset = int(in.Int())
+		case "Cursor":
+			if in.IsNull() {
+				in.Skip()
+				out.Cursor = nil
+			} else {
+				if out.Cursor == nil {
+					out.Cursor = new(pagination.Cursor)
+				}
+				(*out.Cursor).UnmarshalEasyJSON(in)
+			}
+		case "WithTotal":
+			out.WithTotal = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson725dd887EncodeFilmsLibraryInternalModel(out *jwriter.Writer, in HoldFilter) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"FilmID\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.FilmID))
+	}
+	{
+		const prefix string = ",\"UserID\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.UserID))
+	}
+	{
+		const prefix string = ",\"Status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"Limit\":"
+		out.RawString(prefix)
+		out.Int(int(in.Limit))
+	}
+	{
+		const prefix string = ",\"Offset\":"
+		out.RawString(prefix)
+		out.Int(int(in.Offset))
+	}
+	{
+		const prefix string = ",\"Cursor\":"
+		out.RawString(prefix)
+		if in.Cursor == nil {
+			out.RawString("null")
+		} else {
+			(*in.Cursor).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"WithTotal\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.WithTotal))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v HoldFilter) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson725dd887EncodeFilmsLibraryInternalModel(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v HoldFilter) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson725dd887EncodeFilmsLibraryInternalModel(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *HoldFilter) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson725dd887DecodeFilmsLibraryInternalModel(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *HoldFilter) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson725dd887DecodeFilmsLibraryInternalModel(l, v)
+}
+func easyjson725dd887DecodeFilmsLibraryInternalModel1(in *jlexer.Lexer, out *Hold) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "hold_id":
+			out.ID = uint64(in.Uint64())
+		case "film_id":
+			out.FilmID = uint64(in.Uint64())
+		case "title":
+			out.Title = string(in.String())
+		case "user_id":
+			out.UserID = uint64(in.Uint64())
+		case "username":
+			out.Username = string(in.String())
+		case "status":
+			out.Status = string(in.String())
+		case "position":
+			out.Position = int(in.Int())
+		case "copy_id":
+			if in.IsNull() {
+				in.Skip()
+				out.CopyID = nil
+			} else {
+				if out.CopyID == nil {
+					out.CopyID = new(uint64)
+				}
+				*out.CopyID = uint64(in.Uint64())
+			}
+		case "placed_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.PlacedAt).UnmarshalJSON(data))
+			}
+		case "ready_at":
+			if in.IsNull() {
+				in.Skip()
+				out.ReadyAt = nil
+			} else {
+				if out.ReadyAt == nil {
+					out.ReadyAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ReadyAt).UnmarshalJSON(data))
+				}
+			}
+		case "expires_at":
+			if in.IsNull() {
+				in.Skip()
+				out.ExpiresAt = nil
+			} else {
+				if out.ExpiresAt == nil {
+					out.ExpiresAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ExpiresAt).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson725dd887EncodeFilmsLibraryInternalModel1(out *jwriter.Writer, in Hold) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"hold_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.ID))
+	}
+	{
+		const prefix string = ",\"film_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.FilmID))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.UserID))
+	}
+	{
+		const prefix string = ",\"username\":"
+		out.RawString(prefix)
+		out.String(string(in.Username))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	if in.Position != 0 {
+		const prefix string = ",\"position\":"
+		out.RawString(prefix)
+		out.Int(int(in.Position))
+	}
+	if in.CopyID != nil {
+		const prefix string = ",\"copy_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(*in.CopyID))
+	}
+	{
+		const prefix string = ",\"placed_at\":"
+		out.RawString(prefix)
+		out.Raw((in.PlacedAt).MarshalJSON())
+	}
+	if in.ReadyAt != nil {
+		const prefix string = ",\"ready_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.ReadyAt).MarshalJSON())
+	}
+	if in.ExpiresAt != nil {
+		const prefix string = ",\"expires_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.ExpiresAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Hold) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson725dd887EncodeFilmsLibraryInternalModel1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Hold) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson725dd887EncodeFilmsLibraryInternalModel1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Hold) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson725dd887DecodeFilmsLibraryInternalModel1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Hold) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson725dd887DecodeFilmsLibraryInternalModel1(l, v)
+}
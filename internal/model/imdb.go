@@ -0,0 +1,78 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// IMDb datasets, named as in the non-commercial dataset files,
+// title.basics.tsv.gz and so on.
+const (
+	IMDbRatings    = "title.ratings"
+	IMDbTitles     = "title.basics"
+	IMDbNames      = "name.basics"
+	IMDbPrincipals = "title.principals"
+)
+
+// IMDbFilter picks the titles imported from title.basics. MinVotes needs
+// title.ratings.
+type IMDbFilter struct {
+	TitleTypes []string
+	MinYear    int
+	MinVotes   int
+	Adult      bool
+}
+
+// Key identifies the filter in import checkpoints; imports resume only
+// under the same filter.
+func (f IMDbFilter) Key() string {
+	return fmt.Sprintf("types=%s year>=%d votes>=%d adult=%t", strings.Join(f.TitleTypes, ","), f.MinYear, f.MinVotes, f.Adult)
+}
+
+// IMDbCheckpoint is how far a dataset file was imported under a filter.
+// Source identifies the file by size and modification time.
+type IMDbCheckpoint struct {
+	Dataset   string
+	Source    string
+	Filters   string
+	LinesDone int64
+	Done      bool
+}
+
+// IMDbTitle is a film from title.basics. Films are released on the first of
+// January of their year, the only date IMDb has, and rated if title.ratings
+// lists them.
+type IMDbTitle struct {
+	ID          string
+	Title       string
+	ReleaseDate time.Time
+	Rating      *int
+}
+
+// IMDbName is an actor from name.basics, born on the first of January of
+// their birth year if it is known. Sex is taken from their credits.
+type IMDbName struct {
+	ID        string
+	Name      string
+	Sex       string
+	BirthDate *time.Time
+}
+
+// IMDbCredit is a cast credit from title.principals.
+type IMDbCredit struct {
+	FilmID     uint64
+	ActorID    uint64
+	Billing    int
+	Characters []string
+}
+
+// IMDbProgress is what an import did with a dataset. Resumed is the lines
+// skipped as imported by an earlier run.
+type IMDbProgress struct {
+	Dataset  string
+	Lines    int64
+	Resumed  int64
+	Imported int64
+	Skipped  bool
+}
@@ -0,0 +1,29 @@
+package model
+
+// Bulk imports read CSV with a header row or newline-delimited JSON.
+const (
+	ImportCSV    = "csv"
+	ImportNDJSON = "ndjson"
+)
+
+// ImportReport tells how many rows an import read, how many it inserted and
+// which failed. Dry runs validate every row and insert none; Imported is then
+// the rows that would have been. Errors holds the first 1000 failures. Error
+// is why an import stopped part way, if it did; the rows read until then are
+// reported as ever.
+type ImportReport struct {
+	DryRun   bool          `json:"dry_run"`
+	Rows     int           `json:"rows"`
+	Imported int           `json:"imported"`
+	Failed   int           `json:"failed"`
+	Errors   []ImportError `json:"errors"`
+	Error    string        `json:"error,omitempty"`
+}
+
+// ImportError is why a row was not imported. Rows are numbered from 1, not
+// counting the CSV header; Field names the column to blame, if there is one.
+type ImportError struct {
+	Row     int    `json:"row"`
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
@@ -0,0 +1,232 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package model
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson63a4a5efDecodeFilmsLibraryInternalModel(in *jlexer.Lexer, out *ImportReport) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "dry_run":
+			out.DryRun = bool(in.Bool())
+		case "rows":
+			out.Rows = int(in.Int())
+		case "imported":
+			out.Imported = int(in.Int())
+		case "failed":
+			out.Failed = int(in.Int())
+		case "errors":
+			if in.IsNull() {
+				in.Skip()
+				out.Errors = nil
+			} else {
+				in.Delim('[')
+				if out.Errors == nil {
+					if !in.IsDelim(']') {
+						out.Errors = make([]ImportError, 0, 1)
+					} else {
+						out.Errors = []ImportError{}
+					}
+				} else {
+					out.Errors = (out.Errors)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 ImportError
+					(v1).UnmarshalEasyJSON(in)
+					out.Errors = append(out.Errors, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "error":
+			out.Error = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson63a4a5efEncodeFilmsLibraryInternalModel(out *jwriter.Writer, in ImportReport) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"dry_run\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.DryRun))
+	}
+	{
+		const prefix string = ",\"rows\":"
+		out.RawString(prefix)
+		out.Int(int(in.Rows))
+	}
+	{
+		const prefix string = ",\"imported\":"
+		out.RawString(prefix)
+		out.Int(int(in.Imported))
+	}
+	{
+		const prefix string = ",\"failed\":"
+		out.RawString(prefix)
+		out.Int(int(in.Failed))
+	}
+	{
+		const prefix string = ",\"errors\":"
+		out.RawString(prefix)
+		if in.Errors == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Errors {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				(v3).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Error != "" {
+		const prefix string = ",\"error\":"
+		out.RawString(prefix)
+		out.String(string(in.Error))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ImportReport) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson63a4a5efEncodeFilmsLibraryInternalModel(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ImportReport) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson63a4a5efEncodeFilmsLibraryInternalModel(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ImportReport) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson63a4a5efDecodeFilmsLibraryInternalModel(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ImportReport) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson63a4a5efDecodeFilmsLibraryInternalModel(l, v)
+}
+func easyjson63a4a5efDecodeFilmsLibraryInternalModel1(in *jlexer.Lexer, out *ImportError) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "row":
+			out.Row = int(in.Int())
+		case "field":
+			out.Field = string(in.String())
+		case "message":
+			out.Message = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson63a4a5efEncodeFilmsLibraryInternalModel1(out *jwriter.Writer, in ImportError) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"row\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Row))
+	}
+	if in.Field != "" {
+		const prefix string = ",\"field\":"
+		out.RawString(prefix)
+		out.String(string(in.Field))
+	}
+	{
+		const prefix string = ",\"message\":"
+		out.RawString(prefix)
+		out.String(string(in.Message))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ImportError) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson63a4a5efEncodeFilmsLibraryInternalModel1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ImportError) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson63a4a5efEncodeFilmsLibraryInternalModel1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ImportError) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson63a4a5efDecodeFilmsLibraryInternalModel1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ImportError) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson63a4a5efDecodeFilmsLibraryInternalModel1(l, v)
+}
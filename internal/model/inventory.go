@@ -0,0 +1,70 @@
+package model
+
+import (
+	"time"
+
+	"films_library/pkg/pagination"
+)
+
+const (
+	FormatDVD     = "dvd"
+	FormatBluRay  = "bluray"
+	Format4K      = "4k"
+	FormatVHS     = "vhs"
+	FormatDigital = "digital"
+)
+
+const (
+	ConditionNew     = "new"
+	ConditionGood    = "good"
+	ConditionFair    = "fair"
+	ConditionPoor    = "poor"
+	ConditionDamaged = "damaged"
+)
+
+// Copies in CopyActive are in circulation; withdrawn and lost copies are kept
+// for the record but no longer counted.
+const (
+	CopyActive    = "active"
+	CopyWithdrawn = "withdrawn"
+	CopyLost      = "lost"
+)
+
+// Copy is a physical or digital copy of a film the library owns. Barcode is
+// empty for copies without one, AcquiredOn for copies of unknown origin.
+type Copy struct {
+	ID         uint64    `json:"copy_id"`
+	FilmID     uint64    `json:"film_id"`
+	Format     string    `json:"format"`
+	Barcode    string    `json:"barcode"`
+	Condition  string    `json:"condition"`
+	AcquiredOn string    `json:"acquired_on"`
+	Shelf      string    `json:"shelf"`
+	Status     string    `json:"status"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+type CopyRequest struct {
+	FilmID     uint64 `json:"film_id"     validate:"required"`
+	Format     string `json:"format"      validate:"required,oneof=dvd bluray 4k vhs digital"`
+	Barcode    string `json:"barcode"     validate:"omitempty,alphanum,max=32"`
+	Condition  string `json:"condition"   validate:"required,oneof=new good fair poor damaged"`
+	AcquiredOn string `json:"acquired_on" validate:"omitempty,datetime=2006-01-02"`
+	Shelf      string `json:"shelf"       validate:"max=50"`
+	Status     string `json:"status"      validate:"omitempty,oneof=active withdrawn lost"`
+}
+
+type CopyFilter struct {
+	FilmID uint64
+	Format string `validate:"omitempty,oneof=dvd bluray 4k vhs digital"`
+	Status string `validate:"omitempty,oneof=active withdrawn lost"`
+	pagination.Params
+}
+
+// Availability counts the copies of a film in circulation and those of them
+// on the shelf.
+type Availability struct {
+	Copies    int64 `json:"copies"`
+	Available int64 `json:"available"`
+}
@@ -0,0 +1,454 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package model
+
+import (
+	json "encoding/json"
+	pagination "films_library/pkg/pagination"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson6f8bf452DecodeFilmsLibraryInternalModel(in *jlexer.Lexer, out *CopyRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "film_id":
+			out.FilmID = uint64(in.Uint64())
+		case "format":
+			out.Format = string(in.String())
+		case "barcode":
+			out.Barcode = string(in.String())
+		case "condition":
+			out.Condition = string(in.String())
+		case "acquired_on":
+			out.AcquiredOn = string(in.String())
+		case "shelf":
+			out.Shelf = string(in.String())
+		case "status":
+			out.Status = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6f8bf452EncodeFilmsLibraryInternalModel(out *jwriter.Writer, in CopyRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"film_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.FilmID))
+	}
+	{
+		const prefix string = ",\"format\":"
+		out.RawString(prefix)
+		out.String(string(in.Format))
+	}
+	{
+		const prefix string = ",\"barcode\":"
+		out.RawString(prefix)
+		out.String(string(in.Barcode))
+	}
+	{
+		const prefix string = ",\"condition\":"
+		out.RawString(prefix)
+		out.String(string(in.Condition))
+	}
+	{
+		const prefix string = ",\"acquired_on\":"
+		out.RawString(prefix)
+		out.String(string(in.AcquiredOn))
+	}
+	{
+		const prefix string = ",\"shelf\":"
+		out.RawString(prefix)
+		out.String(string(in.Shelf))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CopyRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6f8bf452EncodeFilmsLibraryInternalModel(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CopyRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6f8bf452EncodeFilmsLibraryInternalModel(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CopyRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6f8bf452DecodeFilmsLibraryInternalModel(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CopyRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6f8bf452DecodeFilmsLibraryInternalModel(l, v)
+}
+func easyjson6f8bf452DecodeFilmsLibraryInternalModel1(in *jlexer.Lexer, out *CopyFilter) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "FilmID":
+			out.FilmID = uint64(in.Uint64())
+		case "Format":
+			out.Format = string(in.String())
+		case "Status":
+			out.Status = string(in.String())
+		case "Limit":
+			out.Limit = int(in.Int())
+		case "Offset":
+			out.Offset = int(in.Int())
+		case "Cursor":
+			if in.IsNull() {
+				in.Skip()
+				out.Cursor = nil
+			} else {
+				if out.Cursor == nil {
+					out.Cursor = new(pagination.Cursor)
+				}
+				(*out.Cursor).UnmarshalEasyJSON(in)
+			}
+		case "WithTotal":
+			out.WithTotal = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6f8bf452EncodeFilmsLibraryInternalModel1(out *jwriter.Writer, in CopyFilter) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"FilmID\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.FilmID))
+	}
+	{
+		const prefix string = ",\"Format\":"
+		out.RawString(prefix)
+		out.String(string(in.Format))
+	}
+	{
+		const prefix string = ",\"Status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"Limit\":"
+		out.RawString(prefix)
+		out.Int(int(in.Limit))
+	}
+	{
+		const prefix string = ",\"Offset\":"
+		out.RawString(prefix)
+		out.Int(int(in.Offset))
+	}
+	{
+		const prefix string = ",\"Cursor\":"
+		out.RawString(prefix)
+		if in.Cursor == nil {
+			out.RawString("null")
+		} else {
+			(*in.Cursor).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"WithTotal\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.WithTotal))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CopyFilter) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6f8bf452EncodeFilmsLibraryInternalModel1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CopyFilter) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6f8bf452EncodeFilmsLibraryInternalModel1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CopyFilter) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6f8bf452DecodeFilmsLibraryInternalModel1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CopyFilter) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6f8bf452DecodeFilmsLibraryInternalModel1(l, v)
+}
+func easyjson6f8bf452DecodeFilmsLibraryInternalModel2(in *jlexer.Lexer, out *Copy) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "copy_id":
+			out.ID = uint64(in.Uint64())
+		case "film_id":
+			out.FilmID = uint64(in.Uint64())
+		case "format":
+			out.Format = string(in.String())
+		case "barcode":
+			out.Barcode = string(in.String())
+		case "condition":
+			out.Condition = string(in.String())
+		case "acquired_on":
+			out.AcquiredOn = string(in.String())
+		case "shelf":
+			out.Shelf = string(in.String())
+		case "status":
+			out.Status = string(in.String())
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6f8bf452EncodeFilmsLibraryInternalModel2(out *jwriter.Writer, in Copy) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"copy_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.ID))
+	}
+	{
+		const prefix string = ",\"film_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.FilmID))
+	}
+	{
+		const prefix string = ",\"format\":"
+		out.RawString(prefix)
+		out.String(string(in.Format))
+	}
+	{
+		const prefix string = ",\"barcode\":"
+		out.RawString(prefix)
+		out.String(string(in.Barcode))
+	}
+	{
+		const prefix string = ",\"condition\":"
+		out.RawString(prefix)
+		out.String(string(in.Condition))
+	}
+	{
+		const prefix string = ",\"acquired_on\":"
+		out.RawString(prefix)
+		out.String(string(in.AcquiredOn))
+	}
+	{
+		const prefix string = ",\"shelf\":"
+		out.RawString(prefix)
+		out.String(string(in.Shelf))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Copy) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6f8bf452EncodeFilmsLibraryInternalModel2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Copy) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6f8bf452EncodeFilmsLibraryInternalModel2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Copy) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6f8bf452DecodeFilmsLibraryInternalModel2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Copy) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6f8bf452DecodeFilmsLibraryInternalModel2(l, v)
+}
+func easyjson6f8bf452DecodeFilmsLibraryInternalModel3(in *jlexer.Lexer, out *Availability) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "copies":
+			out.Copies = int64(in.Int64())
+		case "available":
+			out.Available = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6f8bf452EncodeFilmsLibraryInternalModel3(out *jwriter.Writer, in Availability) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"copies\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.Copies))
+	}
+	{
+		const prefix string = ",\"available\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Available))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Availability) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6f8bf452EncodeFilmsLibraryInternalModel3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Availability) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6f8bf452EncodeFilmsLibraryInternalModel3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Availability) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6f8bf452DecodeFilmsLibraryInternalModel3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Availability) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6f8bf452DecodeFilmsLibraryInternalModel3(l, v)
+}
@@ -0,0 +1,50 @@
+package model
+
+import (
+	"time"
+
+	"films_library/pkg/pagination"
+)
+
+// Loans are current until returned. Current loans past their due date are
+// flagged overdue by a background job.
+const (
+	LoanCurrent  = "current"
+	LoanOverdue  = "overdue"
+	LoanReturned = "returned"
+)
+
+// Loan is a copy lent to a borrower.
+type Loan struct {
+	ID           uint64     `json:"loan_id"`
+	CopyID       uint64     `json:"copy_id"`
+	FilmID       uint64     `json:"film_id"`
+	Title        string     `json:"title"`
+	BorrowerID   uint64     `json:"borrower_id"`
+	Borrower     string     `json:"borrower"`
+	CheckedOutAt time.Time  `json:"checked_out_at"`
+	DueOn        string     `json:"due_on"`
+	Renewals     int        `json:"renewals"`
+	ReturnedAt   *time.Time `json:"returned_at,omitempty"`
+	Overdue      bool       `json:"overdue"`
+}
+
+// CheckoutRequest lends a copy. Without DueOn the loan is due after the
+// configured loan period.
+type CheckoutRequest struct {
+	CopyID     uint64 `json:"copy_id"     validate:"required"`
+	BorrowerID uint64 `json:"borrower_id" validate:"required"`
+	DueOn      string `json:"due_on"      validate:"omitempty,datetime=2006-01-02"`
+}
+
+type LoanFilter struct {
+	BorrowerID uint64
+	Status     string `validate:"omitempty,oneof=current overdue returned"`
+	pagination.Params
+}
+
+// LoanPolicy is how long loans last, in days, and how often they may be renewed.
+type LoanPolicy struct {
+	PeriodDays int
+	RenewLimit int
+}
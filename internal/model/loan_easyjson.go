@@ -0,0 +1,435 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package model
+
+import (
+	json "encoding/json"
+	pagination "films_library/pkg/pagination"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson14adcde6DecodeFilmsLibraryInternalModel(in *jlexer.Lexer, out *LoanPolicy) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "PeriodDays":
+			out.PeriodDays = int(in.Int())
+		case "RenewLimit":
+			out.RenewLimit = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson14adcde6EncodeFilmsLibraryInternalModel(out *jwriter.Writer, in LoanPolicy) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"PeriodDays\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.PeriodDays))
+	}
+	{
+		const prefix string = ",\"RenewLimit\":"
+		out.RawString(prefix)
+		out.Int(int(in.RenewLimit))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LoanPolicy) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson14adcde6EncodeFilmsLibraryInternalModel(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LoanPolicy) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson14adcde6EncodeFilmsLibraryInternalModel(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LoanPolicy) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson14adcde6DecodeFilmsLibraryInternalModel(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LoanPolicy) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson14adcde6DecodeFilmsLibraryInternalModel(l, v)
+}
+func easyjson14adcde6DecodeFilmsLibraryInternalModel1(in *jlexer.Lexer, out *LoanFilter) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "BorrowerID":
+			out.BorrowerID = uint64(in.Uint64())
+		case "Status":
+			out.Status = string(in.String())
+		case "Limit":
+			out.Limit = int(in.Int())
+		case "Offset":
+			out.Offset = int(in.Int())
+		case "Cursor":
+			if in.IsNull() {
+				in.Skip()
+				out.Cursor = nil
+			} else {
+				if out.Cursor == nil {
+					out.Cursor = new(pagination.Cursor)
+				}
+				(*out.Cursor).UnmarshalEasyJSON(in)
+			}
+		case "WithTotal":
+			out.WithTotal = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson14adcde6EncodeFilmsLibraryInternalModel1(out *jwriter.Writer, in LoanFilter) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"BorrowerID\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.BorrowerID))
+	}
+	{
+		const prefix string = ",\"Status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"Limit\":"
+		out.RawString(prefix)
+		out.Int(int(in.Limit))
+	}
+	{
+		const prefix string = ",\"Offset\":"
+		out.RawString(prefix)
+		out.Int(int(in.Offset))
+	}
+	{
+		const prefix string = ",\"Cursor\":"
+		out.RawString(prefix)
+		if in.Cursor == nil {
+			out.RawString("null")
+		} else {
+			(*in.Cursor).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"WithTotal\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.WithTotal))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LoanFilter) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson14adcde6EncodeFilmsLibraryInternalModel1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LoanFilter) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson14adcde6EncodeFilmsLibraryInternalModel1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LoanFilter) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson14adcde6DecodeFilmsLibraryInternalModel1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LoanFilter) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson14adcde6DecodeFilmsLibraryInternalModel1(l, v)
+}
+func easyjson14adcde6DecodeFilmsLibraryInternalModel2(in *jlexer.Lexer, out *Loan) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "loan_id":
+			out.ID = uint64(in.Uint64())
+		case "copy_id":
+			out.CopyID = uint64(in.Uint64())
+		case "film_id":
+			out.FilmID = uint64(in.Uint64())
+		case "title":
+			out.Title = string(in.String())
+		case "borrower_id":
+			out.BorrowerID = uint64(in.Uint64())
+		case "borrower":
+			out.Borrower = string(in.String())
+		case "checked_out_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CheckedOutAt).UnmarshalJSON(data))
+			}
+		case "due_on":
+			out.DueOn = string(in.String())
+		case "renewals":
+			out.Renewals = int(in.Int())
+		case "returned_at":
+			if in.IsNull() {
+				in.Skip()
+				out.ReturnedAt = nil
+			} else {
+				if out.ReturnedAt == nil {
+					out.ReturnedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ReturnedAt).UnmarshalJSON(data))
+				}
+			}
+		case "overdue":
+			out.Overdue = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson14adcde6EncodeFilmsLibraryInternalModel2(out *jwriter.Writer, in Loan) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"loan_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.ID))
+	}
+	{
+		const prefix string = ",\"copy_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.CopyID))
+	}
+	{
+		const prefix string = ",\"film_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.FilmID))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"borrower_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.BorrowerID))
+	}
+	{
+		const prefix string = ",\"borrower\":"
+		out.RawString(prefix)
+		out.String(string(in.Borrower))
+	}
+	{
+		const prefix string = ",\"checked_out_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CheckedOutAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"due_on\":"
+		out.RawString(prefix)
+		out.String(string(in.DueOn))
+	}
+	{
+		const prefix string = ",\"renewals\":"
+		out.RawString(prefix)
+		out.Int(int(in.Renewals))
+	}
+	if in.ReturnedAt != nil {
+		const prefix string = ",\"returned_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.ReturnedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"overdue\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Overdue))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Loan) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson14adcde6EncodeFilmsLibraryInternalModel2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Loan) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson14adcde6EncodeFilmsLibraryInternalModel2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Loan) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson14adcde6DecodeFilmsLibraryInternalModel2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Loan) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson14adcde6DecodeFilmsLibraryInternalModel2(l, v)
+}
+func easyjson14adcde6DecodeFilmsLibraryInternalModel3(in *jlexer.Lexer, out *CheckoutRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "copy_id":
+			out.CopyID = uint64(in.Uint64())
+		case "borrower_id":
+			out.BorrowerID = uint64(in.Uint64())
+		case "due_on":
+			out.DueOn = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson14adcde6EncodeFilmsLibraryInternalModel3(out *jwriter.Writer, in CheckoutRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"copy_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.CopyID))
+	}
+	{
+		const prefix string = ",\"borrower_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.BorrowerID))
+	}
+	{
+		const prefix string = ",\"due_on\":"
+		out.RawString(prefix)
+		out.String(string(in.DueOn))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CheckoutRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson14adcde6EncodeFilmsLibraryInternalModel3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CheckoutRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson14adcde6EncodeFilmsLibraryInternalModel3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CheckoutRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson14adcde6DecodeFilmsLibraryInternalModel3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CheckoutRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson14adcde6DecodeFilmsLibraryInternalModel3(l, v)
+}
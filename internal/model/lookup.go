@@ -0,0 +1,55 @@
+package model
+
+import "time"
+
+// A scanned code matches a copy by its barcode or a film by a product code
+// printed on one of its releases.
+const (
+	MatchCopy    = "copy"
+	MatchProduct = "product"
+)
+
+// Copies in circulation are on the shelf, on loan or set aside for a hold.
+const (
+	CirculationAvailable = "available"
+	CirculationOnLoan    = "on_loan"
+	CirculationOnHold    = "on_hold"
+)
+
+// Why a code matched nothing.
+const (
+	LookupInvalidChecksum = "invalid checksum"
+	LookupNotFound        = "code not found"
+)
+
+// ProductCode is an EAN-13 code of a release of a film. UPC-A codes are
+// stored zero-padded to EAN-13.
+type ProductCode struct {
+	Code      string    `json:"code"`
+	FilmID    uint64    `json:"film_id"`
+	Format    string    `json:"format,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ProductCodeRequest struct {
+	Code   string `json:"code"   validate:"required,max=32"`
+	Format string `json:"format" validate:"omitempty,oneof=dvd bluray 4k vhs digital"`
+}
+
+// LookupResult is what a scanned code matched. Copy matches carry the copy,
+// how it circulates and, on loan, when it is due. Codes matching nothing
+// carry an Error instead.
+type LookupResult struct {
+	Code        string `json:"code"`
+	Match       string `json:"match,omitempty"`
+	Film        *Film  `json:"film,omitempty"`
+	Copy        *Copy  `json:"copy,omitempty"`
+	Circulation string `json:"circulation,omitempty"`
+	DueOn       string `json:"due_on,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
+
+// LookupRequest looks up a batch of scanned codes, as in stocktaking.
+type LookupRequest struct {
+	Codes []string `json:"codes" validate:"required,min=1,max=500,dive,required,max=32"`
+}
@@ -0,0 +1,403 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package model
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonF0340f3aDecodeFilmsLibraryInternalModel(in *jlexer.Lexer, out *ProductCodeRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "code":
+			out.Code = string(in.String())
+		case "format":
+			out.Format = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF0340f3aEncodeFilmsLibraryInternalModel(out *jwriter.Writer, in ProductCodeRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"code\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Code))
+	}
+	{
+		const prefix string = ",\"format\":"
+		out.RawString(prefix)
+		out.String(string(in.Format))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ProductCodeRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF0340f3aEncodeFilmsLibraryInternalModel(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ProductCodeRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF0340f3aEncodeFilmsLibraryInternalModel(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ProductCodeRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF0340f3aDecodeFilmsLibraryInternalModel(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ProductCodeRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF0340f3aDecodeFilmsLibraryInternalModel(l, v)
+}
+func easyjsonF0340f3aDecodeFilmsLibraryInternalModel1(in *jlexer.Lexer, out *ProductCode) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "code":
+			out.Code = string(in.String())
+		case "film_id":
+			out.FilmID = uint64(in.Uint64())
+		case "format":
+			out.Format = string(in.String())
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF0340f3aEncodeFilmsLibraryInternalModel1(out *jwriter.Writer, in ProductCode) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"code\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Code))
+	}
+	{
+		const prefix string = ",\"film_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.FilmID))
+	}
+	if in.Format != "" {
+		const prefix string = ",\"format\":"
+		out.RawString(prefix)
+		out.String(string(in.Format))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ProductCode) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF0340f3aEncodeFilmsLibraryInternalModel1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ProductCode) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF0340f3aEncodeFilmsLibraryInternalModel1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ProductCode) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF0340f3aDecodeFilmsLibraryInternalModel1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ProductCode) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF0340f3aDecodeFilmsLibraryInternalModel1(l, v)
+}
+func easyjsonF0340f3aDecodeFilmsLibraryInternalModel2(in *jlexer.Lexer, out *LookupResult) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "code":
+			out.Code = string(in.String())
+		case "match":
+			out.Match = string(in.String())
+		case "film":
+			if in.IsNull() {
+				in.Skip()
+				out.Film = nil
+			} else {
+				if out.Film == nil {
+					out.Film = new(Film)
+				}
+				(*out.Film).UnmarshalEasyJSON(in)
+			}
+		case "copy":
+			if in.IsNull() {
+				in.Skip()
+				out.Copy = nil
+			} else {
+				if out.Copy == nil {
+					out.Copy = new(Copy)
+				}
+				(*out.Copy).UnmarshalEasyJSON(in)
+			}
+		case "circulation":
+			out.Circulation = string(in.String())
+		case "due_on":
+			out.DueOn = string(in.String())
+		case "error":
+			out.Error = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF0340f3aEncodeFilmsLibraryInternalModel2(out *jwriter.Writer, in LookupResult) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"code\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Code))
+	}
+	if in.Match != "" {
+		const prefix string = ",\"match\":"
+		out.RawString(prefix)
+		out.String(string(in.Match))
+	}
+	if in.Film != nil {
+		const prefix string = ",\"film\":"
+		out.RawString(prefix)
+		(*in.Film).MarshalEasyJSON(out)
+	}
+	if in.Copy != nil {
+		const prefix string = ",\"copy\":"
+		out.RawString(prefix)
+		(*in.Copy).MarshalEasyJSON(out)
+	}
+	if in.Circulation != "" {
+		const prefix string = ",\"circulation\":"
+		out.RawString(prefix)
+		out.String(string(in.Circulation))
+	}
+	if in.DueOn != "" {
+		const prefix string = ",\"due_on\":"
+		out.RawString(prefix)
+		out.String(string(in.DueOn))
+	}
+	if in.Error != "" {
+		const prefix string = ",\"error\":"
+		out.RawString(prefix)
+		out.String(string(in.Error))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LookupResult) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF0340f3aEncodeFilmsLibraryInternalModel2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LookupResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF0340f3aEncodeFilmsLibraryInternalModel2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LookupResult) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF0340f3aDecodeFilmsLibraryInternalModel2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LookupResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF0340f3aDecodeFilmsLibraryInternalModel2(l, v)
+}
+func easyjsonF0340f3aDecodeFilmsLibraryInternalModel3(in *jlexer.Lexer, out *LookupRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "codes":
+			if in.IsNull() {
+				in.Skip()
+				out.Codes = nil
+			} else {
+				in.Delim('[')
+				if out.Codes == nil {
+					if !in.IsDelim(']') {
+						out.Codes = make([]string, 0, 4)
+					} else {
+						out.Codes = []string{}
+					}
+				} else {
+					out.Codes = (out.Codes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 string
+					v1 = string(in.String())
+					out.Codes = append(out.Codes, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF0340f3aEncodeFilmsLibraryInternalModel3(out *jwriter.Writer, in LookupRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"codes\":"
+		out.RawString(prefix[1:])
+		if in.Codes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Codes {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v3))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LookupRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF0340f3aEncodeFilmsLibraryInternalModel3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LookupRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF0340f3aEncodeFilmsLibraryInternalModel3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LookupRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF0340f3aDecodeFilmsLibraryInternalModel3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LookupRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF0340f3aDecodeFilmsLibraryInternalModel3(l, v)
+}
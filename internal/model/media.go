@@ -0,0 +1,75 @@
+package model
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"time"
+)
+
+// Sizes an image is served in: the upload as it is, or a thumbnail that many
+// pixels wide.
+const (
+	ImageOriginal = "original"
+	ImageW92      = "w92"
+	ImageW185     = "w185"
+	ImageW500     = "w500"
+)
+
+// ThumbnailWidths are the widths of the thumbnails made of every image.
+var ThumbnailWidths = map[string]int{
+	ImageW92:  92,
+	ImageW185: 185,
+	ImageW500: 500,
+}
+
+// Image is the poster of a film or the headshot of an actor. Its ETag changes
+// whenever it is replaced.
+type Image struct {
+	ContentType string    `json:"content_type"`
+	Width       int       `json:"width"`
+	Height      int       `json:"height"`
+	Size        int64     `json:"size"`
+	ETag        string    `json:"etag"`
+	CreatedAt   time.Time `json:"created_at"`
+	URLs        ImageURLs `json:"urls"`
+}
+
+// ImageURLs are where each size of an image is served. They carry the ETag
+// of the image, so their responses may be cached for good.
+type ImageURLs struct {
+	Original string `json:"original"`
+	W92      string `json:"w92"`
+	W185     string `json:"w185"`
+	W500     string `json:"w500"`
+}
+
+// ImageFile is one size of an image, as served. ETag is that of the image.
+type ImageFile struct {
+	Body        io.ReadCloser `json:"-"`
+	ContentType string        `json:"-"`
+	ETag        string        `json:"-"`
+}
+
+// ImagePath is where the image of a film or actor is served:
+// /films/{id}/poster or /actors/{id}/headshot.
+func ImagePath(entity string, id uint64) string {
+	if entity == EntityActor {
+		return fmt.Sprintf("/actors/%d/headshot", id)
+	}
+	return fmt.Sprintf("/films/%d/poster", id)
+}
+
+// NewImageURLs returns the URLs of the image of a film or actor with etag.
+func NewImageURLs(entity string, id uint64, etag string) ImageURLs {
+	path := ImagePath(entity, id)
+	at := func(size string) string {
+		return path + "?" + url.Values{"size": {size}, "v": {etag}}.Encode()
+	}
+	return ImageURLs{
+		Original: at(ImageOriginal),
+		W92:      at(ImageW92),
+		W185:     at(ImageW185),
+		W500:     at(ImageW500),
+	}
+}
@@ -0,0 +1,275 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package model
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson52202312DecodeFilmsLibraryInternalModel(in *jlexer.Lexer, out *ImageURLs) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "original":
+			out.Original = string(in.String())
+		case "w92":
+			out.W92 = string(in.String())
+		case "w185":
+			out.W185 = string(in.String())
+		case "w500":
+			out.W500 = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson52202312EncodeFilmsLibraryInternalModel(out *jwriter.Writer, in ImageURLs) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"original\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Original))
+	}
+	{
+		const prefix string = ",\"w92\":"
+		out.RawString(prefix)
+		out.String(string(in.W92))
+	}
+	{
+		const prefix string = ",\"w185\":"
+		out.RawString(prefix)
+		out.String(string(in.W185))
+	}
+	{
+		const prefix string = ",\"w500\":"
+		out.RawString(prefix)
+		out.String(string(in.W500))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ImageURLs) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson52202312EncodeFilmsLibraryInternalModel(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ImageURLs) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson52202312EncodeFilmsLibraryInternalModel(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ImageURLs) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson52202312DecodeFilmsLibraryInternalModel(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ImageURLs) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson52202312DecodeFilmsLibraryInternalModel(l, v)
+}
+func easyjson52202312DecodeFilmsLibraryInternalModel1(in *jlexer.Lexer, out *ImageFile) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson52202312EncodeFilmsLibraryInternalModel1(out *jwriter.Writer, in ImageFile) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ImageFile) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson52202312EncodeFilmsLibraryInternalModel1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ImageFile) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson52202312EncodeFilmsLibraryInternalModel1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ImageFile) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson52202312DecodeFilmsLibraryInternalModel1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ImageFile) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson52202312DecodeFilmsLibraryInternalModel1(l, v)
+}
+func easyjson52202312DecodeFilmsLibraryInternalModel2(in *jlexer.Lexer, out *Image) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "content_type":
+			out.ContentType = string(in.String())
+		case "width":
+			out.Width = int(in.Int())
+		case "height":
+			out.Height = int(in.Int())
+		case "size":
+			out.Size = int64(in.Int64())
+		case "etag":
+			out.ETag = string(in.String())
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "urls":
+			(out.URLs).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson52202312EncodeFilmsLibraryInternalModel2(out *jwriter.Writer, in Image) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"content_type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ContentType))
+	}
+	{
+		const prefix string = ",\"width\":"
+		out.RawString(prefix)
+		out.Int(int(in.Width))
+	}
+	{
+		const prefix string = ",\"height\":"
+		out.RawString(prefix)
+		out.Int(int(in.Height))
+	}
+	{
+		const prefix string = ",\"size\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Size))
+	}
+	{
+		const prefix string = ",\"etag\":"
+		out.RawString(prefix)
+		out.String(string(in.ETag))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"urls\":"
+		out.RawString(prefix)
+		(in.URLs).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Image) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson52202312EncodeFilmsLibraryInternalModel2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Image) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson52202312EncodeFilmsLibraryInternalModel2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Image) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson52202312DecodeFilmsLibraryInternalModel2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Image) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson52202312DecodeFilmsLibraryInternalModel2(l, v)
+}
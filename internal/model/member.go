@@ -0,0 +1,151 @@
+package model
+
+import (
+	"time"
+
+	"films_library/pkg/pagination"
+)
+
+const (
+	TierStandard = "standard"
+	TierPremium  = "premium"
+	TierStudent  = "student"
+)
+
+// Suspended members may not borrow. Members suspended for debt are
+// reinstated once their balance is back under the threshold; manual
+// suspensions are lifted by staff only.
+const (
+	MemberActive    = "active"
+	MemberSuspended = "suspended"
+
+	SuspensionDebt   = "debt"
+	SuspensionManual = "manual"
+)
+
+// Ledger entries are charges, which raise the balance of a member, and
+// payments and waivers, which lower it.
+const (
+	EntryCharge  = "charge"
+	EntryPayment = "payment"
+	EntryWaiver  = "waiver"
+
+	ChargeMembership  = "membership"
+	ChargeOverdue     = "overdue"
+	ChargeReplacement = "replacement"
+	ChargeOther       = "other"
+)
+
+// Ledger accounts. Receivable holds what members owe, cash what they paid,
+// fee_income and fine_income what they were charged and waivers what was
+// written off.
+const (
+	AccountReceivable = "receivable"
+	AccountCash       = "cash"
+	AccountFeeIncome  = "fee_income"
+	AccountFineIncome = "fine_income"
+	AccountWaivers    = "waivers"
+)
+
+// Member is the library membership of a user. Balance is what the member
+// owes, in minor currency units; it is negative for members in credit.
+type Member struct {
+	UserID     uint64    `json:"user_id"`
+	Username   string    `json:"username"`
+	Tier       string    `json:"tier"`
+	ExpiresOn  string    `json:"expires_on"`
+	Status     string    `json:"status"`
+	Suspension string    `json:"suspension,omitempty"`
+	Email      string    `json:"email"`
+	Phone      string    `json:"phone"`
+	Address    string    `json:"address"`
+	Balance    int64     `json:"balance"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// MemberRequest enrolls a user, or updates a membership when UserID is
+// taken from the path.
+type MemberRequest struct {
+	UserID    uint64 `json:"user_id"`
+	Tier      string `json:"tier"       validate:"required,oneof=standard premium student"`
+	ExpiresOn string `json:"expires_on" validate:"required,datetime=2006-01-02"`
+	Email     string `json:"email"      validate:"omitempty,email,max=254"`
+	Phone     string `json:"phone"      validate:"max=32"`
+	Address   string `json:"address"    validate:"max=500"`
+}
+
+type MemberFilter struct {
+	Status string `validate:"omitempty,oneof=active suspended"`
+	Tier   string `validate:"omitempty,oneof=standard premium student"`
+	pagination.Params
+}
+
+// LedgerEntry is a charge, payment or waiver of a member. Amount is
+// positive, in minor currency units; Balance is what the member owed right
+// after it was posted.
+type LedgerEntry struct {
+	ID       uint64       `json:"entry_id"`
+	MemberID uint64       `json:"member_id"`
+	Kind     string       `json:"kind"`
+	Category string       `json:"category,omitempty"`
+	Amount   int64        `json:"amount"`
+	LoanID   *uint64      `json:"loan_id,omitempty"`
+	Memo     string       `json:"memo"`
+	PostedBy uint64       `json:"posted_by,omitempty"`
+	PostedAt time.Time    `json:"posted_at"`
+	Balance  int64        `json:"balance"`
+	Lines    []LedgerLine `json:"-"`
+}
+
+// LedgerLine posts part of an entry to an account. Debits are positive,
+// credits negative, and the lines of an entry sum to zero.
+type LedgerLine struct {
+	Account string `json:"account"`
+	Amount  int64  `json:"amount"`
+}
+
+// ChargeRequest charges a member at the configured rate of its category.
+// Overdue fines are charged for a loan of the member; only other charges
+// name their amount.
+type ChargeRequest struct {
+	Category string  `json:"category" validate:"required,oneof=membership overdue replacement other"`
+	LoanID   *uint64 `json:"loan_id"  validate:"required_if=Category overdue"`
+	Amount   int64   `json:"amount"   validate:"required_if=Category other,excluded_unless=Category other,min=0"`
+	Memo     string  `json:"memo"     validate:"max=500"`
+}
+
+// PaymentRequest records a payment or a waiver.
+type PaymentRequest struct {
+	Amount int64  `json:"amount" validate:"required,min=1"`
+	Memo   string `json:"memo"   validate:"max=500"`
+}
+
+// Balance is what a member owes and the debt above which it is suspended.
+type Balance struct {
+	MemberID     uint64 `json:"member_id"`
+	Balance      int64  `json:"balance"`
+	SuspendAbove int64  `json:"suspend_above"`
+	Status       string `json:"status"`
+}
+
+// AccountBalance is the sum of the lines posted to a ledger account.
+type AccountBalance struct {
+	Account string `json:"account"`
+	Balance int64  `json:"balance"`
+}
+
+type StatementFilter struct {
+	MemberID uint64
+	pagination.Params
+}
+
+// FeeSchedule is the rates members are charged, and the debt above which
+// they are suspended, in minor currency units.
+type FeeSchedule struct {
+	Membership    int64
+	Replacement   int64
+	OverduePerDay int64
+	OverdueMax    int64
+	SuspendAbove  int64
+}
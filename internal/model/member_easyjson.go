@@ -0,0 +1,1125 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package model
+
+import (
+	json "encoding/json"
+	pagination "films_library/pkg/pagination"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonA37a3d7eDecodeFilmsLibraryInternalModel(in *jlexer.Lexer, out *StatementFilter) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "MemberID":
+			out.MemberID = uint64(in.Uint64())
+		case "Limit":
+			out.Limit = int(in.Int())
+		case "Offset":
+			out.Offset = int(in.Int())
+		case "Cursor":
+			if in.IsNull() {
+				in.Skip()
+				out.Cursor = nil
+			} else {
+				if out.Cursor == nil {
+					out.Cursor = new(pagination.Cursor)
+				}
+				(*out.Cursor).UnmarshalEasyJSON(in)
+			}
+		case "WithTotal":
+			out.WithTotal = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonA37a3d7eEncodeFilmsLibraryInternalModel(out *jwriter.Writer, in StatementFilter) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"MemberID\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.MemberID))
+	}
+	{
+		const prefix string = ",\"Limit\":"
+		out.RawString(prefix)
+		out.Int(int(in.Limit))
+	}
+	{
+		const prefix string = ",\"Offset\":"
+		out.RawString(prefix)
+		out.Int(int(in.Offset))
+	}
+	{
+		const prefix string = ",\"Cursor\":"
+		out.RawString(prefix)
+		if in.Cursor == nil {
+			out.RawString("null")
+		} else {
+			(*in.Cursor).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"WithTotal\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.WithTotal))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v StatementFilter) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonA37a3d7eEncodeFilmsLibraryInternalModel(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v StatementFilter) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonA37a3d7eEncodeFilmsLibraryInternalModel(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *StatementFilter) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonA37a3d7eDecodeFilmsLibraryInternalModel(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *StatementFilter) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonA37a3d7eDecodeFilmsLibraryInternalModel(l, v)
+}
+func easyjsonA37a3d7eDecodeFilmsLibraryInternalModel1(in *jlexer.Lexer, out *PaymentRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "amount":
+			out.Amount = int64(in.Int64())
+		case "memo":
+			out.Memo = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonA37a3d7eEncodeFilmsLibraryInternalModel1(out *jwriter.Writer, in PaymentRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.Amount))
+	}
+	{
+		const prefix string = ",\"memo\":"
+		out.RawString(prefix)
+		out.String(string(in.Memo))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PaymentRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonA37a3d7eEncodeFilmsLibraryInternalModel1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PaymentRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonA37a3d7eEncodeFilmsLibraryInternalModel1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PaymentRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonA37a3d7eDecodeFilmsLibraryInternalModel1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PaymentRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonA37a3d7eDecodeFilmsLibraryInternalModel1(l, v)
+}
+func easyjsonA37a3d7eDecodeFilmsLibraryInternalModel2(in *jlexer.Lexer, out *MemberRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_id":
+			out.UserID = uint64(in.Uint64())
+		case "tier":
+			out.Tier = string(in.String())
+		case "expires_on":
+			out.ExpiresOn = string(in.String())
+		case "email":
+			out.Email = string(in.String())
+		case "phone":
+			out.Phone = string(in.String())
+		case "address":
+			out.Address = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonA37a3d7eEncodeFilmsLibraryInternalModel2(out *jwriter.Writer, in MemberRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.UserID))
+	}
+	{
+		const prefix string = ",\"tier\":"
+		out.RawString(prefix)
+		out.String(string(in.Tier))
+	}
+	{
+		const prefix string = ",\"expires_on\":"
+		out.RawString(prefix)
+		out.String(string(in.ExpiresOn))
+	}
+	{
+		const prefix string = ",\"email\":"
+		out.RawString(prefix)
+		out.String(string(in.Email))
+	}
+	{
+		const prefix string = ",\"phone\":"
+		out.RawString(prefix)
+		out.String(string(in.Phone))
+	}
+	{
+		const prefix string = ",\"address\":"
+		out.RawString(prefix)
+		out.String(string(in.Address))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MemberRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonA37a3d7eEncodeFilmsLibraryInternalModel2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MemberRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonA37a3d7eEncodeFilmsLibraryInternalModel2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MemberRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonA37a3d7eDecodeFilmsLibraryInternalModel2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MemberRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonA37a3d7eDecodeFilmsLibraryInternalModel2(l, v)
+}
+func easyjsonA37a3d7eDecodeFilmsLibraryInternalModel3(in *jlexer.Lexer, out *MemberFilter) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Status":
+			out.Status = string(in.String())
+		case "Tier":
+			out.Tier = string(in.String())
+		case "Limit":
+			out.Limit = int(in.Int())
+		case "Offset":
+			out.Offset = int(in.Int())
+		case "Cursor":
+			if in.IsNull() {
+				in.Skip()
+				out.Cursor = nil
+			} else {
+				if out.Cursor == nil {
+					out.Cursor = new(pagination.Cursor)
+				}
+				(*out.Cursor).UnmarshalEasyJSON(in)
+			}
+		case "WithTotal":
+			out.WithTotal = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonA37a3d7eEncodeFilmsLibraryInternalModel3(out *jwriter.Writer, in MemberFilter) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Status\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"Tier\":"
+		out.RawString(prefix)
+		out.String(string(in.Tier))
+	}
+	{
+		const prefix string = ",\"Limit\":"
+		out.RawString(prefix)
+		out.Int(int(in.Limit))
+	}
+	{
+		const prefix string = ",\"Offset\":"
+		out.RawString(prefix)
+		out.Int(int(in.Offset))
+	}
+	{
+		const prefix string = ",\"Cursor\":"
+		out.RawString(prefix)
+		if in.Cursor == nil {
+			out.RawString("null")
+		} else {
+			(*in.Cursor).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"WithTotal\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.WithTotal))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MemberFilter) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonA37a3d7eEncodeFilmsLibraryInternalModel3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MemberFilter) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonA37a3d7eEncodeFilmsLibraryInternalModel3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MemberFilter) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonA37a3d7eDecodeFilmsLibraryInternalModel3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MemberFilter) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonA37a3d7eDecodeFilmsLibraryInternalModel3(l, v)
+}
+func easyjsonA37a3d7eDecodeFilmsLibraryInternalModel4(in *jlexer.Lexer, out *Member) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_id":
+			out.UserID = uint64(in.Uint64())
+		case "username":
+			out.Username = string(in.String())
+		case "tier":
+			out.Tier = string(in.String())
+		case "expires_on":
+			out.ExpiresOn = string(in.String())
+		case "status":
+			out.Status = string(in.String())
+		case "suspension":
+			out.Suspension = string(in.String())
+		case "email":
+			out.Email = string(in.String())
+		case "phone":
+			out.Phone = string(in.String())
+		case "address":
+			out.Address = string(in.String())
+		case "balance":
+			out.Balance = int64(in.Int64())
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonA37a3d7eEncodeFilmsLibraryInternalModel4(out *jwriter.Writer, in Member) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.UserID))
+	}
+	{
+		const prefix string = ",\"username\":"
+		out.RawString(prefix)
+		out.String(string(in.Username))
+	}
+	{
+		const prefix string = ",\"tier\":"
+		out.RawString(prefix)
+		out.String(string(in.Tier))
+	}
+	{
+		const prefix string = ",\"expires_on\":"
+		out.RawString(prefix)
+		out.String(string(in.ExpiresOn))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	if in.Suspension != "" {
+		const prefix string = ",\"suspension\":"
+		out.RawString(prefix)
+		out.String(string(in.Suspension))
+	}
+	{
+		const prefix string = ",\"email\":"
+		out.RawString(prefix)
+		out.String(string(in.Email))
+	}
+	{
+		const prefix string = ",\"phone\":"
+		out.RawString(prefix)
+		out.String(string(in.Phone))
+	}
+	{
+		const prefix string = ",\"address\":"
+		out.RawString(prefix)
+		out.String(string(in.Address))
+	}
+	{
+		const prefix string = ",\"balance\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Balance))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Member) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonA37a3d7eEncodeFilmsLibraryInternalModel4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Member) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonA37a3d7eEncodeFilmsLibraryInternalModel4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Member) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonA37a3d7eDecodeFilmsLibraryInternalModel4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Member) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonA37a3d7eDecodeFilmsLibraryInternalModel4(l, v)
+}
+func easyjsonA37a3d7eDecodeFilmsLibraryInternalModel5(in *jlexer.Lexer, out *LedgerLine) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "account":
+			out.Account = string(in.String())
+		case "amount":
+			out.Amount = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonA37a3d7eEncodeFilmsLibraryInternalModel5(out *jwriter.Writer, in LedgerLine) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"account\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Account))
+	}
+	{
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Amount))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LedgerLine) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonA37a3d7eEncodeFilmsLibraryInternalModel5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LedgerLine) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonA37a3d7eEncodeFilmsLibraryInternalModel5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LedgerLine) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonA37a3d7eDecodeFilmsLibraryInternalModel5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LedgerLine) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonA37a3d7eDecodeFilmsLibraryInternalModel5(l, v)
+}
+func easyjsonA37a3d7eDecodeFilmsLibraryInternalModel6(in *jlexer.Lexer, out *LedgerEntry) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "entry_id":
+			out.ID = uint64(in.Uint64())
+		case "member_id":
+			out.MemberID = uint64(in.Uint64())
+		case "kind":
+			out.Kind = string(in.String())
+		case "category":
+			out.Category = string(in.String())
+		case "amount":
+			out.Amount = int64(in.Int64())
+		case "loan_id":
+			if in.IsNull() {
+				in.Skip()
+				out.LoanID = nil
+			} else {
+				if out.LoanID == nil {
+					out.LoanID = new(uint64)
+				}
+				*out.LoanID = uint64(in.Uint64())
+			}
+		case "memo":
+			out.Memo = string(in.String())
+		case "posted_by":
+			out.PostedBy = uint64(in.Uint64())
+		case "posted_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.PostedAt).UnmarshalJSON(data))
+			}
+		case "balance":
+			out.Balance = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonA37a3d7eEncodeFilmsLibraryInternalModel6(out *jwriter.Writer, in LedgerEntry) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"entry_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.ID))
+	}
+	{
+		const prefix string = ",\"member_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.MemberID))
+	}
+	{
+		const prefix string = ",\"kind\":"
+		out.RawString(prefix)
+		out.String(string(in.Kind))
+	}
+	if in.Category != "" {
+		const prefix string = ",\"category\":"
+		out.RawString(prefix)
+		out.String(string(in.Category))
+	}
+	{
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Amount))
+	}
+	if in.LoanID != nil {
+		const prefix string = ",\"loan_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(*in.LoanID))
+	}
+	{
+		const prefix string = ",\"memo\":"
+		out.RawString(prefix)
+		out.String(string(in.Memo))
+	}
+	if in.PostedBy != 0 {
+		const prefix string = ",\"posted_by\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.PostedBy))
+	}
+	{
+		const prefix string = ",\"posted_at\":"
+		out.RawString(prefix)
+		out.Raw((in.PostedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"balance\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Balance))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LedgerEntry) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonA37a3d7eEncodeFilmsLibraryInternalModel6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LedgerEntry) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonA37a3d7eEncodeFilmsLibraryInternalModel6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LedgerEntry) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonA37a3d7eDecodeFilmsLibraryInternalModel6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LedgerEntry) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonA37a3d7eDecodeFilmsLibraryInternalModel6(l, v)
+}
+func easyjsonA37a3d7eDecodeFilmsLibraryInternalModel7(in *jlexer.Lexer, out *FeeSchedule) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Membership":
+			out.Membership = int64(in.Int64())
+		case "Replacement":
+			out.Replacement = int64(in.Int64())
+		case "OverduePerDay":
+			out.OverduePerDay = int64(in.Int64())
+		case "OverdueMax":
+			out.OverdueMax = int64(in.Int64())
+		case "SuspendAbove":
+			out.SuspendAbove = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonA37a3d7eEncodeFilmsLibraryInternalModel7(out *jwriter.Writer, in FeeSchedule) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Membership\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.Membership))
+	}
+	{
+		const prefix string = ",\"Replacement\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Replacement))
+	}
+	{
+		const prefix string = ",\"OverduePerDay\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.OverduePerDay))
+	}
+	{
+		const prefix string = ",\"OverdueMax\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.OverdueMax))
+	}
+	{
+		const prefix string = ",\"SuspendAbove\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.SuspendAbove))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FeeSchedule) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonA37a3d7eEncodeFilmsLibraryInternalModel7(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FeeSchedule) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonA37a3d7eEncodeFilmsLibraryInternalModel7(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FeeSchedule) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonA37a3d7eDecodeFilmsLibraryInternalModel7(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FeeSchedule) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonA37a3d7eDecodeFilmsLibraryInternalModel7(l, v)
+}
+func easyjsonA37a3d7eDecodeFilmsLibraryInternalModel8(in *jlexer.Lexer, out *ChargeRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "category":
+			out.Category = string(in.String())
+		case "loan_id":
+			if in.IsNull() {
+				in.Skip()
+				out.LoanID = nil
+			} else {
+				if out.LoanID == nil {
+					out.LoanID = new(uint64)
+				}
+				*out.LoanID = uint64(in.Uint64())
+			}
+		case "amount":
+			out.Amount = int64(in.Int64())
+		case "memo":
+			out.Memo = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonA37a3d7eEncodeFilmsLibraryInternalModel8(out *jwriter.Writer, in ChargeRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"category\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Category))
+	}
+	{
+		const prefix string = ",\"loan_id\":"
+		out.RawString(prefix)
+		if in.LoanID == nil {
+			out.RawString("null")
+		} else {
+			out.Uint64(uint64(*in.LoanID))
+		}
+	}
+	{
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Amount))
+	}
+	{
+		const prefix string = ",\"memo\":"
+		out.RawString(prefix)
+		out.String(string(in.Memo))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ChargeRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonA37a3d7eEncodeFilmsLibraryInternalModel8(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ChargeRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonA37a3d7eEncodeFilmsLibraryInternalModel8(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ChargeRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonA37a3d7eDecodeFilmsLibraryInternalModel8(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ChargeRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonA37a3d7eDecodeFilmsLibraryInternalModel8(l, v)
+}
+func easyjsonA37a3d7eDecodeFilmsLibraryInternalModel9(in *jlexer.Lexer, out *Balance) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "member_id":
+			out.MemberID = uint64(in.Uint64())
+		case "balance":
+			out.Balance = int64(in.Int64())
+		case "suspend_above":
+			out.SuspendAbove = int64(in.Int64())
+		case "status":
+			out.Status = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonA37a3d7eEncodeFilmsLibraryInternalModel9(out *jwriter.Writer, in Balance) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"member_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.MemberID))
+	}
+	{
+		const prefix string = ",\"balance\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Balance))
+	}
+	{
+		const prefix string = ",\"suspend_above\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.SuspendAbove))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Balance) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonA37a3d7eEncodeFilmsLibraryInternalModel9(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Balance) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonA37a3d7eEncodeFilmsLibraryInternalModel9(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Balance) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonA37a3d7eDecodeFilmsLibraryInternalModel9(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Balance) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonA37a3d7eDecodeFilmsLibraryInternalModel9(l, v)
+}
+func easyjsonA37a3d7eDecodeFilmsLibraryInternalModel10(in *jlexer.Lexer, out *AccountBalance) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "account":
+			out.Account = string(in.String())
+		case "balance":
+			out.Balance = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonA37a3d7eEncodeFilmsLibraryInternalModel10(out *jwriter.Writer, in AccountBalance) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"account\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Account))
+	}
+	{
+		const prefix string = ",\"balance\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Balance))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AccountBalance) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonA37a3d7eEncodeFilmsLibraryInternalModel10(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AccountBalance) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonA37a3d7eEncodeFilmsLibraryInternalModel10(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AccountBalance) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonA37a3d7eDecodeFilmsLibraryInternalModel10(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AccountBalance) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonA37a3d7eDecodeFilmsLibraryInternalModel10(l, v)
+}
@@ -0,0 +1,63 @@
+package model
+
+import (
+	"math"
+	"time"
+
+	"films_library/pkg/pagination"
+)
+
+const (
+	ReviewPending  = "pending"
+	ReviewApproved = "approved"
+	ReviewRejected = "rejected"
+)
+
+// Review is the rating of a film by a user, with an optional text. Reviews
+// with text wait in ReviewPending until a moderator approves them, bare
+// ratings are approved right away. Every review that is not rejected counts
+// towards the community score of its film.
+type Review struct {
+	ID        uint64    `json:"review_id"`
+	FilmID    uint64    `json:"film_id"`
+	UserID    uint64    `json:"user_id"`
+	Username  string    `json:"username"`
+	Score     int       `json:"score"`
+	Body      string    `json:"body"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type ReviewRequest struct {
+	Score int    `json:"score" validate:"required,min=1,max=10"`
+	Body  string `json:"body"  validate:"max=5000"`
+}
+
+type ModerateReviewRequest struct {
+	Status string `json:"status" validate:"required,oneof=approved rejected"`
+}
+
+type ReviewFilter struct {
+	FilmID uint64
+	Status string `validate:"omitempty,oneof=pending approved rejected"`
+	pagination.Params
+}
+
+// ScorePrior is the Bayesian prior user ratings are averaged with: Weight
+// virtual ratings of Mean, so that films with a handful of ratings stay near
+// Mean instead of jumping to the extremes.
+type ScorePrior struct {
+	Mean   float64
+	Weight float64
+}
+
+// Score is the weighted average of count ratings adding up to sum, rounded
+// to two decimals.
+func (p ScorePrior) Score(sum, count int64) float64 {
+	if p.Weight+float64(count) == 0 {
+		return 0
+	}
+	score := (p.Mean*p.Weight + float64(sum)) / (p.Weight + float64(count))
+	return math.Round(score*100) / 100
+}
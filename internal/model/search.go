@@ -0,0 +1,22 @@
+package model
+
+import "films_library/pkg/pagination"
+
+const (
+	SearchFilms  = "film"
+	SearchActors = "actor"
+)
+
+type SearchResult struct {
+	Type    string  `json:"type"`
+	ID      uint64  `json:"id"`
+	Title   string  `json:"title"`
+	Score   float64 `json:"score"`
+	Snippet string  `json:"snippet"`
+}
+
+type SearchQuery struct {
+	Query string `validate:"required,max=200"`
+	Type  string `validate:"omitempty,oneof=film actor"`
+	pagination.Params
+}
@@ -0,0 +1,36 @@
+package model
+
+import "films_library/pkg/pagination"
+
+// Taxonomies films can be classified under.
+const (
+	TaxonomyGenre = "genre"
+	TaxonomyTag   = "tag"
+)
+
+const (
+	MatchAny = "any"
+	MatchAll = "all"
+)
+
+// Term is a genre or a tag.
+type Term struct {
+	ID   uint64 `json:"id"`
+	Name string `json:"name" validate:"required,max=50"`
+}
+
+type TermFilter struct {
+	Taxonomy string `validate:"oneof=genre tag"`
+	pagination.Params
+}
+
+// FilmFacets counts the films of a listing per genre, for facet sidebars.
+type FilmFacets struct {
+	Genres []GenreFacet `json:"genres"`
+}
+
+type GenreFacet struct {
+	GenreID uint64 `json:"genre_id"`
+	Name    string `json:"name"`
+	Films   int64  `json:"films"`
+}
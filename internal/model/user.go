@@ -0,0 +1,77 @@
+package model
+
+import (
+	"time"
+
+	"films_library/pkg/pagination"
+)
+
+const (
+	RoleViewer = "viewer"
+	RoleEditor = "editor"
+	RoleAdmin  = "admin"
+)
+
+type User struct {
+	ID           uint64 `json:"user_id"`
+	Username     string `json:"username"`
+	Role         string `json:"role"`
+	PasswordHash string `json:"-"`
+}
+
+type LoginRequest struct {
+	Username string `json:"username" validate:"required,max=64"`
+	Password string `json:"password" validate:"required,max=72"`
+}
+
+type AddUserRequest struct {
+	Username string `json:"username" validate:"required,max=64"`
+	Password string `json:"password" validate:"required,min=8,max=72"`
+	Role     string `json:"role"     validate:"required,oneof=viewer editor admin"`
+}
+
+type SetRoleRequest struct {
+	Role string `json:"role" validate:"required,oneof=viewer editor admin"`
+}
+
+type UserFilter struct {
+	pagination.Params
+}
+
+// Session is a server-side login. ID is the hash stored in the database;
+// Token is the cookie value and is only known right after it is issued.
+type Session struct {
+	ID        string    `json:"-"`
+	Token     string    `json:"-"`
+	UserID    uint64    `json:"user_id"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// Token is a signed bearer token for machine clients.
+type Token struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// APIKey is a long-lived, scoped credential. Key is only set in the response
+// that creates it; afterwards the key is known by its Prefix alone.
+type APIKey struct {
+	ID         uint64     `json:"key_id"`
+	UserID     uint64     `json:"user_id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Key        string     `json:"key,omitempty"`
+	Hash       string     `json:"-"`
+	Scopes     []string   `json:"scopes"`
+	CreatedAt  time.Time  `json:"created_at"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+}
+
+type AddAPIKeyRequest struct {
+	Name      string     `json:"name"       validate:"required,max=100"`
+	Scopes    []string   `json:"scopes"     validate:"required,min=1,max=20,dive,required"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
@@ -0,0 +1,42 @@
+package model
+
+import (
+	"time"
+
+	"films_library/pkg/pagination"
+)
+
+// WatchlistEntry is a film a user means to watch. Watchlists are ordered by
+// Position, lowest first.
+type WatchlistEntry struct {
+	Position int       `json:"position"`
+	AddedAt  time.Time `json:"added_at"`
+	Film     Film      `json:"film"`
+}
+
+// FilmOrder lists every film of a watchlist or collection in its new order.
+type FilmOrder struct {
+	FilmIDs []uint64 `json:"film_ids" validate:"required,max=1000,unique,dive,min=1"`
+}
+
+// HistoryEntry records that a user watched a film on a day. RewatchCount is
+// how many times the user had seen it before.
+type HistoryEntry struct {
+	ID           uint64 `json:"entry_id"`
+	WatchedOn    string `json:"watched_on"`
+	RewatchCount int    `json:"rewatch_count"`
+	Film         Film   `json:"film"`
+}
+
+// AddHistoryRequest records a viewing. Without RewatchCount it is counted
+// from the earlier entries of the user for the film.
+type AddHistoryRequest struct {
+	FilmID       uint64 `json:"film_id"                 validate:"required"`
+	WatchedOn    string `json:"watched_on"              validate:"required,datetime=2006-01-02"`
+	RewatchCount *int   `json:"rewatch_count,omitempty" validate:"omitempty,min=0,max=1000"`
+}
+
+type ListFilter struct {
+	UserID uint64
+	pagination.Params
+}
@@ -0,0 +1,481 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package model
+
+import (
+	json "encoding/json"
+	pagination "films_library/pkg/pagination"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson9485b863DecodeFilmsLibraryInternalModel(in *jlexer.Lexer, out *WatchlistEntry) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "position":
+			out.Position = int(in.Int())
+		case "added_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.AddedAt).UnmarshalJSON(data))
+			}
+		case "film":
+			(out.Film).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson9485b863EncodeFilmsLibraryInternalModel(out *jwriter.Writer, in WatchlistEntry) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"position\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Position))
+	}
+	{
+		const prefix string = ",\"added_at\":"
+		out.RawString(prefix)
+		out.Raw((in.AddedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"film\":"
+		out.RawString(prefix)
+		(in.Film).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WatchlistEntry) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson9485b863EncodeFilmsLibraryInternalModel(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WatchlistEntry) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson9485b863EncodeFilmsLibraryInternalModel(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WatchlistEntry) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson9485b863DecodeFilmsLibraryInternalModel(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WatchlistEntry) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson9485b863DecodeFilmsLibraryInternalModel(l, v)
+}
+func easyjson9485b863DecodeFilmsLibraryInternalModel1(in *jlexer.Lexer, out *ListFilter) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "UserID":
+			out.UserID = uint64(in.Uint64())
+		case "Limit":
+			out.Limit = int(in.Int())
+		case "Offset":
+			out.Offset = int(in.Int())
+		case "Cursor":
+			if in.IsNull() {
+				in.Skip()
+				out.Cursor = nil
+			} else {
+				if out.Cursor == nil {
+					out.Cursor = new(pagination.Cursor)
+				}
+				(*out.Cursor).UnmarshalEasyJSON(in)
+			}
+		case "WithTotal":
+			out.WithTotal = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson9485b863EncodeFilmsLibraryInternalModel1(out *jwriter.Writer, in ListFilter) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"UserID\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.UserID))
+	}
+	{
+		const prefix string = ",\"Limit\":"
+		out.RawString(prefix)
+		out.Int(int(in.Limit))
+	}
+	{
+		const prefix string = ",\"Offset\":"
+		out.RawString(prefix)
+		out.Int(int(in.Offset))
+	}
+	{
+		const prefix string = ",\"Cursor\":"
+		out.RawString(prefix)
+		if in.Cursor == nil {
+			out.RawString("null")
+		} else {
+			(*in.Cursor).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"WithTotal\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.WithTotal))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ListFilter) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson9485b863EncodeFilmsLibraryInternalModel1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ListFilter) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson9485b863EncodeFilmsLibraryInternalModel1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ListFilter) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson9485b863DecodeFilmsLibraryInternalModel1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ListFilter) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson9485b863DecodeFilmsLibraryInternalModel1(l, v)
+}
+func easyjson9485b863DecodeFilmsLibraryInternalModel2(in *jlexer.Lexer, out *HistoryEntry) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "entry_id":
+			out.ID = uint64(in.Uint64())
+		case "watched_on":
+			out.WatchedOn = string(in.String())
+		case "rewatch_count":
+			out.RewatchCount = int(in.Int())
+		case "film":
+			(out.Film).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson9485b863EncodeFilmsLibraryInternalModel2(out *jwriter.Writer, in HistoryEntry) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"entry_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.ID))
+	}
+	{
+		const prefix string = ",\"watched_on\":"
+		out.RawString(prefix)
+		out.String(string(in.WatchedOn))
+	}
+	{
+		const prefix string = ",\"rewatch_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.RewatchCount))
+	}
+	{
+		const prefix string = ",\"film\":"
+		out.RawString(prefix)
+		(in.Film).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v HistoryEntry) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson9485b863EncodeFilmsLibraryInternalModel2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v HistoryEntry) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson9485b863EncodeFilmsLibraryInternalModel2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *HistoryEntry) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson9485b863DecodeFilmsLibraryInternalModel2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *HistoryEntry) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson9485b863DecodeFilmsLibraryInternalModel2(l, v)
+}
+func easyjson9485b863DecodeFilmsLibraryInternalModel3(in *jlexer.Lexer, out *FilmOrder) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "film_ids":
+			if in.IsNull() {
+				in.Skip()
+				out.FilmIDs = nil
+			} else {
+				in.Delim('[')
+				if out.FilmIDs == nil {
+					if !in.IsDelim(']') {
+						out.FilmIDs = make([]uint64, 0, 8)
+					} else {
+						out.FilmIDs = []uint64{}
+					}
+				} else {
+					out.FilmIDs = (out.FilmIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 uint64
+					v1 = uint64(in.Uint64())
+					out.FilmIDs = append(out.FilmIDs, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson9485b863EncodeFilmsLibraryInternalModel3(out *jwriter.Writer, in FilmOrder) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"film_ids\":"
+		out.RawString(prefix[1:])
+		if in.FilmIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.FilmIDs {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				out.Uint64(uint64(v3))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FilmOrder) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson9485b863EncodeFilmsLibraryInternalModel3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FilmOrder) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson9485b863EncodeFilmsLibraryInternalModel3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FilmOrder) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson9485b863DecodeFilmsLibraryInternalModel3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FilmOrder) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson9485b863DecodeFilmsLibraryInternalModel3(l, v)
+}
+func easyjson9485b863DecodeFilmsLibraryInternalModel4(in *jlexer.Lexer, out *AddHistoryRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "film_id":
+			out.FilmID = uint64(in.Uint64())
+		case "watched_on":
+			out.WatchedOn = string(in.String())
+		case "rewatch_count":
+			if in.IsNull() {
+				in.Skip()
+				out.RewatchCount = nil
+			} else {
+				if out.RewatchCount == nil {
+					out.RewatchCount = new(int)
+				}
+				*out.RewatchCount = int(in.Int())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson9485b863EncodeFilmsLibraryInternalModel4(out *jwriter.Writer, in AddHistoryRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"film_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.FilmID))
+	}
+	{
+		const prefix string = ",\"watched_on\":"
+		out.RawString(prefix)
+		out.String(string(in.WatchedOn))
+	}
+	if in.RewatchCount != nil {
+		const prefix string = ",\"rewatch_count\":"
+		out.RawString(prefix)
+		out.Int(int(*in.RewatchCount))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AddHistoryRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson9485b863EncodeFilmsLibraryInternalModel4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AddHistoryRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson9485b863EncodeFilmsLibraryInternalModel4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AddHistoryRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson9485b863DecodeFilmsLibraryInternalModel4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AddHistoryRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson9485b863DecodeFilmsLibraryInternalModel4(l, v)
+}
@@ -0,0 +1,339 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"films_library/internal/auth"
+	"films_library/internal/model"
+	"films_library/internal/review"
+	"films_library/pkg/logger"
+	"films_library/pkg/pagination"
+	"films_library/pkg/response"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mailru/easyjson"
+)
+
+type ReviewHandler struct {
+	reviewUsecase review.Usecase
+	logger        logger.Interface
+}
+
+// ReviewPermissions is the permission each review route requires.
+var ReviewPermissions = auth.Permissions{
+	"GET /films/{id}/reviews":  auth.ReviewRead,
+	"POST /films/{id}/reviews": auth.ReviewWrite,
+	"PUT /reviews/{id}":        auth.ReviewWrite,
+	"DELETE /reviews/{id}":     auth.ReviewWrite,
+	"GET /reviews":             auth.ReviewModerate,
+	"PUT /reviews/{id}/status": auth.ReviewModerate,
+}
+
+func NewReviewHandler(mux *http.ServeMux, ru review.Usecase, l logger.Interface) {
+	r := &ReviewHandler{ru, l}
+
+	mux.HandleFunc("GET /films/{id}/reviews", r.GetFilmReviews)
+	mux.HandleFunc("POST /films/{id}/reviews", r.AddReview)
+	mux.HandleFunc("PUT /reviews/{id}", r.UpdateReview)
+	mux.HandleFunc("DELETE /reviews/{id}", r.DeleteReview)
+	mux.HandleFunc("GET /reviews", r.GetReviews)
+	mux.HandleFunc("PUT /reviews/{id}/status", r.ModerateReview)
+}
+
+// GetFilmReviews handles the HTTP GET request to retrieve the reviews of a film.
+// @Summary Get film reviews
+// @Description Retrieves a page of the approved reviews of a film, newest first.
+// @Tags reviews
+// @Produce json
+// @Param id path integer true "ID of the film"
+// @Param limit query integer false "Page size (default 20, max 100)"
+// @Param offset query integer false "Number of reviews to skip"
+// @Param cursor query string false "Cursor of the page to fetch"
+// @Param total query boolean false "Include the total number of reviews"
+// @Success 200 {array} model.Review "List of reviews"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /films/{id}/reviews [get]
+func (h *ReviewHandler) GetFilmReviews(w http.ResponseWriter, r *http.Request) {
+	filmId, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, response.InvalidURLParameter, h.logger)
+		return
+	}
+
+	params, err := pagination.ParseQuery(r.URL.Query())
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Bad query param", h.logger)
+		return
+	}
+
+	filter := model.ReviewFilter{FilmID: filmId, Status: model.ReviewApproved, Params: params}
+	reviews, page, err := h.reviewUsecase.GetReviews(r.Context(), filter)
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.PageResponse(w, http.StatusOK, reviews, page)
+}
+
+// GetReviews handles the HTTP GET request to retrieve reviews for moderation.
+// @Summary Get reviews
+// @Description Retrieves a page of reviews of any film, newest first, optionally in one moderation state.
+// @Tags reviews
+// @Produce json
+// @Param status query string false "Moderation state (pending, approved, rejected)"
+// @Param film_id query integer false "Only reviews of this film"
+// @Param limit query integer false "Page size (default 20, max 100)"
+// @Param offset query integer false "Number of reviews to skip"
+// @Param cursor query string false "Cursor of the page to fetch"
+// @Param total query boolean false "Include the total number of reviews"
+// @Success 200 {array} model.Review "List of reviews"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /reviews [get]
+func (h *ReviewHandler) GetReviews(w http.ResponseWriter, r *http.Request) {
+	queryParams := r.URL.Query()
+
+	params, err := pagination.ParseQuery(queryParams)
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Bad query param", h.logger)
+		return
+	}
+
+	filter := model.ReviewFilter{Status: queryParams.Get("status"), Params: params}
+	if v := queryParams.Get("film_id"); v != "" {
+		filter.FilmID, err = strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			h.logger.Error(err)
+			response.ErrorResponse(w, http.StatusBadRequest, "Bad query param", h.logger)
+			return
+		}
+	}
+
+	v := validator.New()
+	if err := v.Struct(filter); err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Invalid request", h.logger)
+		return
+	}
+
+	reviews, page, err := h.reviewUsecase.GetReviews(r.Context(), filter)
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.PageResponse(w, http.StatusOK, reviews, page)
+}
+
+// AddReview handles the HTTP POST request to rate and review a film.
+// @Summary Review film
+// @Description Rates a film from 1 to 10 with an optional text, once per user.
+// @Description Reviews with text are held for moderation; the score counts right away.
+// @Tags reviews
+// @Accept json
+// @Produce json
+// @Param id path integer true "ID of the film"
+// @Param review body model.ReviewRequest true "Score and text"
+// @Success 201 {object} model.Review "The new review"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 404 {string} string "Film not found"
+// @Failure 409 {string} string "Film is already reviewed by user"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /films/{id}/reviews [post]
+func (h *ReviewHandler) AddReview(w http.ResponseWriter, r *http.Request) {
+	user, ok := auth.UserFromContext(r.Context())
+	if !ok {
+		response.ErrorResponse(w, http.StatusUnauthorized, "unauthorized", h.logger)
+		return
+	}
+
+	filmId, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, response.InvalidURLParameter, h.logger)
+		return
+	}
+
+	req, ok := h.review(w, r)
+	if !ok {
+		return
+	}
+
+	review, err := h.reviewUsecase.AddReview(r.Context(), user, filmId, req)
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusCreated, review)
+}
+
+// UpdateReview handles the HTTP PUT request to edit a review.
+// @Summary Update review
+// @Description Replaces the score and text of a review of the caller. Reviews with text go back to moderation.
+// @Tags reviews
+// @Accept json
+// @Produce json
+// @Param id path integer true "ID of the review"
+// @Param review body model.ReviewRequest true "Score and text"
+// @Success 200 {object} model.Review "Updated review"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Review belongs to another user"
+// @Failure 404 {string} string "Review not found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /reviews/{id} [put]
+func (h *ReviewHandler) UpdateReview(w http.ResponseWriter, r *http.Request) {
+	user, ok := auth.UserFromContext(r.Context())
+	if !ok {
+		response.ErrorResponse(w, http.StatusUnauthorized, "unauthorized", h.logger)
+		return
+	}
+
+	id, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, response.InvalidURLParameter, h.logger)
+		return
+	}
+
+	req, ok := h.review(w, r)
+	if !ok {
+		return
+	}
+
+	review, err := h.reviewUsecase.UpdateReview(r.Context(), user, id, req)
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusOK, review)
+}
+
+// DeleteReview handles the HTTP DELETE request to delete a review.
+// @Summary Delete review
+// @Description Deletes a review of the caller. Moderators may delete any review.
+// @Tags reviews
+// @Produce json
+// @Param id path integer true "ID of the review"
+// @Success 200 {string} string "Review deleted"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Review belongs to another user"
+// @Failure 404 {string} string "Review not found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /reviews/{id} [delete]
+func (h *ReviewHandler) DeleteReview(w http.ResponseWriter, r *http.Request) {
+	user, ok := auth.UserFromContext(r.Context())
+	if !ok {
+		response.ErrorResponse(w, http.StatusUnauthorized, "unauthorized", h.logger)
+		return
+	}
+
+	id, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, response.InvalidURLParameter, h.logger)
+		return
+	}
+
+	credential, _ := auth.CredentialFromContext(r.Context())
+	moderator := auth.Allowed(user.Role, auth.ReviewModerate) && credential.Allows(auth.ReviewModerate)
+
+	if err := h.reviewUsecase.DeleteReview(r.Context(), user, id, moderator); err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusOK, response.NIL())
+}
+
+// ModerateReview handles the HTTP PUT request to approve or reject a review.
+// @Summary Moderate review
+// @Description Approves or rejects a review. Rejected reviews are hidden and stop counting towards the community score.
+// @Tags reviews
+// @Accept json
+// @Produce json
+// @Param id path integer true "ID of the review"
+// @Param status body model.ModerateReviewRequest true "New moderation state"
+// @Success 200 {object} model.Review "Moderated review"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Review not found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /reviews/{id}/status [put]
+func (h *ReviewHandler) ModerateReview(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, response.InvalidURLParameter, h.logger)
+		return
+	}
+
+	var req model.ModerateReviewRequest
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Corrupted request body", h.logger)
+		return
+	}
+
+	v := validator.New()
+	if err := v.Struct(req); err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Invalid request", h.logger)
+		return
+	}
+
+	review, err := h.reviewUsecase.ModerateReview(r.Context(), id, req.Status)
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusOK, review)
+}
+
+// review decodes and validates a score and text from the request body.
+func (h *ReviewHandler) review(w http.ResponseWriter, r *http.Request) (model.ReviewRequest, bool) {
+	var req model.ReviewRequest
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Corrupted request body", h.logger)
+		return model.ReviewRequest{}, false
+	}
+
+	v := validator.New()
+	if err := v.Struct(req); err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Invalid request", h.logger)
+		return model.ReviewRequest{}, false
+	}
+	return req, true
+}
+
+// usecaseError maps errors returned by the review usecase onto HTTP responses.
+func (h *ReviewHandler) usecaseError(w http.ResponseWriter, err error) {
+	var notFound *model.ErrNotFound
+	var conflict *model.ErrConflict
+	var forbidden *model.ErrForbidden
+
+	switch {
+	case errors.As(err, &notFound):
+		response.ErrorResponse(w, http.StatusNotFound, notFound.Error(), h.logger)
+	case errors.As(err, &conflict):
+		response.ErrorResponse(w, http.StatusConflict, conflict.Error(), h.logger)
+	case errors.As(err, &forbidden):
+		response.ErrorResponse(w, http.StatusForbidden, forbidden.Error(), h.logger)
+	default:
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusInternalServerError, "Internal server error", h.logger)
+	}
+}
@@ -0,0 +1,224 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/review/review.go
+
+// Package mock_review is a generated GoMock package.
+package mock_review
+
+import (
+	context "context"
+	model "films_library/internal/model"
+	pagination "films_library/pkg/pagination"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUsecase is a mock of Usecase interface.
+type MockUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseMockRecorder
+}
+
+// MockUsecaseMockRecorder is the mock recorder for MockUsecase.
+type MockUsecaseMockRecorder struct {
+	mock *MockUsecase
+}
+
+// NewMockUsecase creates a new mock instance.
+func NewMockUsecase(ctrl *gomock.Controller) *MockUsecase {
+	mock := &MockUsecase{ctrl: ctrl}
+	mock.recorder = &MockUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
+	return m.recorder
+}
+
+// AddReview mocks base method.
+func (m *MockUsecase) AddReview(ctx context.Context, user model.User, filmID uint64, review model.ReviewRequest) (model.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddReview", ctx, user, filmID, review)
+	ret0, _ := ret[0].(model.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddReview indicates an expected call of AddReview.
+func (mr *MockUsecaseMockRecorder) AddReview(ctx, user, filmID, review interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReview", reflect.TypeOf((*MockUsecase)(nil).AddReview), ctx, user, filmID, review)
+}
+
+// DeleteReview mocks base method.
+func (m *MockUsecase) DeleteReview(ctx context.Context, user model.User, id uint64, moderator bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReview", ctx, user, id, moderator)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteReview indicates an expected call of DeleteReview.
+func (mr *MockUsecaseMockRecorder) DeleteReview(ctx, user, id, moderator interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReview", reflect.TypeOf((*MockUsecase)(nil).DeleteReview), ctx, user, id, moderator)
+}
+
+// GetReviews mocks base method.
+func (m *MockUsecase) GetReviews(ctx context.Context, filter model.ReviewFilter) ([]model.Review, pagination.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviews", ctx, filter)
+	ret0, _ := ret[0].([]model.Review)
+	ret1, _ := ret[1].(pagination.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetReviews indicates an expected call of GetReviews.
+func (mr *MockUsecaseMockRecorder) GetReviews(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviews", reflect.TypeOf((*MockUsecase)(nil).GetReviews), ctx, filter)
+}
+
+// ModerateReview mocks base method.
+func (m *MockUsecase) ModerateReview(ctx context.Context, id uint64, status string) (model.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModerateReview", ctx, id, status)
+	ret0, _ := ret[0].(model.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModerateReview indicates an expected call of ModerateReview.
+func (mr *MockUsecaseMockRecorder) ModerateReview(ctx, id, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModerateReview", reflect.TypeOf((*MockUsecase)(nil).ModerateReview), ctx, id, status)
+}
+
+// UpdateReview mocks base method.
+func (m *MockUsecase) UpdateReview(ctx context.Context, user model.User, id uint64, review model.ReviewRequest) (model.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReview", ctx, user, id, review)
+	ret0, _ := ret[0].(model.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateReview indicates an expected call of UpdateReview.
+func (mr *MockUsecaseMockRecorder) UpdateReview(ctx, user, id, review interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReview", reflect.TypeOf((*MockUsecase)(nil).UpdateReview), ctx, user, id, review)
+}
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddReview mocks base method.
+func (m *MockRepository) AddReview(ctx context.Context, review model.Review) (model.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddReview", ctx, review)
+	ret0, _ := ret[0].(model.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddReview indicates an expected call of AddReview.
+func (mr *MockRepositoryMockRecorder) AddReview(ctx, review interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReview", reflect.TypeOf((*MockRepository)(nil).AddReview), ctx, review)
+}
+
+// CountReviews mocks base method.
+func (m *MockRepository) CountReviews(ctx context.Context, filter model.ReviewFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountReviews", ctx, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountReviews indicates an expected call of CountReviews.
+func (mr *MockRepositoryMockRecorder) CountReviews(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountReviews", reflect.TypeOf((*MockRepository)(nil).CountReviews), ctx, filter)
+}
+
+// DeleteReview mocks base method.
+func (m *MockRepository) DeleteReview(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReview", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteReview indicates an expected call of DeleteReview.
+func (mr *MockRepositoryMockRecorder) DeleteReview(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReview", reflect.TypeOf((*MockRepository)(nil).DeleteReview), ctx, id)
+}
+
+// GetReview mocks base method.
+func (m *MockRepository) GetReview(ctx context.Context, id uint64) (model.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReview", ctx, id)
+	ret0, _ := ret[0].(model.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReview indicates an expected call of GetReview.
+func (mr *MockRepositoryMockRecorder) GetReview(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReview", reflect.TypeOf((*MockRepository)(nil).GetReview), ctx, id)
+}
+
+// GetReviews mocks base method.
+func (m *MockRepository) GetReviews(ctx context.Context, filter model.ReviewFilter) ([]model.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviews", ctx, filter)
+	ret0, _ := ret[0].([]model.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviews indicates an expected call of GetReviews.
+func (mr *MockRepositoryMockRecorder) GetReviews(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviews", reflect.TypeOf((*MockRepository)(nil).GetReviews), ctx, filter)
+}
+
+// UpdateReview mocks base method.
+func (m *MockRepository) UpdateReview(ctx context.Context, review model.Review) (model.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReview", ctx, review)
+	ret0, _ := ret[0].(model.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateReview indicates an expected call of UpdateReview.
+func (mr *MockRepositoryMockRecorder) UpdateReview(ctx, review interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReview", reflect.TypeOf((*MockRepository)(nil).UpdateReview), ctx, review)
+}
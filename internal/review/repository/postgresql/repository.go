@@ -0,0 +1,265 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"films_library/internal/model"
+	"films_library/pkg/pagination"
+	"films_library/pkg/postgres"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	foreignKeyViolation = "23503"
+	uniqueViolation     = "23505"
+
+	reviewFilmForeignKey = "review_film_id_fkey"
+)
+
+const reviewColumns = `r.review_id, r.film_id, r.user_id, u.username, r.score, r.body, r.status, r.created_at, r.updated_at`
+
+// returning yields the same columns as reviewColumns from an INSERT or UPDATE of review.
+const returning = ` RETURNING review_id, film_id, user_id,
+        (SELECT username FROM users WHERE users.user_id = review.user_id),
+        score, body, status, created_at, updated_at`
+
+type Repository struct {
+	db postgres.DBConn
+}
+
+func NewRepository(db postgres.DBConn) *Repository {
+	return &Repository{db}
+}
+
+// GetReviews lists reviews newest first.
+func (r *Repository) GetReviews(ctx context.Context, filter model.ReviewFilter) ([]model.Review, error) {
+	sqlQuery := `SELECT ` + reviewColumns + ` FROM review r JOIN users u ON u.user_id = r.user_id`
+
+	backward := filter.Backward()
+
+	args, where := reviewConditions(filter)
+	condition, clauses := filter.Keyset(pagination.Order{Key: "r.review_id", Desc: true}, &args)
+	if condition != "" {
+		where = append(where, condition)
+	}
+	for i, condition := range where {
+		if i == 0 {
+			sqlQuery += " WHERE " + condition
+		} else {
+			sqlQuery += " AND " + condition
+		}
+	}
+	sqlQuery += clauses
+
+	rows, err := r.db.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reviews []model.Review
+	for rows.Next() {
+		review, err := scanReview(rows)
+		if err != nil {
+			return nil, err
+		}
+		reviews = append(reviews, review)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if backward {
+		slices.Reverse(reviews)
+	}
+	return reviews, nil
+}
+
+func (r *Repository) CountReviews(ctx context.Context, filter model.ReviewFilter) (int64, error) {
+	sqlQuery := `SELECT count(*) FROM review r`
+
+	args, where := reviewConditions(filter)
+	for i, condition := range where {
+		if i == 0 {
+			sqlQuery += " WHERE " + condition
+		} else {
+			sqlQuery += " AND " + condition
+		}
+	}
+
+	var total int64
+	if err := r.db.QueryRow(ctx, sqlQuery, args...).Scan(&total); err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+func reviewConditions(filter model.ReviewFilter) ([]interface{}, []string) {
+	var args []interface{}
+	var where []string
+	if filter.FilmID != 0 {
+		args = append(args, filter.FilmID)
+		where = append(where, fmt.Sprintf("r.film_id = $%d", len(args)))
+	}
+	if filter.Status != "" {
+		args = append(args, filter.Status)
+		where = append(where, fmt.Sprintf("r.status = $%d", len(args)))
+	}
+	return args, where
+}
+
+func (r *Repository) GetReview(ctx context.Context, id uint64) (model.Review, error) {
+	sqlQuery := `SELECT ` + reviewColumns + ` FROM review r JOIN users u ON u.user_id = r.user_id WHERE r.review_id=$1`
+
+	review, err := scanReview(r.db.QueryRow(ctx, sqlQuery, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Review{}, &model.ErrNotFound{Message: "review not found"}
+		}
+		return model.Review{}, err
+	}
+	return review, nil
+}
+
+// AddReview stores a review and adds its score to the aggregates of the film.
+func (r *Repository) AddReview(ctx context.Context, review model.Review) (model.Review, error) {
+	sqlQuery := `INSERT INTO review (film_id, user_id, score, body, status) VALUES ($1, $2, $3, $4, $5)` + returning
+
+	var added model.Review
+	err := r.db.BeginTxFunc(ctx, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		var err error
+		added, err = scanReview(tx.QueryRow(ctx, sqlQuery,
+			review.FilmID, review.UserID, review.Score, review.Body, review.Status))
+		if err != nil {
+			return err
+		}
+		return aggregate(ctx, tx, added.FilmID, contribution(added))
+	})
+	if err != nil {
+		return model.Review{}, reviewError(err)
+	}
+	return added, nil
+}
+
+// UpdateReview replaces the score, text and status of a review and moves the
+// aggregates of the film by the difference.
+func (r *Repository) UpdateReview(ctx context.Context, review model.Review) (model.Review, error) {
+	sqlQuery := `UPDATE review SET score=$2, body=$3, status=$4, updated_at=now() WHERE review_id=$1` + returning
+
+	var updated model.Review
+	err := r.db.BeginTxFunc(ctx, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		old, err := lockReview(ctx, tx, review.ID)
+		if err != nil {
+			return err
+		}
+
+		updated, err = scanReview(tx.QueryRow(ctx, sqlQuery, review.ID, review.Score, review.Body, review.Status))
+		if err != nil {
+			return err
+		}
+
+		before, after := contribution(old), contribution(updated)
+		return aggregate(ctx, tx, updated.FilmID, delta{after.count - before.count, after.sum - before.sum})
+	})
+	if err != nil {
+		return model.Review{}, err
+	}
+	return updated, nil
+}
+
+// DeleteReview deletes a review and takes its score out of the aggregates of the film.
+func (r *Repository) DeleteReview(ctx context.Context, id uint64) error {
+	return r.db.BeginTxFunc(ctx, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		old, err := lockReview(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(ctx, `DELETE FROM review WHERE review_id=$1`, id); err != nil {
+			return err
+		}
+
+		before := contribution(old)
+		return aggregate(ctx, tx, old.FilmID, delta{-before.count, -before.sum})
+	})
+}
+
+// lockReview reads the part of a review the aggregates depend on and keeps
+// it locked until the transaction ends.
+func lockReview(ctx context.Context, tx pgx.Tx, id uint64) (model.Review, error) {
+	sqlQuery := `SELECT film_id, score, status FROM review WHERE review_id=$1 FOR UPDATE`
+
+	review := model.Review{ID: id}
+	if err := tx.QueryRow(ctx, sqlQuery, id).Scan(&review.FilmID, &review.Score, &review.Status); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Review{}, &model.ErrNotFound{Message: "review not found"}
+		}
+		return model.Review{}, err
+	}
+	return review, nil
+}
+
+// delta is a change of the rating aggregates of a film.
+type delta struct {
+	count int64
+	sum   int64
+}
+
+// contribution is what a review adds to the aggregates of its film: nothing
+// once rejected.
+func contribution(review model.Review) delta {
+	if review.Status == model.ReviewRejected {
+		return delta{}
+	}
+	return delta{1, int64(review.Score)}
+}
+
+func aggregate(ctx context.Context, tx pgx.Tx, filmID uint64, d delta) error {
+	if d == (delta{}) {
+		return nil
+	}
+
+	sqlQuery := `UPDATE film SET rating_count = rating_count + $2, rating_sum = rating_sum + $3 WHERE film_id=$1`
+
+	_, err := tx.Exec(ctx, sqlQuery, filmID, d.count, d.sum)
+	return err
+}
+
+func scanReview(row pgx.Row) (model.Review, error) {
+	var review model.Review
+	err := row.Scan(
+		&review.ID,
+		&review.FilmID,
+		&review.UserID,
+		&review.Username,
+		&review.Score,
+		&review.Body,
+		&review.Status,
+		&review.CreatedAt,
+		&review.UpdatedAt,
+	)
+	return review, err
+}
+
+func reviewError(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+
+	switch pgErr.Code {
+	case foreignKeyViolation:
+		if pgErr.ConstraintName == reviewFilmForeignKey {
+			return &model.ErrNotFound{Message: "film not found"}
+		}
+		return &model.ErrNotFound{Message: "user not found"}
+	case uniqueViolation:
+		return &model.ErrConflict{Message: "film is already reviewed by user"}
+	}
+	return err
+}
@@ -0,0 +1,85 @@
+package postgresql
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"films_library/internal/model"
+
+	"github.com/pashagolub/pgxmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateReviewMovesAggregates(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mock.Close()
+
+	repo := NewRepository(mock)
+
+	now := time.Now()
+	columns := []string{"review_id", "film_id", "user_id", "username", "score", "body", "status", "created_at", "updated_at"}
+
+	testCases := []struct {
+		name      string
+		oldScore  int
+		oldStatus string
+		review    model.Review
+		count     int64
+		sum       int64
+	}{
+		{
+			name:      "Score changed",
+			oldScore:  4,
+			oldStatus: model.ReviewApproved,
+			review:    model.Review{ID: 7, Score: 9, Status: model.ReviewPending},
+			count:     0,
+			sum:       5,
+		},
+		{
+			name:      "Rejected",
+			oldScore:  4,
+			oldStatus: model.ReviewPending,
+			review:    model.Review{ID: 7, Score: 4, Status: model.ReviewRejected},
+			count:     -1,
+			sum:       -4,
+		},
+		{
+			name:      "Approved after rejection",
+			oldScore:  2,
+			oldStatus: model.ReviewRejected,
+			review:    model.Review{ID: 7, Score: 2, Status: model.ReviewApproved},
+			count:     1,
+			sum:       2,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT film_id, score, status FROM review WHERE review_id=$1 FOR UPDATE`)).
+				WithArgs(tc.review.ID).
+				WillReturnRows(pgxmock.NewRows([]string{"film_id", "score", "status"}).AddRow(uint64(1), tc.oldScore, tc.oldStatus))
+			mock.ExpectQuery(regexp.QuoteMeta(`UPDATE review SET score=$2, body=$3, status=$4, updated_at=now() WHERE review_id=$1`)).
+				WithArgs(tc.review.ID, tc.review.Score, tc.review.Body, tc.review.Status).
+				WillReturnRows(pgxmock.NewRows(columns).
+					AddRow(tc.review.ID, uint64(1), uint64(3), "neo", tc.review.Score, "", tc.review.Status, now, now))
+			mock.ExpectExec(regexp.QuoteMeta(`UPDATE film SET rating_count = rating_count + $2, rating_sum = rating_sum + $3 WHERE film_id=$1`)).
+				WithArgs(uint64(1), tc.count, tc.sum).
+				WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+			mock.ExpectCommit()
+
+			updated, err := repo.UpdateReview(context.Background(), tc.review)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.review.Status, updated.Status)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package review
+
+import (
+	"context"
+
+	"films_library/internal/model"
+	"films_library/pkg/pagination"
+)
+
+type (
+	Usecase interface {
+		GetReviews(ctx context.Context, filter model.ReviewFilter) ([]model.Review, pagination.Page, error)
+		AddReview(ctx context.Context, user model.User, filmID uint64, review model.ReviewRequest) (model.Review, error)
+		UpdateReview(ctx context.Context, user model.User, id uint64, review model.ReviewRequest) (model.Review, error)
+		DeleteReview(ctx context.Context, user model.User, id uint64, moderator bool) error
+		ModerateReview(ctx context.Context, id uint64, status string) (model.Review, error)
+	}
+
+	// Repository keeps the rating aggregates of films in step with the
+	// reviews it stores.
+	Repository interface {
+		GetReviews(ctx context.Context, filter model.ReviewFilter) ([]model.Review, error)
+		CountReviews(ctx context.Context, filter model.ReviewFilter) (int64, error)
+		GetReview(ctx context.Context, id uint64) (model.Review, error)
+		AddReview(ctx context.Context, review model.Review) (model.Review, error)
+		UpdateReview(ctx context.Context, review model.Review) (model.Review, error)
+		DeleteReview(ctx context.Context, id uint64) error
+	}
+)
@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"context"
+
+	"films_library/internal/model"
+	"films_library/internal/review"
+	"films_library/pkg/logger"
+	"films_library/pkg/pagination"
+)
+
+type ReviewUsecase struct {
+	reviewRepo review.Repository
+	logger     logger.Interface
+}
+
+func NewReviewUsecase(rr review.Repository, l logger.Interface) *ReviewUsecase {
+	return &ReviewUsecase{rr, l}
+}
+
+func (ru *ReviewUsecase) GetReviews(ctx context.Context, filter model.ReviewFilter) ([]model.Review, pagination.Page, error) {
+	reviews, err := ru.reviewRepo.GetReviews(ctx, filter)
+	if err != nil {
+		return []model.Review{}, pagination.Page{}, err
+	}
+
+	reviews, page := pagination.Paginate(reviews, filter.Params, func(review model.Review) pagination.Cursor {
+		return pagination.Cursor{ID: review.ID}
+	})
+
+	if filter.WithTotal {
+		total, err := ru.reviewRepo.CountReviews(ctx, filter)
+		if err != nil {
+			return []model.Review{}, pagination.Page{}, err
+		}
+		page.Total = &total
+	}
+	return reviews, page, nil
+}
+
+func (ru *ReviewUsecase) AddReview(ctx context.Context, user model.User, filmID uint64, req model.ReviewRequest) (model.Review, error) {
+	return ru.reviewRepo.AddReview(ctx, model.Review{
+		FilmID: filmID,
+		UserID: user.ID,
+		Score:  req.Score,
+		Body:   req.Body,
+		Status: initialStatus(req.Body),
+	})
+}
+
+// UpdateReview replaces the score and text of a review of user. The review
+// goes back to moderation whenever it has text.
+func (ru *ReviewUsecase) UpdateReview(ctx context.Context, user model.User, id uint64, req model.ReviewRequest) (model.Review, error) {
+	review, err := ru.ownReview(ctx, user, id)
+	if err != nil {
+		return model.Review{}, err
+	}
+
+	review.Score = req.Score
+	review.Body = req.Body
+	review.Status = initialStatus(req.Body)
+	return ru.reviewRepo.UpdateReview(ctx, review)
+}
+
+// DeleteReview deletes a review of user, or of anyone when moderator is set.
+func (ru *ReviewUsecase) DeleteReview(ctx context.Context, user model.User, id uint64, moderator bool) error {
+	if !moderator {
+		if _, err := ru.ownReview(ctx, user, id); err != nil {
+			return err
+		}
+	}
+	return ru.reviewRepo.DeleteReview(ctx, id)
+}
+
+func (ru *ReviewUsecase) ModerateReview(ctx context.Context, id uint64, status string) (model.Review, error) {
+	review, err := ru.reviewRepo.GetReview(ctx, id)
+	if err != nil {
+		return model.Review{}, err
+	}
+
+	review.Status = status
+	return ru.reviewRepo.UpdateReview(ctx, review)
+}
+
+func (ru *ReviewUsecase) ownReview(ctx context.Context, user model.User, id uint64) (model.Review, error) {
+	review, err := ru.reviewRepo.GetReview(ctx, id)
+	if err != nil {
+		return model.Review{}, err
+	}
+	if review.UserID != user.ID {
+		return model.Review{}, &model.ErrForbidden{Message: "review belongs to another user"}
+	}
+	return review, nil
+}
+
+// initialStatus is the moderation state of a review with body: ratings
+// without text have nothing to moderate.
+func initialStatus(body string) string {
+	if body == "" {
+		return model.ReviewApproved
+	}
+	return model.ReviewPending
+}
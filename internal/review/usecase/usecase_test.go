@@ -0,0 +1,172 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"films_library/internal/model"
+	mock_review "films_library/internal/review/mocks"
+	"films_library/pkg/logger"
+
+	"github.com/golang/mock/gomock"
+)
+
+func TestReviewUsecase_AddReview(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	loggerMock := logger.NewMockInterface(ctrl)
+	reviewRepo := mock_review.NewMockRepository(ctrl)
+	usecase := NewReviewUsecase(reviewRepo, loggerMock)
+
+	ctx := context.Background()
+	user := model.User{ID: 3, Username: "neo"}
+
+	testCases := []struct {
+		name           string
+		req            model.ReviewRequest
+		expectedStatus string
+	}{
+		{
+			name:           "Bare rating is approved",
+			req:            model.ReviewRequest{Score: 8},
+			expectedStatus: model.ReviewApproved,
+		},
+		{
+			name:           "Text waits for moderation",
+			req:            model.ReviewRequest{Score: 9, Body: "There is no spoon."},
+			expectedStatus: model.ReviewPending,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			review := model.Review{FilmID: 1, UserID: user.ID, Score: tc.req.Score, Body: tc.req.Body, Status: tc.expectedStatus}
+			reviewRepo.EXPECT().AddReview(ctx, review).Return(review, nil)
+
+			added, err := usecase.AddReview(ctx, user, 1, tc.req)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(added, review) {
+				t.Errorf("Expected review %v, got %v", review, added)
+			}
+		})
+	}
+}
+
+func TestReviewUsecase_UpdateReview(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	loggerMock := logger.NewMockInterface(ctrl)
+	reviewRepo := mock_review.NewMockRepository(ctrl)
+	usecase := NewReviewUsecase(reviewRepo, loggerMock)
+
+	ctx := context.Background()
+	stored := model.Review{ID: 7, FilmID: 1, UserID: 3, Score: 4, Status: model.ReviewApproved}
+
+	testCases := []struct {
+		name          string
+		user          model.User
+		req           model.ReviewRequest
+		expectUpdate  bool
+		expectedError error
+	}{
+		{
+			name:         "Author edits text back into moderation",
+			user:         model.User{ID: 3},
+			req:          model.ReviewRequest{Score: 6, Body: "Better on a second watch."},
+			expectUpdate: true,
+		},
+		{
+			name:          "Another user",
+			user:          model.User{ID: 4},
+			req:           model.ReviewRequest{Score: 1},
+			expectedError: &model.ErrForbidden{Message: "review belongs to another user"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			reviewRepo.EXPECT().GetReview(ctx, stored.ID).Return(stored, nil)
+			if tc.expectUpdate {
+				updated := stored
+				updated.Score, updated.Body, updated.Status = tc.req.Score, tc.req.Body, model.ReviewPending
+				reviewRepo.EXPECT().UpdateReview(ctx, updated).Return(updated, nil)
+			}
+
+			_, err := usecase.UpdateReview(ctx, tc.user, stored.ID, tc.req)
+			if (err == nil) != (tc.expectedError == nil) || err != nil && err.Error() != tc.expectedError.Error() {
+				t.Errorf("Unexpected error: expected %v, got %v", tc.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestReviewUsecase_DeleteReview(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	loggerMock := logger.NewMockInterface(ctrl)
+	reviewRepo := mock_review.NewMockRepository(ctrl)
+	usecase := NewReviewUsecase(reviewRepo, loggerMock)
+
+	ctx := context.Background()
+	stored := model.Review{ID: 7, FilmID: 1, UserID: 3, Score: 4, Status: model.ReviewApproved}
+
+	testCases := []struct {
+		name          string
+		user          model.User
+		moderator     bool
+		repoError     error
+		expectGet     bool
+		expectDelete  bool
+		expectedError error
+	}{
+		{
+			name:         "Author",
+			user:         model.User{ID: 3},
+			expectGet:    true,
+			expectDelete: true,
+		},
+		{
+			name:         "Moderator skips the ownership check",
+			user:         model.User{ID: 9},
+			moderator:    true,
+			expectDelete: true,
+		},
+		{
+			name:          "Another user",
+			user:          model.User{ID: 4},
+			expectGet:     true,
+			expectedError: &model.ErrForbidden{Message: "review belongs to another user"},
+		},
+		{
+			name:          "Error from repository",
+			user:          model.User{ID: 3},
+			moderator:     true,
+			repoError:     errors.New("repository error"),
+			expectDelete:  true,
+			expectedError: errors.New("repository error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.expectGet {
+				reviewRepo.EXPECT().GetReview(ctx, stored.ID).Return(stored, nil)
+			}
+			if tc.expectDelete {
+				reviewRepo.EXPECT().DeleteReview(ctx, stored.ID).Return(tc.repoError)
+			}
+
+			err := usecase.DeleteReview(ctx, tc.user, stored.ID, tc.moderator)
+			if (err == nil) != (tc.expectedError == nil) || err != nil && err.Error() != tc.expectedError.Error() {
+				t.Errorf("Unexpected error: expected %v, got %v", tc.expectedError, err)
+			}
+		})
+	}
+}
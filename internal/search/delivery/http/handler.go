@@ -0,0 +1,78 @@
+package http
+
+import (
+	"net/http"
+
+	"films_library/internal/auth"
+	"films_library/internal/model"
+	"films_library/internal/search"
+	"films_library/pkg/logger"
+	"films_library/pkg/pagination"
+	"films_library/pkg/response"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type SearchHandler struct {
+	searchUsecase search.Usecase
+	logger        logger.Interface
+}
+
+// SearchPermissions is the permission each search route requires.
+var SearchPermissions = auth.Permissions{
+	"GET /search": auth.FilmRead,
+}
+
+func NewSearchHandler(mux *http.ServeMux, su search.Usecase, l logger.Interface) {
+	r := &SearchHandler{su, l}
+
+	mux.HandleFunc("GET /search", r.Search)
+}
+
+// Search handles the HTTP GET request to search films and actors.
+// @Summary Search films and actors
+// @Description Full-text and typo-tolerant search over film titles, descriptions and cast, and actor names.
+// @Description Results are ranked by relevance and carry a highlighted snippet.
+// @Tags search
+// @Produce json
+// @Param q query string true "Search terms"
+// @Param type query string false "Restrict results to 'film' or 'actor'"
+// @Param limit query integer false "Page size (default 20, max 100)"
+// @Param offset query integer false "Number of results to skip"
+// @Param cursor query string false "Cursor of the page to fetch"
+// @Success 200 {array} model.SearchResult "Ranked results"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /search [get]
+func (h *SearchHandler) Search(w http.ResponseWriter, r *http.Request) {
+	queryParams := r.URL.Query()
+
+	params, err := pagination.ParseQuery(queryParams)
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Bad query param", h.logger)
+		return
+	}
+
+	query := model.SearchQuery{
+		Query:  queryParams.Get("q"),
+		Type:   queryParams.Get("type"),
+		Params: params,
+	}
+
+	v := validator.New()
+	if err := v.Struct(query); err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Invalid request", h.logger)
+		return
+	}
+
+	results, page, err := h.searchUsecase.Search(r.Context(), query)
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusInternalServerError, "Internal server error", h.logger)
+		return
+	}
+
+	response.PageResponse(w, http.StatusOK, results, page)
+}
@@ -0,0 +1,103 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+
+	"films_library/internal/model"
+	"films_library/pkg/postgres"
+)
+
+// Film and actor documents are matched both by full-text search and by
+// trigram word similarity, so that "matrix" finds "The Matrix" and typos such
+// as "matirx" still match. The score adds the two signals together. Each
+// document comes with the text and search configuration its snippet is cut
+// from.
+const (
+	filmSearch = `
+        SELECT 'film' AS type, f.film_id AS id, f.title AS title,
+               ts_rank_cd(f.search_vector, q.query) + word_similarity(q.search, f.title) AS score,
+               'english' AS config, f.title || '. ' || coalesce(f."description", '') AS document
+        FROM film f, q
+        WHERE f.search_vector @@ q.query OR q.search <% f.title`
+
+	actorSearch = `
+        SELECT 'actor' AS type, a.actor_id AS id, a."name" AS title,
+               ts_rank_cd(a.search_vector, q.query) + word_similarity(q.search, a."name") AS score,
+               'simple' AS config, a."name" AS document
+        FROM actor a, q
+        WHERE a.search_vector @@ q.query OR q.search <% a."name"`
+
+	headlineOptions = "StartSel=<mark>, StopSel=</mark>, MaxWords=35, MinWords=15, HighlightAll=false"
+)
+
+type Repository struct {
+	db postgres.DBConn
+}
+
+func NewRepository(db postgres.DBConn) *Repository {
+	return &Repository{db}
+}
+
+func (r *Repository) Search(ctx context.Context, query model.SearchQuery) ([]model.SearchResult, error) {
+	var documents string
+	switch query.Type {
+	case model.SearchFilms:
+		documents = filmSearch
+	case model.SearchActors:
+		documents = actorSearch
+	default:
+		documents = filmSearch + " UNION ALL " + actorSearch
+	}
+
+	args := []interface{}{query.Query, headlineOptions}
+	var page string
+	if limit := query.FetchLimit(); limit > 0 {
+		args = append(args, limit)
+		page += fmt.Sprintf(" LIMIT $%d", len(args))
+	}
+	if offset := query.Position(); offset > 0 {
+		args = append(args, offset)
+		page += fmt.Sprintf(" OFFSET $%d", len(args))
+	}
+
+	// Snippets are the costliest part of a search, so they are cut for the
+	// rows of the page only, once it is ranked and limited.
+	sqlQuery := fmt.Sprintf(`
+        WITH q AS (
+            SELECT $1::text AS search,
+                   websearch_to_tsquery('english', $1) || websearch_to_tsquery('simple', $1) AS query
+        )
+        SELECT p.type, p.id, p.title, p.score, ts_headline(p.config::regconfig, p.document, q.query, $2)
+        FROM (
+            SELECT type, id, title, score, config, document
+            FROM (%s) AS results
+            ORDER BY score DESC, type, id%s
+        ) AS p, q
+        ORDER BY p.score DESC, p.type, p.id`, documents, page)
+
+	rows, err := r.db.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	results := []model.SearchResult{}
+	for rows.Next() {
+		var result model.SearchResult
+		if err := rows.Scan(
+			&result.Type,
+			&result.ID,
+			&result.Title,
+			&result.Score,
+			&result.Snippet,
+		); err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
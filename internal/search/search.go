@@ -0,0 +1,15 @@
+package search
+
+import (
+	"context"
+	"films_library/internal/model"
+	"films_library/pkg/pagination"
+)
+
+type Usecase interface {
+	Search(ctx context.Context, query model.SearchQuery) ([]model.SearchResult, pagination.Page, error)
+}
+
+type Repository interface {
+	Search(ctx context.Context, query model.SearchQuery) ([]model.SearchResult, error)
+}
@@ -0,0 +1,29 @@
+package usecase
+
+import (
+	"context"
+
+	"films_library/internal/model"
+	"films_library/internal/search"
+	"films_library/pkg/logger"
+	"films_library/pkg/pagination"
+)
+
+type SearchUsecase struct {
+	searchRepo search.Repository
+	logger     logger.Interface
+}
+
+func NewSearchUsecase(sr search.Repository, l logger.Interface) *SearchUsecase {
+	return &SearchUsecase{sr, l}
+}
+
+func (su *SearchUsecase) Search(ctx context.Context, query model.SearchQuery) ([]model.SearchResult, pagination.Page, error) {
+	results, err := su.searchRepo.Search(ctx, query)
+	if err != nil {
+		return []model.SearchResult{}, pagination.Page{}, err
+	}
+
+	results, page := pagination.PaginateOffset(results, query.Params)
+	return results, page, nil
+}
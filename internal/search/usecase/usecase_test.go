@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"films_library/internal/model"
+	mock_search "films_library/internal/search/mocks"
+	"films_library/pkg/logger"
+	"films_library/pkg/pagination"
+
+	"github.com/golang/mock/gomock"
+)
+
+func TestSearchUsecase_Search(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	loggerMock := logger.NewMockInterface(ctrl)
+	searchRepo := mock_search.NewMockRepository(ctrl)
+	usecase := NewSearchUsecase(searchRepo, loggerMock)
+
+	ctx := context.Background()
+
+	results := []model.SearchResult{
+		{Type: model.SearchFilms, ID: 1, Title: "The Matrix", Score: 1.2, Snippet: "The <mark>Matrix</mark>"},
+		{Type: model.SearchActors, ID: 4, Title: "Keanu Reeves", Score: 0.4, Snippet: "Keanu Reeves"},
+	}
+
+	testCases := []struct {
+		name            string
+		query           model.SearchQuery
+		repoResults     []model.SearchResult
+		expectedResults []model.SearchResult
+		expectedPage    pagination.Page
+		expectedError   error
+	}{
+		{
+			name:            "Single page",
+			query:           model.SearchQuery{Query: "matrix", Params: pagination.Params{Limit: 5}},
+			repoResults:     results,
+			expectedResults: results,
+		},
+		{
+			name:            "More results follow",
+			query:           model.SearchQuery{Query: "matrix", Params: pagination.Params{Limit: 1}},
+			repoResults:     results,
+			expectedResults: results[:1],
+			expectedPage:    pagination.Page{Next: pagination.Encode(pagination.Cursor{Offset: 1})},
+		},
+		{
+			name:            "Error from repository",
+			query:           model.SearchQuery{Query: "matrix"},
+			expectedResults: []model.SearchResult{},
+			expectedError:   errors.New("repository error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			searchRepo.EXPECT().Search(ctx, tc.query).Return(tc.repoResults, tc.expectedError)
+
+			results, page, err := usecase.Search(ctx, tc.query)
+			if err != tc.expectedError {
+				t.Errorf("Unexpected error: expected %v, got %v", tc.expectedError, err)
+			}
+
+			if !reflect.DeepEqual(results, tc.expectedResults) {
+				t.Errorf("Expected results %v, got %v", tc.expectedResults, results)
+			}
+
+			if page != tc.expectedPage {
+				t.Errorf("Expected page %v, got %v", tc.expectedPage, page)
+			}
+		})
+	}
+}
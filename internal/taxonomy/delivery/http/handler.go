@@ -0,0 +1,329 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"films_library/internal/auth"
+	"films_library/internal/model"
+	"films_library/internal/taxonomy"
+	"films_library/pkg/logger"
+	"films_library/pkg/pagination"
+	"films_library/pkg/response"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mailru/easyjson"
+)
+
+type TaxonomyHandler struct {
+	taxonomyUsecase taxonomy.Usecase
+	logger          logger.Interface
+}
+
+// TaxonomyPermissions is the permission each genre and tag route requires.
+var TaxonomyPermissions = auth.Permissions{
+	"GET /genres":                        auth.GenreRead,
+	"GET /genres/{id}":                   auth.GenreRead,
+	"POST /genres":                       auth.GenreWrite,
+	"PUT /genres/{id}":                   auth.GenreWrite,
+	"DELETE /genres/{id}":                auth.GenreDelete,
+	"GET /tags":                          auth.TagRead,
+	"GET /tags/{id}":                     auth.TagRead,
+	"POST /tags":                         auth.TagWrite,
+	"PUT /tags/{id}":                     auth.TagWrite,
+	"DELETE /tags/{id}":                  auth.TagDelete,
+	"POST /films/{id}/genres/{termId}":   auth.FilmWrite,
+	"DELETE /films/{id}/genres/{termId}": auth.FilmWrite,
+	"POST /films/{id}/tags/{termId}":     auth.FilmWrite,
+	"DELETE /films/{id}/tags/{termId}":   auth.FilmWrite,
+}
+
+func NewTaxonomyHandler(mux *http.ServeMux, tu taxonomy.Usecase, l logger.Interface) {
+	r := &TaxonomyHandler{tu, l}
+
+	for taxonomy, path := range map[string]string{
+		model.TaxonomyGenre: "/genres",
+		model.TaxonomyTag:   "/tags",
+	} {
+		mux.HandleFunc("GET "+path, r.GetTerms(taxonomy))
+		mux.HandleFunc("GET "+path+"/{id}", r.GetTerm(taxonomy))
+		mux.HandleFunc("POST "+path, r.AddTerm(taxonomy))
+		mux.HandleFunc("PUT "+path+"/{id}", r.UpdateTerm(taxonomy))
+		mux.HandleFunc("DELETE "+path+"/{id}", r.DeleteTerm(taxonomy))
+		mux.HandleFunc("POST /films/{id}"+path+"/{termId}", r.LinkFilm(taxonomy))
+		mux.HandleFunc("DELETE /films/{id}"+path+"/{termId}", r.UnlinkFilm(taxonomy))
+	}
+}
+
+// GetTerms handles the HTTP GET request to retrieve a list of genres or tags.
+// @Summary Get genres or tags
+// @Description Retrieves a page of genres or tags.
+// @Tags taxonomy
+// @Produce json
+// @Param limit query integer false "Page size (default 20, max 100)"
+// @Param offset query integer false "Number of terms to skip"
+// @Param cursor query string false "Cursor of the page to fetch"
+// @Param total query boolean false "Include the total number of terms"
+// @Success 200 {array} model.Term "List of terms"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /genres [get]
+// @Router /tags [get]
+func (h *TaxonomyHandler) GetTerms(taxonomy string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		params, err := pagination.ParseQuery(r.URL.Query())
+		if err != nil {
+			h.logger.Error(err)
+			response.ErrorResponse(w, http.StatusBadRequest, "Bad query param", h.logger)
+			return
+		}
+
+		terms, page, err := h.taxonomyUsecase.GetTerms(r.Context(), model.TermFilter{Taxonomy: taxonomy, Params: params})
+		if err != nil {
+			h.usecaseError(w, err)
+			return
+		}
+
+		response.PageResponse(w, http.StatusOK, terms, page)
+	}
+}
+
+// GetTerm handles the HTTP GET request to retrieve a genre or tag.
+// @Summary Get genre or tag
+// @Description Retrieves a genre or tag by ID.
+// @Tags taxonomy
+// @Produce json
+// @Param id path integer true "ID of the term"
+// @Success 200 {object} model.Term "Term"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Term not found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /genres/{id} [get]
+// @Router /tags/{id} [get]
+func (h *TaxonomyHandler) GetTerm(taxonomy string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+		if err != nil {
+			h.logger.Error(err)
+			response.ErrorResponse(w, http.StatusBadRequest, response.InvalidURLParameter, h.logger)
+			return
+		}
+
+		term, err := h.taxonomyUsecase.GetTerm(r.Context(), taxonomy, id)
+		if err != nil {
+			h.usecaseError(w, err)
+			return
+		}
+
+		response.SuccessResponse(w, http.StatusOK, term)
+	}
+}
+
+// AddTerm handles the HTTP POST request to add a genre or tag.
+// @Summary Add genre or tag
+// @Description Adds a genre or tag. Names are unique regardless of case.
+// @Tags taxonomy
+// @Accept json
+// @Produce json
+// @Param term body model.Term true "Term to be added"
+// @Success 201 {integer} integer "ID of the newly added term"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 409 {string} string "Term already exists"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /genres [post]
+// @Router /tags [post]
+func (h *TaxonomyHandler) AddTerm(taxonomy string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		term, ok := h.term(w, r)
+		if !ok {
+			return
+		}
+
+		id, err := h.taxonomyUsecase.AddTerm(r.Context(), taxonomy, term)
+		if err != nil {
+			h.usecaseError(w, err)
+			return
+		}
+
+		response.SuccessResponse(w, http.StatusCreated, id)
+	}
+}
+
+// UpdateTerm handles the HTTP PUT request to rename a genre or tag.
+// @Summary Update genre or tag
+// @Description Renames a genre or tag.
+// @Tags taxonomy
+// @Accept json
+// @Produce json
+// @Param id path integer true "ID of the term"
+// @Param term body model.Term true "Updated term"
+// @Success 200 {object} model.Term "Updated term"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Term not found"
+// @Failure 409 {string} string "Term already exists"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /genres/{id} [put]
+// @Router /tags/{id} [put]
+func (h *TaxonomyHandler) UpdateTerm(taxonomy string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+		if err != nil {
+			h.logger.Error(err)
+			response.ErrorResponse(w, http.StatusBadRequest, response.InvalidURLParameter, h.logger)
+			return
+		}
+
+		term, ok := h.term(w, r)
+		if !ok {
+			return
+		}
+		term.ID = id
+
+		updated, err := h.taxonomyUsecase.UpdateTerm(r.Context(), taxonomy, term)
+		if err != nil {
+			h.usecaseError(w, err)
+			return
+		}
+
+		response.SuccessResponse(w, http.StatusOK, updated)
+	}
+}
+
+// DeleteTerm handles the HTTP DELETE request to delete a genre or tag.
+// @Summary Delete genre or tag
+// @Description Deletes a genre or tag and unclassifies its films.
+// @Tags taxonomy
+// @Produce json
+// @Param id path integer true "ID of the term"
+// @Success 200 {string} string "Term deleted"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Term not found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /genres/{id} [delete]
+// @Router /tags/{id} [delete]
+func (h *TaxonomyHandler) DeleteTerm(taxonomy string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+		if err != nil {
+			h.logger.Error(err)
+			response.ErrorResponse(w, http.StatusBadRequest, response.InvalidURLParameter, h.logger)
+			return
+		}
+
+		if err := h.taxonomyUsecase.DeleteTerm(r.Context(), taxonomy, id); err != nil {
+			h.usecaseError(w, err)
+			return
+		}
+
+		response.SuccessResponse(w, http.StatusOK, response.NIL())
+	}
+}
+
+// LinkFilm handles the HTTP POST request to classify a film under a genre or tag.
+// @Summary Link genre or tag to film
+// @Description Classifies a film under a genre or tag.
+// @Tags films
+// @Produce json
+// @Param id path integer true "ID of the film"
+// @Param termId path integer true "ID of the genre or tag"
+// @Success 201 {string} string "Film classified"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Film or term not found"
+// @Failure 409 {string} string "Film is already classified under the term"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /films/{id}/genres/{termId} [post]
+// @Router /films/{id}/tags/{termId} [post]
+func (h *TaxonomyHandler) LinkFilm(taxonomy string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		filmId, termId, err := filmTermParams(r)
+		if err != nil {
+			h.logger.Error(err)
+			response.ErrorResponse(w, http.StatusBadRequest, response.InvalidURLParameter, h.logger)
+			return
+		}
+
+		if err := h.taxonomyUsecase.LinkFilm(r.Context(), taxonomy, filmId, termId); err != nil {
+			h.usecaseError(w, err)
+			return
+		}
+		response.SuccessResponse(w, http.StatusCreated, response.NIL())
+	}
+}
+
+// UnlinkFilm handles the HTTP DELETE request to unclassify a film.
+// @Summary Unlink genre or tag from film
+// @Description Removes a genre or tag from a film.
+// @Tags films
+// @Produce json
+// @Param id path integer true "ID of the film"
+// @Param termId path integer true "ID of the genre or tag"
+// @Success 200 {string} string "Film unclassified"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Film is not classified under the term"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /films/{id}/genres/{termId} [delete]
+// @Router /films/{id}/tags/{termId} [delete]
+func (h *TaxonomyHandler) UnlinkFilm(taxonomy string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		filmId, termId, err := filmTermParams(r)
+		if err != nil {
+			h.logger.Error(err)
+			response.ErrorResponse(w, http.StatusBadRequest, response.InvalidURLParameter, h.logger)
+			return
+		}
+
+		if err := h.taxonomyUsecase.UnlinkFilm(r.Context(), taxonomy, filmId, termId); err != nil {
+			h.usecaseError(w, err)
+			return
+		}
+		response.SuccessResponse(w, http.StatusOK, response.NIL())
+	}
+}
+
+func filmTermParams(r *http.Request) (uint64, uint64, error) {
+	filmId, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	termId, err := strconv.ParseUint(r.PathValue("termId"), 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	return filmId, termId, nil
+}
+
+// term decodes and validates a genre or tag from the request body.
+func (h *TaxonomyHandler) term(w http.ResponseWriter, r *http.Request) (model.Term, bool) {
+	var term model.Term
+	if err := easyjson.UnmarshalFromReader(r.Body, &term); err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Corrupted request body", h.logger)
+		return model.Term{}, false
+	}
+
+	v := validator.New()
+	if err := v.Struct(term); err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Invalid request", h.logger)
+		return model.Term{}, false
+	}
+	return term, true
+}
+
+// usecaseError maps errors returned by the taxonomy usecase onto HTTP responses.
+func (h *TaxonomyHandler) usecaseError(w http.ResponseWriter, err error) {
+	var notFound *model.ErrNotFound
+	var conflict *model.ErrConflict
+
+	switch {
+	case errors.As(err, &notFound):
+		response.ErrorResponse(w, http.StatusNotFound, notFound.Error(), h.logger)
+	case errors.As(err, &conflict):
+		response.ErrorResponse(w, http.StatusConflict, conflict.Error(), h.logger)
+	default:
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusInternalServerError, "Internal server error", h.logger)
+	}
+}
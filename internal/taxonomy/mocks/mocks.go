@@ -0,0 +1,280 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/taxonomy/taxonomy.go
+
+// Package mock_taxonomy is a generated GoMock package.
+package mock_taxonomy
+
+import (
+	context "context"
+	model "films_library/internal/model"
+	pagination "films_library/pkg/pagination"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUsecase is a mock of Usecase interface.
+type MockUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseMockRecorder
+}
+
+// MockUsecaseMockRecorder is the mock recorder for MockUsecase.
+type MockUsecaseMockRecorder struct {
+	mock *MockUsecase
+}
+
+// NewMockUsecase creates a new mock instance.
+func NewMockUsecase(ctrl *gomock.Controller) *MockUsecase {
+	mock := &MockUsecase{ctrl: ctrl}
+	mock.recorder = &MockUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
+	return m.recorder
+}
+
+// AddTerm mocks base method.
+func (m *MockUsecase) AddTerm(ctx context.Context, taxonomy string, term model.Term) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTerm", ctx, taxonomy, term)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTerm indicates an expected call of AddTerm.
+func (mr *MockUsecaseMockRecorder) AddTerm(ctx, taxonomy, term interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTerm", reflect.TypeOf((*MockUsecase)(nil).AddTerm), ctx, taxonomy, term)
+}
+
+// DeleteTerm mocks base method.
+func (m *MockUsecase) DeleteTerm(ctx context.Context, taxonomy string, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTerm", ctx, taxonomy, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTerm indicates an expected call of DeleteTerm.
+func (mr *MockUsecaseMockRecorder) DeleteTerm(ctx, taxonomy, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTerm", reflect.TypeOf((*MockUsecase)(nil).DeleteTerm), ctx, taxonomy, id)
+}
+
+// GetTerm mocks base method.
+func (m *MockUsecase) GetTerm(ctx context.Context, taxonomy string, id uint64) (model.Term, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTerm", ctx, taxonomy, id)
+	ret0, _ := ret[0].(model.Term)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTerm indicates an expected call of GetTerm.
+func (mr *MockUsecaseMockRecorder) GetTerm(ctx, taxonomy, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTerm", reflect.TypeOf((*MockUsecase)(nil).GetTerm), ctx, taxonomy, id)
+}
+
+// GetTerms mocks base method.
+func (m *MockUsecase) GetTerms(ctx context.Context, filter model.TermFilter) ([]model.Term, pagination.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTerms", ctx, filter)
+	ret0, _ := ret[0].([]model.Term)
+	ret1, _ := ret[1].(pagination.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTerms indicates an expected call of GetTerms.
+func (mr *MockUsecaseMockRecorder) GetTerms(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTerms", reflect.TypeOf((*MockUsecase)(nil).GetTerms), ctx, filter)
+}
+
+// LinkFilm mocks base method.
+func (m *MockUsecase) LinkFilm(ctx context.Context, taxonomy string, filmID, termID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LinkFilm", ctx, taxonomy, filmID, termID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LinkFilm indicates an expected call of LinkFilm.
+func (mr *MockUsecaseMockRecorder) LinkFilm(ctx, taxonomy, filmID, termID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkFilm", reflect.TypeOf((*MockUsecase)(nil).LinkFilm), ctx, taxonomy, filmID, termID)
+}
+
+// UnlinkFilm mocks base method.
+func (m *MockUsecase) UnlinkFilm(ctx context.Context, taxonomy string, filmID, termID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlinkFilm", ctx, taxonomy, filmID, termID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnlinkFilm indicates an expected call of UnlinkFilm.
+func (mr *MockUsecaseMockRecorder) UnlinkFilm(ctx, taxonomy, filmID, termID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlinkFilm", reflect.TypeOf((*MockUsecase)(nil).UnlinkFilm), ctx, taxonomy, filmID, termID)
+}
+
+// UpdateTerm mocks base method.
+func (m *MockUsecase) UpdateTerm(ctx context.Context, taxonomy string, term model.Term) (model.Term, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTerm", ctx, taxonomy, term)
+	ret0, _ := ret[0].(model.Term)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTerm indicates an expected call of UpdateTerm.
+func (mr *MockUsecaseMockRecorder) UpdateTerm(ctx, taxonomy, term interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTerm", reflect.TypeOf((*MockUsecase)(nil).UpdateTerm), ctx, taxonomy, term)
+}
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddTerm mocks base method.
+func (m *MockRepository) AddTerm(ctx context.Context, taxonomy string, term model.Term) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTerm", ctx, taxonomy, term)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTerm indicates an expected call of AddTerm.
+func (mr *MockRepositoryMockRecorder) AddTerm(ctx, taxonomy, term interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTerm", reflect.TypeOf((*MockRepository)(nil).AddTerm), ctx, taxonomy, term)
+}
+
+// CountTerms mocks base method.
+func (m *MockRepository) CountTerms(ctx context.Context, filter model.TermFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountTerms", ctx, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountTerms indicates an expected call of CountTerms.
+func (mr *MockRepositoryMockRecorder) CountTerms(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountTerms", reflect.TypeOf((*MockRepository)(nil).CountTerms), ctx, filter)
+}
+
+// DeleteTerm mocks base method.
+func (m *MockRepository) DeleteTerm(ctx context.Context, taxonomy string, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTerm", ctx, taxonomy, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTerm indicates an expected call of DeleteTerm.
+func (mr *MockRepositoryMockRecorder) DeleteTerm(ctx, taxonomy, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTerm", reflect.TypeOf((*MockRepository)(nil).DeleteTerm), ctx, taxonomy, id)
+}
+
+// GetTerm mocks base method.
+func (m *MockRepository) GetTerm(ctx context.Context, taxonomy string, id uint64) (model.Term, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTerm", ctx, taxonomy, id)
+	ret0, _ := ret[0].(model.Term)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTerm indicates an expected call of GetTerm.
+func (mr *MockRepositoryMockRecorder) GetTerm(ctx, taxonomy, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTerm", reflect.TypeOf((*MockRepository)(nil).GetTerm), ctx, taxonomy, id)
+}
+
+// GetTerms mocks base method.
+func (m *MockRepository) GetTerms(ctx context.Context, filter model.TermFilter) ([]model.Term, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTerms", ctx, filter)
+	ret0, _ := ret[0].([]model.Term)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTerms indicates an expected call of GetTerms.
+func (mr *MockRepositoryMockRecorder) GetTerms(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTerms", reflect.TypeOf((*MockRepository)(nil).GetTerms), ctx, filter)
+}
+
+// LinkFilm mocks base method.
+func (m *MockRepository) LinkFilm(ctx context.Context, taxonomy string, filmID, termID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LinkFilm", ctx, taxonomy, filmID, termID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LinkFilm indicates an expected call of LinkFilm.
+func (mr *MockRepositoryMockRecorder) LinkFilm(ctx, taxonomy, filmID, termID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkFilm", reflect.TypeOf((*MockRepository)(nil).LinkFilm), ctx, taxonomy, filmID, termID)
+}
+
+// UnlinkFilm mocks base method.
+func (m *MockRepository) UnlinkFilm(ctx context.Context, taxonomy string, filmID, termID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlinkFilm", ctx, taxonomy, filmID, termID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnlinkFilm indicates an expected call of UnlinkFilm.
+func (mr *MockRepositoryMockRecorder) UnlinkFilm(ctx, taxonomy, filmID, termID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlinkFilm", reflect.TypeOf((*MockRepository)(nil).UnlinkFilm), ctx, taxonomy, filmID, termID)
+}
+
+// UpdateTerm mocks base method.
+func (m *MockRepository) UpdateTerm(ctx context.Context, taxonomy string, term model.Term) (model.Term, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTerm", ctx, taxonomy, term)
+	ret0, _ := ret[0].(model.Term)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTerm indicates an expected call of UpdateTerm.
+func (mr *MockRepositoryMockRecorder) UpdateTerm(ctx, taxonomy, term interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTerm", reflect.TypeOf((*MockRepository)(nil).UpdateTerm), ctx, taxonomy, term)
+}
@@ -0,0 +1,228 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"films_library/internal/model"
+	"films_library/pkg/pagination"
+	"films_library/pkg/postgres"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	foreignKeyViolation = "23503"
+	uniqueViolation     = "23505"
+)
+
+// table names the columns storing the terms of one taxonomy and their links
+// to films. Taxonomies are never spliced into SQL other than through it.
+type table struct {
+	name string
+	id   string
+	link string
+}
+
+var tables = map[string]table{
+	model.TaxonomyGenre: {name: "genre", id: "genre_id", link: "film_genre"},
+	model.TaxonomyTag:   {name: "tag", id: "tag_id", link: "film_tag"},
+}
+
+func lookup(taxonomy string) (table, error) {
+	t, ok := tables[taxonomy]
+	if !ok {
+		return table{}, fmt.Errorf("unknown taxonomy %q", taxonomy)
+	}
+	return t, nil
+}
+
+type Repository struct {
+	db postgres.DBConn
+}
+
+func NewRepository(db postgres.DBConn) *Repository {
+	return &Repository{db}
+}
+
+func (r *Repository) GetTerms(ctx context.Context, filter model.TermFilter) ([]model.Term, error) {
+	t, err := lookup(filter.Taxonomy)
+	if err != nil {
+		return nil, err
+	}
+
+	sqlQuery := fmt.Sprintf(`SELECT %s, "name" FROM %s`, t.id, t.name)
+
+	backward := filter.Backward()
+
+	var args []interface{}
+	condition, clauses := filter.Keyset(pagination.Order{Key: t.id}, &args)
+	if condition != "" {
+		sqlQuery += " WHERE " + condition
+	}
+	sqlQuery += clauses
+
+	rows, err := r.db.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var terms []model.Term
+	for rows.Next() {
+		var term model.Term
+		if err := rows.Scan(&term.ID, &term.Name); err != nil {
+			return nil, err
+		}
+		terms = append(terms, term)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if backward {
+		slices.Reverse(terms)
+	}
+	return terms, nil
+}
+
+func (r *Repository) CountTerms(ctx context.Context, filter model.TermFilter) (int64, error) {
+	t, err := lookup(filter.Taxonomy)
+	if err != nil {
+		return 0, err
+	}
+
+	var total int64
+	if err := r.db.QueryRow(ctx, `SELECT count(*) FROM `+t.name).Scan(&total); err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+func (r *Repository) GetTerm(ctx context.Context, taxonomy string, id uint64) (model.Term, error) {
+	t, err := lookup(taxonomy)
+	if err != nil {
+		return model.Term{}, err
+	}
+
+	sqlQuery := fmt.Sprintf(`SELECT %[1]s, "name" FROM %[2]s WHERE %[1]s=$1`, t.id, t.name)
+
+	var term model.Term
+	if err := r.db.QueryRow(ctx, sqlQuery, id).Scan(&term.ID, &term.Name); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Term{}, &model.ErrNotFound{Message: taxonomy + " not found"}
+		}
+		return model.Term{}, err
+	}
+	return term, nil
+}
+
+func (r *Repository) AddTerm(ctx context.Context, taxonomy string, term model.Term) (uint64, error) {
+	t, err := lookup(taxonomy)
+	if err != nil {
+		return 0, err
+	}
+
+	sqlQuery := fmt.Sprintf(`INSERT INTO %s ("name") VALUES ($1) RETURNING %s`, t.name, t.id)
+
+	var id uint64
+	if err := r.db.QueryRow(ctx, sqlQuery, term.Name).Scan(&id); err != nil {
+		return 0, termError(taxonomy, err)
+	}
+	return id, nil
+}
+
+func (r *Repository) UpdateTerm(ctx context.Context, taxonomy string, term model.Term) (model.Term, error) {
+	t, err := lookup(taxonomy)
+	if err != nil {
+		return model.Term{}, err
+	}
+
+	sqlQuery := fmt.Sprintf(`UPDATE %s SET "name"=$2 WHERE %s=$1`, t.name, t.id)
+
+	res, err := r.db.Exec(ctx, sqlQuery, term.ID, term.Name)
+	if err != nil {
+		return model.Term{}, termError(taxonomy, err)
+	}
+	if res.RowsAffected() == 0 {
+		return model.Term{}, &model.ErrNotFound{Message: taxonomy + " not found"}
+	}
+	return term, nil
+}
+
+func (r *Repository) DeleteTerm(ctx context.Context, taxonomy string, id uint64) error {
+	t, err := lookup(taxonomy)
+	if err != nil {
+		return err
+	}
+
+	res, err := r.db.Exec(ctx, fmt.Sprintf(`DELETE FROM %s WHERE %s=$1`, t.name, t.id), id)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return &model.ErrNotFound{Message: taxonomy + " not found"}
+	}
+	return nil
+}
+
+func (r *Repository) LinkFilm(ctx context.Context, taxonomy string, filmID, termID uint64) error {
+	t, err := lookup(taxonomy)
+	if err != nil {
+		return err
+	}
+
+	sqlQuery := fmt.Sprintf(`INSERT INTO %s (film_id, %s) VALUES ($1, $2)`, t.link, t.id)
+
+	if _, err := r.db.Exec(ctx, sqlQuery, filmID, termID); err != nil {
+		return filmTermError(taxonomy, t, err)
+	}
+	return nil
+}
+
+func (r *Repository) UnlinkFilm(ctx context.Context, taxonomy string, filmID, termID uint64) error {
+	t, err := lookup(taxonomy)
+	if err != nil {
+		return err
+	}
+
+	sqlQuery := fmt.Sprintf(`DELETE FROM %s WHERE film_id=$1 AND %s=$2`, t.link, t.id)
+
+	res, err := r.db.Exec(ctx, sqlQuery, filmID, termID)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return &model.ErrNotFound{Message: "film is not classified under this " + taxonomy}
+	}
+	return nil
+}
+
+func termError(taxonomy string, err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return &model.ErrConflict{Message: taxonomy + " already exists"}
+	}
+	return err
+}
+
+func filmTermError(taxonomy string, t table, err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+
+	switch pgErr.Code {
+	case foreignKeyViolation:
+		if pgErr.ConstraintName == t.link+"_film_id_fkey" {
+			return &model.ErrNotFound{Message: "film not found"}
+		}
+		return &model.ErrNotFound{Message: taxonomy + " not found"}
+	case uniqueViolation:
+		return &model.ErrConflict{Message: "film is already classified under this " + taxonomy}
+	}
+	return err
+}
@@ -0,0 +1,35 @@
+package taxonomy
+
+import (
+	"context"
+
+	"films_library/internal/model"
+	"films_library/pkg/pagination"
+)
+
+// Every method takes the taxonomy, model.TaxonomyGenre or model.TaxonomyTag,
+// the terms belong to.
+type (
+	Usecase interface {
+		GetTerms(ctx context.Context, filter model.TermFilter) ([]model.Term, pagination.Page, error)
+		GetTerm(ctx context.Context, taxonomy string, id uint64) (model.Term, error)
+		AddTerm(ctx context.Context, taxonomy string, term model.Term) (uint64, error)
+		UpdateTerm(ctx context.Context, taxonomy string, term model.Term) (model.Term, error)
+		DeleteTerm(ctx context.Context, taxonomy string, id uint64) error
+
+		LinkFilm(ctx context.Context, taxonomy string, filmID, termID uint64) error
+		UnlinkFilm(ctx context.Context, taxonomy string, filmID, termID uint64) error
+	}
+
+	Repository interface {
+		GetTerms(ctx context.Context, filter model.TermFilter) ([]model.Term, error)
+		CountTerms(ctx context.Context, filter model.TermFilter) (int64, error)
+		GetTerm(ctx context.Context, taxonomy string, id uint64) (model.Term, error)
+		AddTerm(ctx context.Context, taxonomy string, term model.Term) (uint64, error)
+		UpdateTerm(ctx context.Context, taxonomy string, term model.Term) (model.Term, error)
+		DeleteTerm(ctx context.Context, taxonomy string, id uint64) error
+
+		LinkFilm(ctx context.Context, taxonomy string, filmID, termID uint64) error
+		UnlinkFilm(ctx context.Context, taxonomy string, filmID, termID uint64) error
+	}
+)
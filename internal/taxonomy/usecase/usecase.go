@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+
+	"films_library/internal/model"
+	"films_library/internal/taxonomy"
+	"films_library/pkg/logger"
+	"films_library/pkg/pagination"
+)
+
+type TaxonomyUsecase struct {
+	taxonomyRepo taxonomy.Repository
+	logger       logger.Interface
+}
+
+func NewTaxonomyUsecase(tr taxonomy.Repository, l logger.Interface) *TaxonomyUsecase {
+	return &TaxonomyUsecase{tr, l}
+}
+
+func (tu *TaxonomyUsecase) GetTerms(ctx context.Context, filter model.TermFilter) ([]model.Term, pagination.Page, error) {
+	terms, err := tu.taxonomyRepo.GetTerms(ctx, filter)
+	if err != nil {
+		return []model.Term{}, pagination.Page{}, err
+	}
+
+	terms, page := pagination.Paginate(terms, filter.Params, func(term model.Term) pagination.Cursor {
+		return pagination.Cursor{ID: term.ID}
+	})
+
+	if filter.WithTotal {
+		total, err := tu.taxonomyRepo.CountTerms(ctx, filter)
+		if err != nil {
+			return []model.Term{}, pagination.Page{}, err
+		}
+		page.Total = &total
+	}
+	return terms, page, nil
+}
+
+func (tu *TaxonomyUsecase) GetTerm(ctx context.Context, taxonomy string, id uint64) (model.Term, error) {
+	return tu.taxonomyRepo.GetTerm(ctx, taxonomy, id)
+}
+
+func (tu *TaxonomyUsecase) AddTerm(ctx context.Context, taxonomy string, term model.Term) (uint64, error) {
+	return tu.taxonomyRepo.AddTerm(ctx, taxonomy, term)
+}
+
+func (tu *TaxonomyUsecase) UpdateTerm(ctx context.Context, taxonomy string, term model.Term) (model.Term, error) {
+	return tu.taxonomyRepo.UpdateTerm(ctx, taxonomy, term)
+}
+
+func (tu *TaxonomyUsecase) DeleteTerm(ctx context.Context, taxonomy string, id uint64) error {
+	return tu.taxonomyRepo.DeleteTerm(ctx, taxonomy, id)
+}
+
+func (tu *TaxonomyUsecase) LinkFilm(ctx context.Context, taxonomy string, filmID, termID uint64) error {
+	return tu.taxonomyRepo.LinkFilm(ctx, taxonomy, filmID, termID)
+}
+
+func (tu *TaxonomyUsecase) UnlinkFilm(ctx context.Context, taxonomy string, filmID, termID uint64) error {
+	return tu.taxonomyRepo.UnlinkFilm(ctx, taxonomy, filmID, termID)
+}
@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"films_library/internal/model"
+	mock_taxonomy "films_library/internal/taxonomy/mocks"
+	"films_library/pkg/logger"
+	"films_library/pkg/pagination"
+
+	"github.com/golang/mock/gomock"
+)
+
+func TestTaxonomyUsecase_GetTerms(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	loggerMock := logger.NewMockInterface(ctrl)
+	taxonomyRepo := mock_taxonomy.NewMockRepository(ctrl)
+	usecase := NewTaxonomyUsecase(taxonomyRepo, loggerMock)
+
+	ctx := context.Background()
+
+	terms := []model.Term{{ID: 1, Name: "Drama"}, {ID: 2, Name: "Comedy"}}
+	var total int64 = 2
+
+	testCases := []struct {
+		name          string
+		filter        model.TermFilter
+		repoTerms     []model.Term
+		repoError     error
+		countTotal    bool
+		expectedTerms []model.Term
+		expectedPage  pagination.Page
+		expectedError error
+	}{
+		{
+			name:          "Single page",
+			filter:        model.TermFilter{Taxonomy: model.TaxonomyGenre, Params: pagination.Params{Limit: 5}},
+			repoTerms:     terms,
+			expectedTerms: terms,
+		},
+		{
+			name:          "More terms follow",
+			filter:        model.TermFilter{Taxonomy: model.TaxonomyTag, Params: pagination.Params{Limit: 1}},
+			repoTerms:     terms,
+			expectedTerms: terms[:1],
+			expectedPage:  pagination.Page{Next: pagination.Encode(pagination.Cursor{ID: 1})},
+		},
+		{
+			name:          "With total",
+			filter:        model.TermFilter{Taxonomy: model.TaxonomyGenre, Params: pagination.Params{WithTotal: true}},
+			repoTerms:     terms,
+			countTotal:    true,
+			expectedTerms: terms,
+			expectedPage:  pagination.Page{Total: &total},
+		},
+		{
+			name:          "Error from repository",
+			filter:        model.TermFilter{Taxonomy: model.TaxonomyGenre},
+			repoError:     errors.New("repository error"),
+			expectedTerms: []model.Term{},
+			expectedError: errors.New("repository error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			taxonomyRepo.EXPECT().GetTerms(ctx, tc.filter).Return(tc.repoTerms, tc.repoError)
+			if tc.countTotal {
+				taxonomyRepo.EXPECT().CountTerms(ctx, tc.filter).Return(total, nil)
+			}
+
+			terms, page, err := usecase.GetTerms(ctx, tc.filter)
+			if (err == nil) != (tc.expectedError == nil) || err != nil && err.Error() != tc.expectedError.Error() {
+				t.Errorf("Unexpected error: expected %v, got %v", tc.expectedError, err)
+			}
+
+			if !reflect.DeepEqual(terms, tc.expectedTerms) {
+				t.Errorf("Expected terms %v, got %v", tc.expectedTerms, terms)
+			}
+
+			if !reflect.DeepEqual(page, tc.expectedPage) {
+				t.Errorf("Expected page %v, got %v", tc.expectedPage, page)
+			}
+		})
+	}
+}
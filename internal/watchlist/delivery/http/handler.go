@@ -0,0 +1,315 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"films_library/internal/auth"
+	"films_library/internal/model"
+	"films_library/internal/watchlist"
+	"films_library/pkg/logger"
+	"films_library/pkg/pagination"
+	"films_library/pkg/response"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mailru/easyjson"
+)
+
+type WatchlistHandler struct {
+	watchlistUsecase watchlist.Usecase
+	logger           logger.Interface
+}
+
+// WatchlistPermissions is the permission each watchlist and history route requires.
+var WatchlistPermissions = auth.Permissions{
+	"GET /me/watchlist":             auth.WatchlistRead,
+	"POST /me/watchlist/{filmId}":   auth.WatchlistWrite,
+	"DELETE /me/watchlist/{filmId}": auth.WatchlistWrite,
+	"PUT /me/watchlist":             auth.WatchlistWrite,
+	"GET /me/history":               auth.WatchlistRead,
+	"POST /me/history":              auth.WatchlistWrite,
+	"DELETE /me/history/{id}":       auth.WatchlistWrite,
+}
+
+func NewWatchlistHandler(mux *http.ServeMux, wu watchlist.Usecase, l logger.Interface) {
+	r := &WatchlistHandler{wu, l}
+
+	mux.HandleFunc("GET /me/watchlist", r.GetWatchlist)
+	mux.HandleFunc("POST /me/watchlist/{filmId}", r.AddToWatchlist)
+	mux.HandleFunc("DELETE /me/watchlist/{filmId}", r.RemoveFromWatchlist)
+	mux.HandleFunc("PUT /me/watchlist", r.ReorderWatchlist)
+	mux.HandleFunc("GET /me/history", r.GetHistory)
+	mux.HandleFunc("POST /me/history", r.AddHistory)
+	mux.HandleFunc("DELETE /me/history/{id}", r.DeleteHistory)
+}
+
+// GetWatchlist handles the HTTP GET request to retrieve the caller's watchlist.
+// @Summary Get watchlist
+// @Description Retrieves a page of the caller's watchlist, in its order, with the films.
+// @Tags watchlist
+// @Produce json
+// @Param limit query integer false "Page size (default 20, max 100)"
+// @Param offset query integer false "Number of entries to skip"
+// @Param cursor query string false "Cursor of the page to fetch"
+// @Success 200 {array} model.WatchlistEntry "Watchlist"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /me/watchlist [get]
+func (h *WatchlistHandler) GetWatchlist(w http.ResponseWriter, r *http.Request) {
+	filter, ok := h.listFilter(w, r)
+	if !ok {
+		return
+	}
+
+	entries, page, err := h.watchlistUsecase.GetWatchlist(r.Context(), filter)
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.PageResponse(w, http.StatusOK, entries, page)
+}
+
+// AddToWatchlist handles the HTTP POST request to put a film on the caller's watchlist.
+// @Summary Add to watchlist
+// @Description Puts a film at the end of the caller's watchlist.
+// @Tags watchlist
+// @Produce json
+// @Param filmId path integer true "ID of the film"
+// @Success 201 {string} string "Film added"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 404 {string} string "Film not found"
+// @Failure 409 {string} string "Film is already on the watchlist"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /me/watchlist/{filmId} [post]
+func (h *WatchlistHandler) AddToWatchlist(w http.ResponseWriter, r *http.Request) {
+	user, filmId, ok := h.userAndID(w, r, "filmId")
+	if !ok {
+		return
+	}
+
+	if err := h.watchlistUsecase.AddToWatchlist(r.Context(), user.ID, filmId); err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusCreated, response.NIL())
+}
+
+// RemoveFromWatchlist handles the HTTP DELETE request to take a film off the caller's watchlist.
+// @Summary Remove from watchlist
+// @Description Takes a film off the caller's watchlist.
+// @Tags watchlist
+// @Produce json
+// @Param filmId path integer true "ID of the film"
+// @Success 200 {string} string "Film removed"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 404 {string} string "Film is not on the watchlist"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /me/watchlist/{filmId} [delete]
+func (h *WatchlistHandler) RemoveFromWatchlist(w http.ResponseWriter, r *http.Request) {
+	user, filmId, ok := h.userAndID(w, r, "filmId")
+	if !ok {
+		return
+	}
+
+	if err := h.watchlistUsecase.RemoveFromWatchlist(r.Context(), user.ID, filmId); err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusOK, response.NIL())
+}
+
+// ReorderWatchlist handles the HTTP PUT request to reorder the caller's watchlist.
+// @Summary Reorder watchlist
+// @Description Reorders the caller's watchlist. The body lists every film on it exactly once.
+// @Tags watchlist
+// @Accept json
+// @Produce json
+// @Param order body model.FilmOrder true "Films in their new order"
+// @Success 200 {string} string "Watchlist reordered"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 409 {string} string "Order does not match the watchlist"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /me/watchlist [put]
+func (h *WatchlistHandler) ReorderWatchlist(w http.ResponseWriter, r *http.Request) {
+	user, ok := auth.UserFromContext(r.Context())
+	if !ok {
+		response.ErrorResponse(w, http.StatusUnauthorized, "unauthorized", h.logger)
+		return
+	}
+
+	var order model.FilmOrder
+	if err := easyjson.UnmarshalFromReader(r.Body, &order); err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Corrupted request body", h.logger)
+		return
+	}
+
+	v := validator.New()
+	if err := v.Struct(order); err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Invalid request", h.logger)
+		return
+	}
+
+	if err := h.watchlistUsecase.ReorderWatchlist(r.Context(), user.ID, order); err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusOK, response.NIL())
+}
+
+// GetHistory handles the HTTP GET request to retrieve the caller's watched history.
+// @Summary Get watched history
+// @Description Retrieves a page of the caller's viewings, latest first, with the films.
+// @Tags watchlist
+// @Produce json
+// @Param limit query integer false "Page size (default 20, max 100)"
+// @Param offset query integer false "Number of entries to skip"
+// @Param cursor query string false "Cursor of the page to fetch"
+// @Success 200 {array} model.HistoryEntry "Watched history"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /me/history [get]
+func (h *WatchlistHandler) GetHistory(w http.ResponseWriter, r *http.Request) {
+	filter, ok := h.listFilter(w, r)
+	if !ok {
+		return
+	}
+
+	entries, page, err := h.watchlistUsecase.GetHistory(r.Context(), filter)
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.PageResponse(w, http.StatusOK, entries, page)
+}
+
+// AddHistory handles the HTTP POST request to record a viewing.
+// @Summary Record viewing
+// @Description Records that the caller watched a film on a day. Without rewatch_count, earlier viewings are counted.
+// @Tags watchlist
+// @Accept json
+// @Produce json
+// @Param entry body model.AddHistoryRequest true "Viewing"
+// @Success 201 {integer} integer "ID of the history entry"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 404 {string} string "Film not found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /me/history [post]
+func (h *WatchlistHandler) AddHistory(w http.ResponseWriter, r *http.Request) {
+	user, ok := auth.UserFromContext(r.Context())
+	if !ok {
+		response.ErrorResponse(w, http.StatusUnauthorized, "unauthorized", h.logger)
+		return
+	}
+
+	var entry model.AddHistoryRequest
+	if err := easyjson.UnmarshalFromReader(r.Body, &entry); err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Corrupted request body", h.logger)
+		return
+	}
+
+	v := validator.New()
+	if err := v.Struct(entry); err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Invalid request", h.logger)
+		return
+	}
+
+	id, err := h.watchlistUsecase.AddHistory(r.Context(), user.ID, entry)
+	if err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusCreated, id)
+}
+
+// DeleteHistory handles the HTTP DELETE request to delete a history entry.
+// @Summary Delete viewing
+// @Description Deletes an entry of the caller's watched history.
+// @Tags watchlist
+// @Produce json
+// @Param id path integer true "ID of the history entry"
+// @Success 200 {string} string "Entry deleted"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 404 {string} string "History entry not found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /me/history/{id} [delete]
+func (h *WatchlistHandler) DeleteHistory(w http.ResponseWriter, r *http.Request) {
+	user, id, ok := h.userAndID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	if err := h.watchlistUsecase.DeleteHistory(r.Context(), user.ID, id); err != nil {
+		h.usecaseError(w, err)
+		return
+	}
+
+	response.SuccessResponse(w, http.StatusOK, response.NIL())
+}
+
+// listFilter reads the page of the caller's list a request asks for.
+func (h *WatchlistHandler) listFilter(w http.ResponseWriter, r *http.Request) (model.ListFilter, bool) {
+	user, ok := auth.UserFromContext(r.Context())
+	if !ok {
+		response.ErrorResponse(w, http.StatusUnauthorized, "unauthorized", h.logger)
+		return model.ListFilter{}, false
+	}
+
+	params, err := pagination.ParseQuery(r.URL.Query())
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, "Bad query param", h.logger)
+		return model.ListFilter{}, false
+	}
+	return model.ListFilter{UserID: user.ID, Params: params}, true
+}
+
+// userAndID reads the caller and the ID in the named path segment.
+func (h *WatchlistHandler) userAndID(w http.ResponseWriter, r *http.Request, name string) (model.User, uint64, bool) {
+	user, ok := auth.UserFromContext(r.Context())
+	if !ok {
+		response.ErrorResponse(w, http.StatusUnauthorized, "unauthorized", h.logger)
+		return model.User{}, 0, false
+	}
+
+	id, err := strconv.ParseUint(r.PathValue(name), 10, 64)
+	if err != nil {
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusBadRequest, response.InvalidURLParameter, h.logger)
+		return model.User{}, 0, false
+	}
+	return user, id, true
+}
+
+// usecaseError maps errors returned by the watchlist usecase onto HTTP responses.
+func (h *WatchlistHandler) usecaseError(w http.ResponseWriter, err error) {
+	var notFound *model.ErrNotFound
+	var conflict *model.ErrConflict
+
+	switch {
+	case errors.As(err, &notFound):
+		response.ErrorResponse(w, http.StatusNotFound, notFound.Error(), h.logger)
+	case errors.As(err, &conflict):
+		response.ErrorResponse(w, http.StatusConflict, conflict.Error(), h.logger)
+	default:
+		h.logger.Error(err)
+		response.ErrorResponse(w, http.StatusInternalServerError, "Internal server error", h.logger)
+	}
+}
@@ -0,0 +1,264 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/watchlist/watchlist.go
+
+// Package mock_watchlist is a generated GoMock package.
+package mock_watchlist
+
+import (
+	context "context"
+	model "films_library/internal/model"
+	pagination "films_library/pkg/pagination"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUsecase is a mock of Usecase interface.
+type MockUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseMockRecorder
+}
+
+// MockUsecaseMockRecorder is the mock recorder for MockUsecase.
+type MockUsecaseMockRecorder struct {
+	mock *MockUsecase
+}
+
+// NewMockUsecase creates a new mock instance.
+func NewMockUsecase(ctrl *gomock.Controller) *MockUsecase {
+	mock := &MockUsecase{ctrl: ctrl}
+	mock.recorder = &MockUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
+	return m.recorder
+}
+
+// AddHistory mocks base method.
+func (m *MockUsecase) AddHistory(ctx context.Context, userID uint64, entry model.AddHistoryRequest) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddHistory", ctx, userID, entry)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddHistory indicates an expected call of AddHistory.
+func (mr *MockUsecaseMockRecorder) AddHistory(ctx, userID, entry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddHistory", reflect.TypeOf((*MockUsecase)(nil).AddHistory), ctx, userID, entry)
+}
+
+// AddToWatchlist mocks base method.
+func (m *MockUsecase) AddToWatchlist(ctx context.Context, userID, filmID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddToWatchlist", ctx, userID, filmID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddToWatchlist indicates an expected call of AddToWatchlist.
+func (mr *MockUsecaseMockRecorder) AddToWatchlist(ctx, userID, filmID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddToWatchlist", reflect.TypeOf((*MockUsecase)(nil).AddToWatchlist), ctx, userID, filmID)
+}
+
+// DeleteHistory mocks base method.
+func (m *MockUsecase) DeleteHistory(ctx context.Context, userID, entryID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteHistory", ctx, userID, entryID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteHistory indicates an expected call of DeleteHistory.
+func (mr *MockUsecaseMockRecorder) DeleteHistory(ctx, userID, entryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHistory", reflect.TypeOf((*MockUsecase)(nil).DeleteHistory), ctx, userID, entryID)
+}
+
+// GetHistory mocks base method.
+func (m *MockUsecase) GetHistory(ctx context.Context, filter model.ListFilter) ([]model.HistoryEntry, pagination.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistory", ctx, filter)
+	ret0, _ := ret[0].([]model.HistoryEntry)
+	ret1, _ := ret[1].(pagination.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetHistory indicates an expected call of GetHistory.
+func (mr *MockUsecaseMockRecorder) GetHistory(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistory", reflect.TypeOf((*MockUsecase)(nil).GetHistory), ctx, filter)
+}
+
+// GetWatchlist mocks base method.
+func (m *MockUsecase) GetWatchlist(ctx context.Context, filter model.ListFilter) ([]model.WatchlistEntry, pagination.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWatchlist", ctx, filter)
+	ret0, _ := ret[0].([]model.WatchlistEntry)
+	ret1, _ := ret[1].(pagination.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetWatchlist indicates an expected call of GetWatchlist.
+func (mr *MockUsecaseMockRecorder) GetWatchlist(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWatchlist", reflect.TypeOf((*MockUsecase)(nil).GetWatchlist), ctx, filter)
+}
+
+// RemoveFromWatchlist mocks base method.
+func (m *MockUsecase) RemoveFromWatchlist(ctx context.Context, userID, filmID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFromWatchlist", ctx, userID, filmID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveFromWatchlist indicates an expected call of RemoveFromWatchlist.
+func (mr *MockUsecaseMockRecorder) RemoveFromWatchlist(ctx, userID, filmID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFromWatchlist", reflect.TypeOf((*MockUsecase)(nil).RemoveFromWatchlist), ctx, userID, filmID)
+}
+
+// ReorderWatchlist mocks base method.
+func (m *MockUsecase) ReorderWatchlist(ctx context.Context, userID uint64, order model.FilmOrder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderWatchlist", ctx, userID, order)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderWatchlist indicates an expected call of ReorderWatchlist.
+func (mr *MockUsecaseMockRecorder) ReorderWatchlist(ctx, userID, order interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderWatchlist", reflect.TypeOf((*MockUsecase)(nil).ReorderWatchlist), ctx, userID, order)
+}
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddHistory mocks base method.
+func (m *MockRepository) AddHistory(ctx context.Context, userID uint64, entry model.AddHistoryRequest) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddHistory", ctx, userID, entry)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddHistory indicates an expected call of AddHistory.
+func (mr *MockRepositoryMockRecorder) AddHistory(ctx, userID, entry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddHistory", reflect.TypeOf((*MockRepository)(nil).AddHistory), ctx, userID, entry)
+}
+
+// AddToWatchlist mocks base method.
+func (m *MockRepository) AddToWatchlist(ctx context.Context, userID, filmID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddToWatchlist", ctx, userID, filmID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddToWatchlist indicates an expected call of AddToWatchlist.
+func (mr *MockRepositoryMockRecorder) AddToWatchlist(ctx, userID, filmID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddToWatchlist", reflect.TypeOf((*MockRepository)(nil).AddToWatchlist), ctx, userID, filmID)
+}
+
+// DeleteHistory mocks base method.
+func (m *MockRepository) DeleteHistory(ctx context.Context, userID, entryID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteHistory", ctx, userID, entryID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteHistory indicates an expected call of DeleteHistory.
+func (mr *MockRepositoryMockRecorder) DeleteHistory(ctx, userID, entryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHistory", reflect.TypeOf((*MockRepository)(nil).DeleteHistory), ctx, userID, entryID)
+}
+
+// GetHistory mocks base method.
+func (m *MockRepository) GetHistory(ctx context.Context, filter model.ListFilter) ([]model.HistoryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistory", ctx, filter)
+	ret0, _ := ret[0].([]model.HistoryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHistory indicates an expected call of GetHistory.
+func (mr *MockRepositoryMockRecorder) GetHistory(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistory", reflect.TypeOf((*MockRepository)(nil).GetHistory), ctx, filter)
+}
+
+// GetWatchlist mocks base method.
+func (m *MockRepository) GetWatchlist(ctx context.Context, filter model.ListFilter) ([]model.WatchlistEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWatchlist", ctx, filter)
+	ret0, _ := ret[0].([]model.WatchlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWatchlist indicates an expected call of GetWatchlist.
+func (mr *MockRepositoryMockRecorder) GetWatchlist(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWatchlist", reflect.TypeOf((*MockRepository)(nil).GetWatchlist), ctx, filter)
+}
+
+// RemoveFromWatchlist mocks base method.
+func (m *MockRepository) RemoveFromWatchlist(ctx context.Context, userID, filmID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFromWatchlist", ctx, userID, filmID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveFromWatchlist indicates an expected call of RemoveFromWatchlist.
+func (mr *MockRepositoryMockRecorder) RemoveFromWatchlist(ctx, userID, filmID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFromWatchlist", reflect.TypeOf((*MockRepository)(nil).RemoveFromWatchlist), ctx, userID, filmID)
+}
+
+// ReorderWatchlist mocks base method.
+func (m *MockRepository) ReorderWatchlist(ctx context.Context, userID uint64, filmIDs []uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderWatchlist", ctx, userID, filmIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderWatchlist indicates an expected call of ReorderWatchlist.
+func (mr *MockRepositoryMockRecorder) ReorderWatchlist(ctx, userID, filmIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderWatchlist", reflect.TypeOf((*MockRepository)(nil).ReorderWatchlist), ctx, userID, filmIDs)
+}
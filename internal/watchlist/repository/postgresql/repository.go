@@ -0,0 +1,229 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"films_library/internal/model"
+	"films_library/pkg/pagination"
+	"films_library/pkg/postgres"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	foreignKeyViolation = "23503"
+	uniqueViolation     = "23505"
+)
+
+// filmColumns reads films as the film repository does, with a missing release
+// date as 0001-01-01 and a missing rating as -1.
+const filmColumns = `f.film_id, f.title, f."description", COALESCE(f.release_date, '0001-01-01'), COALESCE(f.rating, -1), f.rating_count, f.rating_sum`
+
+type Repository struct {
+	db postgres.DBConn
+}
+
+func NewRepository(db postgres.DBConn) *Repository {
+	return &Repository{db}
+}
+
+// GetWatchlist lists the watchlist of a user in its order.
+func (r *Repository) GetWatchlist(ctx context.Context, filter model.ListFilter) ([]model.WatchlistEntry, error) {
+	sqlQuery := `SELECT w."position", w.added_at, ` + filmColumns + `
+        FROM watchlist w
+        JOIN film f ON f.film_id = w.film_id
+        WHERE w.user_id = $1`
+
+	backward := filter.Backward()
+
+	args := []interface{}{filter.UserID}
+	condition, clauses := filter.Keyset(pagination.Order{Column: `w."position"`, Cast: "int", Key: "w.film_id"}, &args)
+	if condition != "" {
+		sqlQuery += " AND " + condition
+	}
+	sqlQuery += clauses
+
+	rows, err := r.db.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []model.WatchlistEntry
+	for rows.Next() {
+		var entry model.WatchlistEntry
+		if err := rows.Scan(append([]interface{}{&entry.Position, &entry.AddedAt}, filmFields(&entry.Film)...)...); err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if backward {
+		slices.Reverse(entries)
+	}
+	return entries, nil
+}
+
+// AddToWatchlist puts a film at the end of the watchlist of a user.
+func (r *Repository) AddToWatchlist(ctx context.Context, userID, filmID uint64) error {
+	sqlQuery := `
+        INSERT INTO watchlist (user_id, film_id, "position")
+        SELECT $1, $2, COALESCE(max("position"), 0) + 1 FROM watchlist WHERE user_id = $1`
+
+	if _, err := r.db.Exec(ctx, sqlQuery, userID, filmID); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case foreignKeyViolation:
+				return &model.ErrNotFound{Message: "film not found"}
+			case uniqueViolation:
+				return &model.ErrConflict{Message: "film is already on the watchlist"}
+			}
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *Repository) RemoveFromWatchlist(ctx context.Context, userID, filmID uint64) error {
+	res, err := r.db.Exec(ctx, `DELETE FROM watchlist WHERE user_id=$1 AND film_id=$2`, userID, filmID)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return &model.ErrNotFound{Message: "film is not on the watchlist"}
+	}
+	return nil
+}
+
+// ReorderWatchlist numbers the films of a watchlist in the order of filmIDs,
+// which must list each of them once.
+func (r *Repository) ReorderWatchlist(ctx context.Context, userID uint64, filmIDs []uint64) error {
+	return r.db.BeginTxFunc(ctx, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, `SELECT film_id FROM watchlist WHERE user_id=$1 FOR UPDATE`, userID)
+		if err != nil {
+			return err
+		}
+		current := make(map[uint64]bool)
+		for rows.Next() {
+			var filmID uint64
+			if err := rows.Scan(&filmID); err != nil {
+				rows.Close()
+				return err
+			}
+			current[filmID] = true
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return err
+		}
+
+		if len(current) != len(filmIDs) {
+			return &model.ErrConflict{Message: "order must list every film of the watchlist once"}
+		}
+		for _, filmID := range filmIDs {
+			if !current[filmID] {
+				return &model.ErrConflict{Message: "order must list every film of the watchlist once"}
+			}
+		}
+
+		sqlQuery := `
+            UPDATE watchlist w SET "position" = o.ord
+            FROM unnest($2::bigint[]) WITH ORDINALITY AS o(film_id, ord)
+            WHERE w.user_id = $1 AND w.film_id = o.film_id`
+		_, err = tx.Exec(ctx, sqlQuery, userID, filmIDs)
+		return err
+	})
+}
+
+// GetHistory lists the viewings of a user, latest first.
+func (r *Repository) GetHistory(ctx context.Context, filter model.ListFilter) ([]model.HistoryEntry, error) {
+	sqlQuery := `SELECT h.entry_id, to_char(h.watched_on, 'YYYY-MM-DD'), h.rewatch_count, ` + filmColumns + `
+        FROM watch_history h
+        JOIN film f ON f.film_id = h.film_id
+        WHERE h.user_id = $1`
+
+	backward := filter.Backward()
+
+	args := []interface{}{filter.UserID}
+	condition, clauses := filter.Keyset(pagination.Order{Column: "h.watched_on", Cast: "date", Key: "h.entry_id", Desc: true}, &args)
+	if condition != "" {
+		sqlQuery += " AND " + condition
+	}
+	sqlQuery += clauses
+
+	rows, err := r.db.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []model.HistoryEntry
+	for rows.Next() {
+		var entry model.HistoryEntry
+		if err := rows.Scan(append([]interface{}{&entry.ID, &entry.WatchedOn, &entry.RewatchCount}, filmFields(&entry.Film)...)...); err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if backward {
+		slices.Reverse(entries)
+	}
+	return entries, nil
+}
+
+// AddHistory records a viewing. Without a rewatch count, the earlier
+// viewings of the film by the user are counted.
+func (r *Repository) AddHistory(ctx context.Context, userID uint64, entry model.AddHistoryRequest) (uint64, error) {
+	sqlQuery := `
+        INSERT INTO watch_history (user_id, film_id, watched_on, rewatch_count)
+        VALUES ($1, $2, $3::date, COALESCE($4, (
+            SELECT count(*) FROM watch_history
+            WHERE user_id = $1 AND film_id = $2 AND watched_on <= $3::date)))
+        RETURNING entry_id`
+
+	var id uint64
+	err := r.db.QueryRow(ctx, sqlQuery, userID, entry.FilmID, entry.WatchedOn, entry.RewatchCount).Scan(&id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation {
+			return 0, &model.ErrNotFound{Message: "film not found"}
+		}
+		return 0, err
+	}
+	return id, nil
+}
+
+func (r *Repository) DeleteHistory(ctx context.Context, userID, entryID uint64) error {
+	res, err := r.db.Exec(ctx, `DELETE FROM watch_history WHERE user_id=$1 AND entry_id=$2`, userID, entryID)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return &model.ErrNotFound{Message: "history entry not found"}
+	}
+	return nil
+}
+
+// filmFields are the scan destinations of filmColumns.
+func filmFields(film *model.Film) []interface{} {
+	return []interface{}{
+		&film.ID,
+		&film.Title,
+		&film.Description,
+		&film.ReleaseDate,
+		&film.Rating,
+		&film.RatingCount,
+		&film.RatingSum,
+	}
+}
@@ -0,0 +1,94 @@
+package postgresql
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"films_library/internal/model"
+	"films_library/pkg/pagination"
+
+	"github.com/pashagolub/pgxmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReorderWatchlist(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mock.Close()
+
+	repo := NewRepository(mock)
+
+	testCases := []struct {
+		name     string
+		filmIDs  []uint64
+		conflict bool
+	}{
+		{name: "Same films", filmIDs: []uint64{7, 3, 5}},
+		{name: "Film missing", filmIDs: []uint64{7, 3}, conflict: true},
+		{name: "Unknown film", filmIDs: []uint64{7, 3, 8}, conflict: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT film_id FROM watchlist WHERE user_id=$1 FOR UPDATE`)).
+				WithArgs(uint64(2)).
+				WillReturnRows(pgxmock.NewRows([]string{"film_id"}).AddRow(uint64(3)).AddRow(uint64(5)).AddRow(uint64(7)))
+			if tc.conflict {
+				// pgxmock's BeginTxFunc rolls back once on the error and
+				// again in its deferred cleanup.
+				mock.ExpectRollback()
+				mock.ExpectRollback()
+			} else {
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE watchlist w SET "position" = o.ord`)).
+					WithArgs(uint64(2), tc.filmIDs).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 3))
+				mock.ExpectCommit()
+			}
+
+			err := repo.ReorderWatchlist(context.Background(), 2, tc.filmIDs)
+			if tc.conflict {
+				var conflict *model.ErrConflict
+				assert.ErrorAs(t, err, &conflict)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestGetWatchlistUndatedUnratedFilm(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mock.Close()
+
+	repo := NewRepository(mock)
+
+	addedAt := time.Date(2024, 3, 18, 0, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(regexp.QuoteMeta(`COALESCE(f.release_date, '0001-01-01'), COALESCE(f.rating, -1), f.rating_count`)).
+		WithArgs(uint64(2), 2).
+		WillReturnRows(pgxmock.NewRows([]string{"position", "added_at", "film_id", "title", "description", "release_date", "rating", "rating_count", "rating_sum"}).
+			AddRow(1, addedAt, uint64(7), "Heat", "", time.Time{}, -1, int64(0), int64(0)))
+
+	entries, err := repo.GetWatchlist(context.Background(), model.ListFilter{UserID: 2, Params: pagination.Params{Limit: 1}})
+	assert.NoError(t, err)
+	assert.Equal(t, []model.WatchlistEntry{{
+		Position: 1,
+		AddedAt:  addedAt,
+		Film:     model.Film{ID: 7, Title: "Heat", Rating: -1},
+	}}, entries)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"context"
+	"strconv"
+
+	"films_library/internal/model"
+	"films_library/internal/watchlist"
+	"films_library/pkg/logger"
+	"films_library/pkg/pagination"
+)
+
+type WatchlistUsecase struct {
+	watchlistRepo watchlist.Repository
+	prior         model.ScorePrior
+	logger        logger.Interface
+}
+
+func NewWatchlistUsecase(wr watchlist.Repository, prior model.ScorePrior, l logger.Interface) *WatchlistUsecase {
+	return &WatchlistUsecase{wr, prior, l}
+}
+
+func (wu *WatchlistUsecase) GetWatchlist(ctx context.Context, filter model.ListFilter) ([]model.WatchlistEntry, pagination.Page, error) {
+	entries, err := wu.watchlistRepo.GetWatchlist(ctx, filter)
+	if err != nil {
+		return []model.WatchlistEntry{}, pagination.Page{}, err
+	}
+
+	entries, page := pagination.Paginate(entries, filter.Params, func(entry model.WatchlistEntry) pagination.Cursor {
+		return pagination.Cursor{Value: strconv.Itoa(entry.Position), ID: entry.Film.ID}
+	})
+	for i := range entries {
+		wu.score(&entries[i].Film)
+	}
+	return entries, page, nil
+}
+
+func (wu *WatchlistUsecase) AddToWatchlist(ctx context.Context, userID, filmID uint64) error {
+	return wu.watchlistRepo.AddToWatchlist(ctx, userID, filmID)
+}
+
+func (wu *WatchlistUsecase) RemoveFromWatchlist(ctx context.Context, userID, filmID uint64) error {
+	return wu.watchlistRepo.RemoveFromWatchlist(ctx, userID, filmID)
+}
+
+func (wu *WatchlistUsecase) ReorderWatchlist(ctx context.Context, userID uint64, order model.FilmOrder) error {
+	return wu.watchlistRepo.ReorderWatchlist(ctx, userID, order.FilmIDs)
+}
+
+func (wu *WatchlistUsecase) GetHistory(ctx context.Context, filter model.ListFilter) ([]model.HistoryEntry, pagination.Page, error) {
+	entries, err := wu.watchlistRepo.GetHistory(ctx, filter)
+	if err != nil {
+		return []model.HistoryEntry{}, pagination.Page{}, err
+	}
+
+	entries, page := pagination.Paginate(entries, filter.Params, func(entry model.HistoryEntry) pagination.Cursor {
+		return pagination.Cursor{Value: entry.WatchedOn, ID: entry.ID}
+	})
+	for i := range entries {
+		wu.score(&entries[i].Film)
+	}
+	return entries, page, nil
+}
+
+func (wu *WatchlistUsecase) AddHistory(ctx context.Context, userID uint64, entry model.AddHistoryRequest) (uint64, error) {
+	return wu.watchlistRepo.AddHistory(ctx, userID, entry)
+}
+
+func (wu *WatchlistUsecase) DeleteHistory(ctx context.Context, userID, entryID uint64) error {
+	return wu.watchlistRepo.DeleteHistory(ctx, userID, entryID)
+}
+
+func (wu *WatchlistUsecase) score(film *model.Film) {
+	film.CommunityScore = wu.prior.Score(film.RatingSum, film.RatingCount)
+}
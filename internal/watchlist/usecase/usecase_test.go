@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"films_library/internal/model"
+	mock_watchlist "films_library/internal/watchlist/mocks"
+	"films_library/pkg/logger"
+	"films_library/pkg/pagination"
+
+	"github.com/golang/mock/gomock"
+)
+
+func TestWatchlistUsecase_GetWatchlist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	loggerMock := logger.NewMockInterface(ctrl)
+	watchlistRepo := mock_watchlist.NewMockRepository(ctrl)
+	usecase := NewWatchlistUsecase(watchlistRepo, model.ScorePrior{Mean: 6, Weight: 10}, loggerMock)
+
+	ctx := context.Background()
+
+	entries := []model.WatchlistEntry{
+		{Position: 1, Film: model.Film{ID: 4, Title: "Heat"}},
+		{Position: 2, Film: model.Film{ID: 9, Title: "Ronin", RatingCount: 10, RatingSum: 80}},
+	}
+
+	testCases := []struct {
+		name            string
+		filter          model.ListFilter
+		repoEntries     []model.WatchlistEntry
+		repoError       error
+		expectedEntries []model.WatchlistEntry
+		expectedPage    pagination.Page
+		expectedError   error
+	}{
+		{
+			name:        "Single page",
+			filter:      model.ListFilter{UserID: 3, Params: pagination.Params{Limit: 5}},
+			repoEntries: entries,
+			expectedEntries: []model.WatchlistEntry{
+				{Position: 1, Film: model.Film{ID: 4, Title: "Heat", CommunityScore: 6}},
+				{Position: 2, Film: model.Film{ID: 9, Title: "Ronin", CommunityScore: 7, RatingCount: 10, RatingSum: 80}},
+			},
+		},
+		{
+			name:        "More entries follow",
+			filter:      model.ListFilter{UserID: 3, Params: pagination.Params{Limit: 1}},
+			repoEntries: entries,
+			expectedEntries: []model.WatchlistEntry{
+				{Position: 1, Film: model.Film{ID: 4, Title: "Heat", CommunityScore: 6}},
+			},
+			expectedPage: pagination.Page{Next: pagination.Encode(pagination.Cursor{Value: "1", ID: 4})},
+		},
+		{
+			name:            "Error from repository",
+			filter:          model.ListFilter{UserID: 3},
+			repoError:       errors.New("repository error"),
+			expectedEntries: []model.WatchlistEntry{},
+			expectedError:   errors.New("repository error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repoEntries := append([]model.WatchlistEntry(nil), tc.repoEntries...)
+			watchlistRepo.EXPECT().GetWatchlist(ctx, tc.filter).Return(repoEntries, tc.repoError)
+
+			entries, page, err := usecase.GetWatchlist(ctx, tc.filter)
+			if (err == nil) != (tc.expectedError == nil) || err != nil && err.Error() != tc.expectedError.Error() {
+				t.Errorf("Unexpected error: expected %v, got %v", tc.expectedError, err)
+			}
+
+			if !reflect.DeepEqual(entries, tc.expectedEntries) {
+				t.Errorf("Expected entries %v, got %v", tc.expectedEntries, entries)
+			}
+
+			if !reflect.DeepEqual(page, tc.expectedPage) {
+				t.Errorf("Expected page %v, got %v", tc.expectedPage, page)
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package watchlist
+
+import (
+	"context"
+
+	"films_library/internal/model"
+	"films_library/pkg/pagination"
+)
+
+type (
+	Usecase interface {
+		GetWatchlist(ctx context.Context, filter model.ListFilter) ([]model.WatchlistEntry, pagination.Page, error)
+		AddToWatchlist(ctx context.Context, userID, filmID uint64) error
+		RemoveFromWatchlist(ctx context.Context, userID, filmID uint64) error
+		ReorderWatchlist(ctx context.Context, userID uint64, order model.FilmOrder) error
+
+		GetHistory(ctx context.Context, filter model.ListFilter) ([]model.HistoryEntry, pagination.Page, error)
+		AddHistory(ctx context.Context, userID uint64, entry model.AddHistoryRequest) (uint64, error)
+		DeleteHistory(ctx context.Context, userID, entryID uint64) error
+	}
+
+	Repository interface {
+		GetWatchlist(ctx context.Context, filter model.ListFilter) ([]model.WatchlistEntry, error)
+		AddToWatchlist(ctx context.Context, userID, filmID uint64) error
+		RemoveFromWatchlist(ctx context.Context, userID, filmID uint64) error
+		ReorderWatchlist(ctx context.Context, userID uint64, filmIDs []uint64) error
+
+		GetHistory(ctx context.Context, filter model.ListFilter) ([]model.HistoryEntry, error)
+		AddHistory(ctx context.Context, userID uint64, entry model.AddHistoryRequest) (uint64, error)
+		DeleteHistory(ctx context.Context, userID, entryID uint64) error
+	}
+)
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS film_actor;
+DROP TABLE IF EXISTS actor;
+DROP TABLE IF EXISTS film;
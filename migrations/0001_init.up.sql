@@ -13,7 +13,7 @@ CREATE TABLE IF NOT EXISTS actor (
     birth_date  DATE
 );
 
-CREATE TABLE film_actor (
+CREATE TABLE IF NOT EXISTS film_actor (
     film_id     BIGINT REFERENCES film(film_id)   ON DELETE CASCADE,
     actor_id    BIGINT REFERENCES actor(actor_id) ON DELETE CASCADE,
     PRIMARY KEY (film_id, actor_id)
@@ -0,0 +1,15 @@
+DROP INDEX IF EXISTS actor_name_trgm_idx;
+DROP INDEX IF EXISTS actor_search_vector_idx;
+DROP INDEX IF EXISTS film_title_trgm_idx;
+DROP INDEX IF EXISTS film_search_vector_idx;
+
+DROP TRIGGER IF EXISTS actor_search_vector ON actor;
+DROP TRIGGER IF EXISTS film_actor_search_vector ON film_actor;
+DROP TRIGGER IF EXISTS film_search_vector ON film;
+
+DROP FUNCTION IF EXISTS actor_search_vector_update();
+DROP FUNCTION IF EXISTS film_actor_search_vector_update();
+DROP FUNCTION IF EXISTS film_search_vector_update();
+
+ALTER TABLE actor DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE film DROP COLUMN IF EXISTS search_vector;
@@ -0,0 +1,64 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- Film documents combine the title, the description and the names of the cast.
+ALTER TABLE film ADD COLUMN IF NOT EXISTS search_vector tsvector;
+
+CREATE OR REPLACE FUNCTION film_search_vector_update() RETURNS trigger AS $$
+BEGIN
+    NEW.search_vector :=
+        setweight(to_tsvector('english', coalesce(NEW.title, '')), 'A') ||
+        setweight(to_tsvector('english', coalesce(NEW."description", '')), 'B') ||
+        setweight(to_tsvector('simple', coalesce((
+            SELECT string_agg(a."name", ' ')
+            FROM film_actor fa
+            JOIN actor a ON a.actor_id = fa.actor_id
+            WHERE fa.film_id = NEW.film_id
+        ), '')), 'C');
+    RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS film_search_vector ON film;
+CREATE TRIGGER film_search_vector
+    BEFORE INSERT OR UPDATE OF title, "description" ON film
+    FOR EACH ROW EXECUTE FUNCTION film_search_vector_update();
+
+-- Touching the title re-runs film_search_vector_update when the cast changes.
+CREATE OR REPLACE FUNCTION film_actor_search_vector_update() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        UPDATE film SET title = title WHERE film_id = OLD.film_id;
+        RETURN OLD;
+    END IF;
+    UPDATE film SET title = title WHERE film_id = NEW.film_id;
+    RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS film_actor_search_vector ON film_actor;
+CREATE TRIGGER film_actor_search_vector
+    AFTER INSERT OR DELETE ON film_actor
+    FOR EACH ROW EXECUTE FUNCTION film_actor_search_vector_update();
+
+CREATE OR REPLACE FUNCTION actor_search_vector_update() RETURNS trigger AS $$
+BEGIN
+    UPDATE film SET title = title
+    WHERE film_id IN (SELECT film_id FROM film_actor WHERE actor_id = NEW.actor_id);
+    RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS actor_search_vector ON actor;
+CREATE TRIGGER actor_search_vector
+    AFTER UPDATE OF "name" ON actor
+    FOR EACH ROW EXECUTE FUNCTION actor_search_vector_update();
+
+ALTER TABLE actor ADD COLUMN IF NOT EXISTS search_vector tsvector
+    GENERATED ALWAYS AS (to_tsvector('simple', coalesce("name", ''))) STORED;
+
+UPDATE film SET title = title;
+
+CREATE INDEX IF NOT EXISTS film_search_vector_idx ON film USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS film_title_trgm_idx    ON film USING GIN (title gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS actor_search_vector_idx ON actor USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS actor_name_trgm_idx    ON actor USING GIN ("name" gin_trgm_ops);
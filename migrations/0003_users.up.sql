@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS users (
+    user_id         BIGSERIAL   PRIMARY KEY,
+    username        TEXT        CHECK(length(username) <= 64) NOT NULL UNIQUE,
+    password_hash   TEXT        NOT NULL,
+    "role"          TEXT        CHECK("role" IN ('admin', 'user')) NOT NULL DEFAULT 'user',
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- session_id is the SHA-256 of the cookie token, so a leaked table does not
+-- leak usable sessions.
+CREATE TABLE IF NOT EXISTS session (
+    session_id  TEXT        PRIMARY KEY,
+    user_id     BIGINT      NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+    expires_at  TIMESTAMPTZ NOT NULL,
+    revoked_at  TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS session_user_id_idx ON session (user_id);
@@ -0,0 +1,15 @@
+-- key_hash is the SHA-256 of the key; the key itself is shown once on creation.
+CREATE TABLE IF NOT EXISTS api_key (
+    key_id          BIGSERIAL   PRIMARY KEY,
+    user_id         BIGINT      NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    "name"          TEXT        CHECK(length("name") <= 100) NOT NULL,
+    prefix          TEXT        NOT NULL,
+    key_hash        TEXT        NOT NULL UNIQUE,
+    scopes          TEXT[]      NOT NULL,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT now(),
+    expires_at      TIMESTAMPTZ,
+    last_used_at    TIMESTAMPTZ,
+    revoked_at      TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS api_key_user_id_idx ON api_key (user_id);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS film_crew;
+DROP TABLE IF EXISTS person;
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS person (
+    person_id   BIGSERIAL   PRIMARY KEY,
+    "name"      TEXT        CHECK(length("name") <= 100) NOT NULL,
+    birth_date  DATE
+);
+
+CREATE TABLE IF NOT EXISTS film_crew (
+    film_id     BIGINT  REFERENCES film(film_id)     ON DELETE CASCADE,
+    person_id   BIGINT  REFERENCES person(person_id) ON DELETE CASCADE,
+    "role"      TEXT    CHECK("role" IN ('director', 'writer', 'composer', 'producer', 'cinematographer')),
+    PRIMARY KEY (film_id, person_id, "role")
+);
+
+CREATE INDEX IF NOT EXISTS film_crew_person_id_idx ON film_crew (person_id);
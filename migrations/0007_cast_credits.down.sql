@@ -0,0 +1,6 @@
+ALTER TABLE film_actor
+    DROP COLUMN IF EXISTS characters,
+    DROP COLUMN IF EXISTS billing,
+    DROP COLUMN IF EXISTS uncredited,
+    DROP COLUMN IF EXISTS voice,
+    DROP COLUMN IF EXISTS cameo;
@@ -0,0 +1,6 @@
+ALTER TABLE film_actor
+    ADD COLUMN IF NOT EXISTS characters TEXT[]  NOT NULL DEFAULT '{}',
+    ADD COLUMN IF NOT EXISTS billing    INT     CHECK(billing > 0),
+    ADD COLUMN IF NOT EXISTS uncredited BOOLEAN NOT NULL DEFAULT false,
+    ADD COLUMN IF NOT EXISTS voice      BOOLEAN NOT NULL DEFAULT false,
+    ADD COLUMN IF NOT EXISTS cameo      BOOLEAN NOT NULL DEFAULT false;
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS film_tag;
+DROP TABLE IF EXISTS film_genre;
+DROP TABLE IF EXISTS tag;
+DROP TABLE IF EXISTS genre;
@@ -0,0 +1,29 @@
+CREATE TABLE IF NOT EXISTS genre (
+    genre_id    BIGSERIAL   PRIMARY KEY,
+    "name"      TEXT        CHECK(length("name") <= 50) NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS genre_name_key ON genre (lower("name"));
+
+CREATE TABLE IF NOT EXISTS tag (
+    tag_id      BIGSERIAL   PRIMARY KEY,
+    "name"      TEXT        CHECK(length("name") <= 50) NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS tag_name_key ON tag (lower("name"));
+
+CREATE TABLE IF NOT EXISTS film_genre (
+    film_id     BIGINT  REFERENCES film(film_id)   ON DELETE CASCADE,
+    genre_id    BIGINT  REFERENCES genre(genre_id) ON DELETE CASCADE,
+    PRIMARY KEY (film_id, genre_id)
+);
+
+CREATE INDEX IF NOT EXISTS film_genre_genre_id_idx ON film_genre (genre_id);
+
+CREATE TABLE IF NOT EXISTS film_tag (
+    film_id     BIGINT  REFERENCES film(film_id) ON DELETE CASCADE,
+    tag_id      BIGINT  REFERENCES tag(tag_id)   ON DELETE CASCADE,
+    PRIMARY KEY (film_id, tag_id)
+);
+
+CREATE INDEX IF NOT EXISTS film_tag_tag_id_idx ON film_tag (tag_id);
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS review;
+
+ALTER TABLE film
+    DROP COLUMN IF EXISTS rating_sum,
+    DROP COLUMN IF EXISTS rating_count;
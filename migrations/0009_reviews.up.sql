@@ -0,0 +1,21 @@
+-- film.rating stays the editorial rating; rating_count and rating_sum
+-- aggregate the user ratings that count towards the community score and are
+-- kept in step with review by the application, in the same transaction.
+ALTER TABLE film
+    ADD COLUMN IF NOT EXISTS rating_count BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS rating_sum   BIGINT NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS review (
+    review_id   BIGSERIAL   PRIMARY KEY,
+    film_id     BIGINT      NOT NULL REFERENCES film(film_id)  ON DELETE CASCADE,
+    user_id     BIGINT      NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    score       INT         NOT NULL CHECK(score >= 1 AND score <= 10),
+    body        TEXT        NOT NULL DEFAULT '' CHECK(length(body) <= 5000),
+    status      TEXT        NOT NULL DEFAULT 'pending' CHECK(status IN ('pending', 'approved', 'rejected')),
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE (film_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS review_user_id_idx ON review (user_id);
+CREATE INDEX IF NOT EXISTS review_status_idx ON review (status, review_id);
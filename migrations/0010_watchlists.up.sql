@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS watchlist (
+    user_id     BIGINT      REFERENCES users(user_id) ON DELETE CASCADE,
+    film_id     BIGINT      REFERENCES film(film_id)  ON DELETE CASCADE,
+    "position"  INT         NOT NULL,
+    added_at    TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_id, film_id)
+);
+
+CREATE INDEX IF NOT EXISTS watchlist_user_position_idx ON watchlist (user_id, "position", film_id);
+
+CREATE TABLE IF NOT EXISTS watch_history (
+    entry_id        BIGSERIAL   PRIMARY KEY,
+    user_id         BIGINT      NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    film_id         BIGINT      NOT NULL REFERENCES film(film_id)  ON DELETE CASCADE,
+    watched_on      DATE        NOT NULL,
+    rewatch_count   INT         NOT NULL DEFAULT 0 CHECK(rewatch_count >= 0),
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS watch_history_user_idx ON watch_history (user_id, watched_on DESC, entry_id DESC);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS collection_film;
+DROP TABLE IF EXISTS collection;
@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS collection (
+    collection_id   BIGSERIAL    PRIMARY KEY,
+    title           VARCHAR(150) NOT NULL CHECK(length(title) >= 1),
+    "description"   VARCHAR(1000) NOT NULL DEFAULT '',
+    owner_id        BIGINT       NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    visibility      VARCHAR(10)  NOT NULL DEFAULT 'private' CHECK(visibility IN ('public', 'private')),
+    created_at      TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    updated_at      TIMESTAMPTZ  NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS collection_owner_idx ON collection (owner_id);
+CREATE INDEX IF NOT EXISTS collection_visibility_idx ON collection (visibility);
+
+CREATE TABLE IF NOT EXISTS collection_film (
+    collection_id   BIGINT REFERENCES collection(collection_id) ON DELETE CASCADE,
+    film_id         BIGINT REFERENCES film(film_id) ON DELETE CASCADE,
+    "position"      INT    NOT NULL,
+    PRIMARY KEY (collection_id, film_id)
+);
+
+CREATE INDEX IF NOT EXISTS collection_film_position_idx ON collection_film (collection_id, "position", film_id);
+CREATE INDEX IF NOT EXISTS collection_film_film_idx ON collection_film (film_id);
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS copy (
+    copy_id         BIGSERIAL   PRIMARY KEY,
+    film_id         BIGINT      NOT NULL REFERENCES film(film_id) ON DELETE CASCADE,
+    format          VARCHAR(10) NOT NULL CHECK(format IN ('dvd', 'bluray', '4k', 'vhs', 'digital')),
+    barcode         VARCHAR(32),
+    "condition"     VARCHAR(10) NOT NULL DEFAULT 'good' CHECK("condition" IN ('new', 'good', 'fair', 'poor', 'damaged')),
+    acquired_on     DATE,
+    shelf           VARCHAR(50) NOT NULL DEFAULT '',
+    status          VARCHAR(10) NOT NULL DEFAULT 'active' CHECK(status IN ('active', 'withdrawn', 'lost')),
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS copy_barcode_idx ON copy (barcode) WHERE barcode IS NOT NULL;
+CREATE INDEX IF NOT EXISTS copy_film_idx ON copy (film_id, status);
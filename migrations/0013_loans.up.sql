@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS loan (
+    loan_id         BIGSERIAL   PRIMARY KEY,
+    copy_id         BIGINT      NOT NULL REFERENCES copy(copy_id) ON DELETE RESTRICT,
+    borrower_id     BIGINT      NOT NULL REFERENCES users(user_id) ON DELETE RESTRICT,
+    checked_out_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+    due_on          DATE        NOT NULL,
+    renewals        INT         NOT NULL DEFAULT 0 CHECK(renewals >= 0),
+    returned_at     TIMESTAMPTZ,
+    overdue         BOOLEAN     NOT NULL DEFAULT false
+);
+
+-- A copy is lent to one borrower at a time.
+CREATE UNIQUE INDEX IF NOT EXISTS loan_active_copy_idx ON loan (copy_id) WHERE returned_at IS NULL;
+CREATE INDEX IF NOT EXISTS loan_borrower_idx ON loan (borrower_id, loan_id DESC);
+CREATE INDEX IF NOT EXISTS loan_due_idx ON loan (due_on) WHERE returned_at IS NULL;
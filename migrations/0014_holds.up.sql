@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS hold (
+    hold_id     BIGSERIAL   PRIMARY KEY,
+    film_id     BIGINT      NOT NULL REFERENCES film(film_id)  ON DELETE CASCADE,
+    user_id     BIGINT      NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    status      VARCHAR(10) NOT NULL DEFAULT 'waiting'
+                CHECK(status IN ('waiting', 'ready', 'collected', 'expired', 'cancelled')),
+    copy_id     BIGINT      REFERENCES copy(copy_id) ON DELETE SET NULL,
+    placed_at   TIMESTAMPTZ NOT NULL DEFAULT now(),
+    ready_at    TIMESTAMPTZ,
+    expires_at  TIMESTAMPTZ,
+    closed_at   TIMESTAMPTZ
+);
+
+-- Queues are served in hold_id order.
+CREATE INDEX IF NOT EXISTS hold_queue_idx ON hold (film_id, hold_id) WHERE status = 'waiting';
+CREATE INDEX IF NOT EXISTS hold_user_idx ON hold (user_id, hold_id DESC);
+CREATE INDEX IF NOT EXISTS hold_expiry_idx ON hold (expires_at) WHERE status = 'ready';
+-- A user holds a film once at a time, and a copy is set aside for one hold.
+CREATE UNIQUE INDEX IF NOT EXISTS hold_open_idx ON hold (film_id, user_id) WHERE status IN ('waiting', 'ready');
+CREATE UNIQUE INDEX IF NOT EXISTS hold_copy_idx ON hold (copy_id) WHERE status = 'ready';
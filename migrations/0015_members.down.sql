@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS ledger_line;
+DROP FUNCTION IF EXISTS ledger_entry_balanced();
+DROP TABLE IF EXISTS ledger_entry;
+DROP TABLE IF EXISTS member;
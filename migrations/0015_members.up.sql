@@ -0,0 +1,57 @@
+CREATE TABLE IF NOT EXISTS member (
+    user_id     BIGINT       PRIMARY KEY REFERENCES users(user_id) ON DELETE CASCADE,
+    tier        VARCHAR(16)  NOT NULL DEFAULT 'standard' CHECK(tier IN ('standard', 'premium', 'student')),
+    expires_on  DATE         NOT NULL,
+    status      VARCHAR(16)  NOT NULL DEFAULT 'active' CHECK(status IN ('active', 'suspended')),
+    -- Why a suspended member is suspended: members suspended for debt are
+    -- reinstated automatically once they pay it down.
+    suspension  VARCHAR(16)  CHECK(suspension IN ('debt', 'manual')),
+    email       VARCHAR(254) NOT NULL DEFAULT '',
+    phone       VARCHAR(32)  NOT NULL DEFAULT '',
+    address     VARCHAR(500) NOT NULL DEFAULT '',
+    created_at  TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    updated_at  TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    CHECK((status = 'suspended') = (suspension IS NOT NULL))
+);
+
+-- A ledger entry is one charge, payment or waiver of a member. Its lines
+-- post the amount, in minor currency units, to two accounts: debits are
+-- positive, credits negative. The receivable account holds what members owe.
+CREATE TABLE IF NOT EXISTS ledger_entry (
+    entry_id    BIGSERIAL    PRIMARY KEY,
+    member_id   BIGINT       NOT NULL REFERENCES member(user_id) ON DELETE RESTRICT,
+    kind        VARCHAR(16)  NOT NULL CHECK(kind IN ('charge', 'payment', 'waiver')),
+    category    VARCHAR(16)  CHECK(category IN ('membership', 'overdue', 'replacement', 'other')),
+    amount      BIGINT       NOT NULL CHECK(amount > 0),
+    loan_id     BIGINT       REFERENCES loan(loan_id) ON DELETE RESTRICT,
+    memo        VARCHAR(500) NOT NULL DEFAULT '',
+    posted_by   BIGINT       REFERENCES users(user_id) ON DELETE SET NULL,
+    posted_at   TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    CHECK((kind = 'charge') = (category IS NOT NULL))
+);
+
+CREATE INDEX IF NOT EXISTS ledger_entry_member_idx ON ledger_entry (member_id, entry_id);
+-- A loan is fined for being overdue once.
+CREATE UNIQUE INDEX IF NOT EXISTS ledger_entry_overdue_idx ON ledger_entry (loan_id) WHERE category = 'overdue';
+
+CREATE TABLE IF NOT EXISTS ledger_line (
+    entry_id    BIGINT       NOT NULL REFERENCES ledger_entry(entry_id) ON DELETE CASCADE,
+    account     VARCHAR(16)  NOT NULL CHECK(account IN ('receivable', 'cash', 'fee_income', 'fine_income', 'waivers')),
+    amount      BIGINT       NOT NULL CHECK(amount <> 0),
+    PRIMARY KEY (entry_id, account)
+);
+
+-- The lines of every entry balance by the end of the transaction posting it.
+CREATE OR REPLACE FUNCTION ledger_entry_balanced() RETURNS trigger AS $$
+BEGIN
+    IF (SELECT sum(amount) FROM ledger_line WHERE entry_id = NEW.entry_id) <> 0 THEN
+        RAISE EXCEPTION 'ledger entry % does not balance', NEW.entry_id;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE CONSTRAINT TRIGGER ledger_line_balanced
+    AFTER INSERT OR UPDATE ON ledger_line
+    DEFERRABLE INITIALLY DEFERRED
+    FOR EACH ROW EXECUTE FUNCTION ledger_entry_balanced();
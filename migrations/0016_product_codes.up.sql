@@ -0,0 +1,10 @@
+-- Product codes printed on the releases of a film, stored as EAN-13 with
+-- UPC-A codes zero-padded.
+CREATE TABLE IF NOT EXISTS product_code (
+    code        CHAR(13)    PRIMARY KEY CHECK(code ~ '^[0-9]{13}$'),
+    film_id     BIGINT      NOT NULL REFERENCES film(film_id) ON DELETE CASCADE,
+    format      VARCHAR(16) CHECK(format IN ('dvd', 'bluray', '4k', 'vhs', 'digital')),
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS product_code_film_idx ON product_code (film_id);
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS imdb_import;
+
+ALTER TABLE actor DROP COLUMN IF EXISTS imdb_id;
+
+ALTER TABLE film DROP COLUMN IF EXISTS imdb_id;
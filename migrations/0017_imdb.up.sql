@@ -0,0 +1,17 @@
+-- IMDb identifiers of films and actors imported from the IMDb datasets.
+ALTER TABLE film
+    ADD COLUMN IF NOT EXISTS imdb_id TEXT UNIQUE CHECK(imdb_id ~ '^tt[0-9]{7,}$');
+
+ALTER TABLE actor
+    ADD COLUMN IF NOT EXISTS imdb_id TEXT UNIQUE CHECK(imdb_id ~ '^nm[0-9]{7,}$');
+
+-- How far each IMDb dataset has been imported. An import resumes after
+-- lines_done unless the file or the filters changed since.
+CREATE TABLE IF NOT EXISTS imdb_import (
+    dataset     TEXT        PRIMARY KEY,
+    source      TEXT        NOT NULL,
+    filters     TEXT        NOT NULL,
+    lines_done  BIGINT      NOT NULL DEFAULT 0,
+    done        BOOLEAN     NOT NULL DEFAULT false,
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+);
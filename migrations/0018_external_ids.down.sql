@@ -0,0 +1,17 @@
+ALTER TABLE film
+    ADD COLUMN IF NOT EXISTS imdb_id TEXT UNIQUE CHECK(imdb_id ~ '^tt[0-9]{7,}$');
+
+ALTER TABLE actor
+    ADD COLUMN IF NOT EXISTS imdb_id TEXT UNIQUE CHECK(imdb_id ~ '^nm[0-9]{7,}$');
+
+UPDATE film f SET imdb_id = x.external_id
+FROM film_external_id x
+WHERE x.film_id = f.film_id AND x.provider = 'imdb';
+
+UPDATE actor a SET imdb_id = x.external_id
+FROM actor_external_id x
+WHERE x.actor_id = a.actor_id AND x.provider = 'imdb';
+
+DROP TABLE IF EXISTS actor_external_id;
+
+DROP TABLE IF EXISTS film_external_id;
@@ -0,0 +1,42 @@
+-- IDs of films and actors in other catalogues, one per provider. An ID
+-- belongs to one film or actor only.
+CREATE TABLE IF NOT EXISTS film_external_id (
+    film_id     BIGINT      NOT NULL REFERENCES film(film_id) ON DELETE CASCADE,
+    provider    VARCHAR(16) NOT NULL CHECK(provider IN ('imdb', 'tmdb', 'wikidata')),
+    external_id TEXT        NOT NULL,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (film_id, provider),
+    UNIQUE (provider, external_id),
+    CHECK(CASE provider
+        WHEN 'imdb' THEN external_id ~ '^tt[0-9]{7,}$'
+        WHEN 'tmdb' THEN external_id ~ '^[1-9][0-9]{0,9}$'
+        WHEN 'wikidata' THEN external_id ~ '^Q[1-9][0-9]*$'
+    END)
+);
+
+CREATE TABLE IF NOT EXISTS actor_external_id (
+    actor_id    BIGINT      NOT NULL REFERENCES actor(actor_id) ON DELETE CASCADE,
+    provider    VARCHAR(16) NOT NULL CHECK(provider IN ('imdb', 'tmdb', 'wikidata')),
+    external_id TEXT        NOT NULL,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (actor_id, provider),
+    UNIQUE (provider, external_id),
+    CHECK(CASE provider
+        WHEN 'imdb' THEN external_id ~ '^nm[0-9]{7,}$'
+        WHEN 'tmdb' THEN external_id ~ '^[1-9][0-9]{0,9}$'
+        WHEN 'wikidata' THEN external_id ~ '^Q[1-9][0-9]*$'
+    END)
+);
+
+-- The IMDb IDs of imported films and actors move to the new tables.
+INSERT INTO film_external_id (film_id, provider, external_id)
+SELECT film_id, 'imdb', imdb_id FROM film WHERE imdb_id IS NOT NULL
+ON CONFLICT DO NOTHING;
+
+INSERT INTO actor_external_id (actor_id, provider, external_id)
+SELECT actor_id, 'imdb', imdb_id FROM actor WHERE imdb_id IS NOT NULL
+ON CONFLICT DO NOTHING;
+
+ALTER TABLE film DROP COLUMN IF EXISTS imdb_id;
+
+ALTER TABLE actor DROP COLUMN IF EXISTS imdb_id;
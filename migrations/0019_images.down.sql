@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS actor_headshot;
+
+DROP TABLE IF EXISTS film_poster;
@@ -0,0 +1,21 @@
+-- Film posters and actor headshots. The files are kept in the blob store
+-- under the ETag, which changes whenever the image is replaced.
+CREATE TABLE IF NOT EXISTS film_poster (
+    film_id      BIGINT      PRIMARY KEY REFERENCES film(film_id) ON DELETE CASCADE,
+    content_type VARCHAR(32) NOT NULL,
+    width        INTEGER     NOT NULL CHECK(width > 0),
+    height       INTEGER     NOT NULL CHECK(height > 0),
+    size         BIGINT      NOT NULL CHECK(size > 0),
+    etag         VARCHAR(64) NOT NULL,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS actor_headshot (
+    actor_id     BIGINT      PRIMARY KEY REFERENCES actor(actor_id) ON DELETE CASCADE,
+    content_type VARCHAR(32) NOT NULL,
+    width        INTEGER     NOT NULL CHECK(width > 0),
+    height       INTEGER     NOT NULL CHECK(height > 0),
+    size         BIGINT      NOT NULL CHECK(size > 0),
+    etag         VARCHAR(64) NOT NULL,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT now()
+);
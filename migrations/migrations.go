@@ -0,0 +1,8 @@
+// Package migrations embeds the numbered SQL migrations of the film library
+// schema. Files are named VERSION_NAME.up.sql and VERSION_NAME.down.sql.
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS
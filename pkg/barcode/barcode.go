@@ -0,0 +1,51 @@
+// Package barcode checks EAN-13 and UPC-A product codes as printed on disc
+// packaging and normalises them to EAN-13.
+package barcode
+
+import "strings"
+
+// Normalize drops the spaces and hyphens codes are often printed or typed with.
+func Normalize(code string) string {
+	return strings.NewReplacer(" ", "", "-", "").Replace(code)
+}
+
+// IsGTIN reports whether code is shaped like an EAN-13 or UPC-A code: 13 or
+// 12 digits. It does not check the check digit.
+func IsGTIN(code string) bool {
+	if len(code) != 12 && len(code) != 13 {
+		return false
+	}
+	for _, r := range code {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// EAN13 returns a valid EAN-13 or UPC-A code as EAN-13, UPC-A codes gaining
+// a leading zero. It reports false for anything else, including codes with a
+// wrong check digit.
+func EAN13(code string) (string, bool) {
+	if !IsGTIN(code) {
+		return "", false
+	}
+	if len(code) == 12 {
+		code = "0" + code
+	}
+
+	// Digits are weighted 1 and 3 alternately from the left; the check
+	// digit rounds their sum up to a multiple of ten.
+	sum := 0
+	for i, r := range code[:12] {
+		d := int(r - '0')
+		if i%2 == 1 {
+			d *= 3
+		}
+		sum += d
+	}
+	if (10-sum%10)%10 != int(code[12]-'0') {
+		return "", false
+	}
+	return code, true
+}
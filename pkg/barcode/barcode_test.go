@@ -0,0 +1,34 @@
+package barcode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEAN13(t *testing.T) {
+	tests := []struct {
+		name     string
+		code     string
+		expected string
+		valid    bool
+	}{
+		{name: "EAN-13", code: "5051892002165", expected: "5051892002165", valid: true},
+		{name: "UPC-A gains a leading zero", code: "085391163725", expected: "0085391163725", valid: true},
+		{name: "Wrong check digit", code: "5051892002166"},
+		{name: "Too short", code: "50518920021"},
+		{name: "Not digits", code: "50518920O2165"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			code, valid := EAN13(test.code)
+			assert.Equal(t, test.valid, valid)
+			assert.Equal(t, test.expected, code)
+		})
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	assert.Equal(t, "5051892002165", Normalize("5 051892-002165"))
+}
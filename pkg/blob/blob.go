@@ -0,0 +1,18 @@
+// Package blob keeps files by key behind the Store interface, so that they
+// can live on the local filesystem or in an object store.
+package blob
+
+import (
+	"context"
+	"io"
+)
+
+// Store keeps blobs under slash-separated keys such as films/7/poster.
+type Store interface {
+	// Put stores the contents of r under key, replacing any blob there.
+	Put(ctx context.Context, key string, r io.Reader) error
+	// Open returns the blob under key, or an error wrapping fs.ErrNotExist.
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete removes the blob under key. Missing blobs are not an error.
+	Delete(ctx context.Context, key string) error
+}
@@ -0,0 +1,51 @@
+package blob
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocal(t *testing.T) {
+	root := t.TempDir()
+	store, err := NewLocal(root)
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	assert.NoError(t, store.Put(ctx, "films/7/poster", strings.NewReader("first")))
+	assert.NoError(t, store.Put(ctx, "films/7/poster", strings.NewReader("second")))
+
+	r, err := store.Open(ctx, "films/7/poster")
+	assert.NoError(t, err)
+	data, _ := io.ReadAll(r)
+	r.Close()
+	assert.Equal(t, "second", string(data))
+
+	assert.NoError(t, store.Delete(ctx, "films/7/poster"))
+	assert.NoError(t, store.Delete(ctx, "films/7/poster"))
+	_, err = store.Open(ctx, "films/7/poster")
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+
+	entries, err := os.ReadDir(root)
+	assert.NoError(t, err)
+	assert.Empty(t, entries, "empty directories are removed")
+}
+
+func TestLocalRejectsKeysOutsideRoot(t *testing.T) {
+	root := t.TempDir()
+	store, err := NewLocal(filepath.Join(root, "blobs"))
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	for _, key := range []string{"../escape", "/etc/passwd", "films/../../escape", "", "."} {
+		assert.Error(t, store.Put(ctx, key, strings.NewReader("x")), key)
+	}
+	_, err = os.Stat(filepath.Join(root, "escape"))
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+}
@@ -0,0 +1,122 @@
+// Package export writes rows to a stream one at a time as CSV, NDJSON or a
+// JSON array, so that exports take the same memory however many rows they
+// hold.
+package export
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+
+	"github.com/mailru/easyjson"
+)
+
+const (
+	CSV    = "csv"
+	NDJSON = "ndjson"
+	JSON   = "json"
+)
+
+// Row is a row written in any format: as JSON, or as a CSV record in the
+// order of the header.
+type Row interface {
+	easyjson.Marshaler
+	Record() []string
+}
+
+// ContentType returns the media type of format, or "" if it is unknown.
+func ContentType(format string) string {
+	switch format {
+	case CSV:
+		return "text/csv; charset=utf-8"
+	case NDJSON:
+		return "application/x-ndjson"
+	case JSON:
+		return "application/json; charset=utf-8"
+	}
+	return ""
+}
+
+// Writer writes rows in one format. Nothing is written until the first row
+// or Close, so a caller can still report an error before that.
+type Writer struct {
+	w       io.Writer
+	format  string
+	header  []string
+	csv     *csv.Writer
+	started bool
+}
+
+func NewWriter(w io.Writer, format string, header []string) (*Writer, error) {
+	if ContentType(format) == "" {
+		return nil, fmt.Errorf("export - unknown format %q", format)
+	}
+	return &Writer{w: w, format: format, header: header}, nil
+}
+
+// Started reports whether anything has been written.
+func (w *Writer) Started() bool {
+	return w.started
+}
+
+func (w *Writer) Write(row Row) error {
+	first := !w.started
+	if err := w.start(); err != nil {
+		return err
+	}
+
+	switch w.format {
+	case CSV:
+		return w.csv.Write(row.Record())
+	case NDJSON:
+		if _, err := easyjson.MarshalToWriter(row, w.w); err != nil {
+			return err
+		}
+		_, err := io.WriteString(w.w, "\n")
+		return err
+	default:
+		if !first {
+			if _, err := io.WriteString(w.w, ","); err != nil {
+				return err
+			}
+		}
+		_, err := easyjson.MarshalToWriter(row, w.w)
+		return err
+	}
+}
+
+// Close ends the stream. It does not close the underlying writer.
+func (w *Writer) Close() error {
+	if err := w.start(); err != nil {
+		return err
+	}
+
+	switch w.format {
+	case CSV:
+		w.csv.Flush()
+		return w.csv.Error()
+	case JSON:
+		_, err := io.WriteString(w.w, "]\n")
+		return err
+	}
+	return nil
+}
+
+// start writes what comes before the first row: the CSV header, or the
+// opening bracket of a JSON array.
+func (w *Writer) start() error {
+	if w.started {
+		return nil
+	}
+	w.started = true
+
+	switch w.format {
+	case CSV:
+		w.csv = csv.NewWriter(w.w)
+		return w.csv.Write(w.header)
+	case JSON:
+		_, err := io.WriteString(w.w, "[")
+		return err
+	}
+	return nil
+}
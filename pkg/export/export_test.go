@@ -0,0 +1,83 @@
+package export
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"films_library/pkg/logger"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mailru/easyjson/jwriter"
+	"github.com/stretchr/testify/assert"
+)
+
+type row struct {
+	id   string
+	name string
+}
+
+func (r row) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawString(`{"id":` + r.id + `,"name":`)
+	w.String(r.name)
+	w.RawByte('}')
+}
+
+func (r row) Record() []string {
+	return []string{r.id, r.name}
+}
+
+func TestWriter(t *testing.T) {
+	rows := []row{{"1", "Heat"}, {"2", `Say "Hi"`}}
+
+	tests := []struct {
+		name     string
+		format   string
+		rows     []row
+		expected string
+	}{
+		{name: "CSV", format: CSV, rows: rows, expected: "id,name\n1,Heat\n2,\"Say \"\"Hi\"\"\"\n"},
+		{name: "CSV without rows", format: CSV, expected: "id,name\n"},
+		{name: "NDJSON", format: NDJSON, rows: rows, expected: "{\"id\":1,\"name\":\"Heat\"}\n{\"id\":2,\"name\":\"Say \\\"Hi\\\"\"}\n"},
+		{name: "NDJSON without rows", format: NDJSON},
+		{name: "JSON", format: JSON, rows: rows, expected: "[{\"id\":1,\"name\":\"Heat\"},{\"id\":2,\"name\":\"Say \\\"Hi\\\"\"}]\n"},
+		{name: "JSON without rows", format: JSON, expected: "[]\n"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			w, err := NewWriter(&buf, test.format, []string{"id", "name"})
+			assert.NoError(t, err)
+			for _, r := range test.rows {
+				assert.NoError(t, w.Write(r))
+			}
+			assert.NoError(t, w.Close())
+			assert.Equal(t, test.expected, buf.String())
+		})
+	}
+}
+
+func TestWriterRejectsUnknownFormats(t *testing.T) {
+	_, err := NewWriter(&bytes.Buffer{}, "xml", nil)
+	assert.Error(t, err)
+}
+
+func TestFailAbortsStartedExports(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	l := logger.NewMockInterface(ctrl)
+	l.EXPECT().Error(gomock.Any())
+
+	recorder := httptest.NewRecorder()
+	w, err := NewWriter(recorder, CSV, []string{"id", "name"})
+	assert.NoError(t, err)
+	assert.NoError(t, w.Write(row{"1", "Heat"}))
+
+	assert.PanicsWithValue(t, http.ErrAbortHandler, func() {
+		Fail(recorder, w, errors.New("connection reset"), l)
+	})
+}
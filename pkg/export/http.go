@@ -0,0 +1,33 @@
+package export
+
+import (
+	"mime"
+	"net/http"
+	"time"
+
+	"films_library/pkg/logger"
+	"films_library/pkg/response"
+)
+
+// Attach sets the headers of a download of an export of name in format,
+// named after the day it was taken.
+func Attach(w http.ResponseWriter, name, format string) {
+	filename := name + "-" + time.Now().UTC().Format(time.DateOnly) + "." + format
+	w.Header().Set("Content-Type", ContentType(format))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": filename}))
+	w.Header().Set("Cache-Control", "no-store")
+}
+
+// Fail ends an export that failed with err. Until ew has written anything
+// the client gets an error response; after that the response is aborted,
+// so that the client sees it cut short rather than complete.
+func Fail(w http.ResponseWriter, ew *Writer, err error, l logger.Interface) {
+	l.Error(err)
+	if ew.Started() {
+		panic(http.ErrAbortHandler)
+	}
+
+	w.Header().Del("Content-Disposition")
+	w.Header().Del("Cache-Control")
+	response.ErrorResponse(w, http.StatusInternalServerError, "Internal server error", l)
+}
@@ -0,0 +1,21 @@
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+	"time"
+)
+
+// LiftDeadlines clears the read and write deadlines the server timeouts set
+// on the connection of a request, for handlers whose bodies take longer to
+// read or write. Writers that cannot set deadlines are left as they are.
+func LiftDeadlines(w http.ResponseWriter) error {
+	rc := http.NewResponseController(w)
+	if err := rc.SetReadDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+	return nil
+}
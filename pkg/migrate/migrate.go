@@ -0,0 +1,355 @@
+// Package migrate applies numbered up/down SQL migrations to postgres.
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"films_library/pkg/postgres"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+)
+
+// _lockID is the key of the advisory lock held while migrating, so that
+// several instances starting at once do not apply the same migration twice.
+const _lockID = 7_264_113_905
+
+const (
+	createTable = `CREATE TABLE IF NOT EXISTS schema_migrations (version BIGINT NOT NULL, dirty BOOLEAN NOT NULL)`
+	readVersion = `SELECT version, dirty FROM schema_migrations LIMIT 1`
+)
+
+var (
+	ErrDirty          = errors.New("migrate: database is dirty, fix it and force a version")
+	ErrOutdated       = errors.New("migrate: database schema is out of date")
+	ErrUnknownVersion = errors.New("migrate: unknown version")
+	ErrNoDown         = errors.New("migrate: migration has no down script")
+
+	fileName = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+)
+
+// Migration -.
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Status -.
+type Status struct {
+	Version int64
+	Dirty   bool
+	Latest  int64
+	Pending []Migration
+}
+
+// conn is the part of a database connection the migrator needs.
+type conn interface {
+	Exec(ctx context.Context, sql string, arguments ...interface{}) (pgconn.CommandTag, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+// Migrator -.
+type Migrator struct {
+	db         postgres.DBConn
+	migrations []Migration
+}
+
+// New reads the migrations found in source.
+func New(db postgres.DBConn, source fs.FS) (*Migrator, error) {
+	migrations, err := Load(source)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{
+		db:         db,
+		migrations: migrations,
+	}, nil
+}
+
+// Load reads VERSION_NAME.up.sql and VERSION_NAME.down.sql files from source
+// and returns the migrations ordered by version.
+func Load(source fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(source, ".")
+	if err != nil {
+		return nil, fmt.Errorf("migrate - Load - fs.ReadDir: %w", err)
+	}
+
+	byVersion := make(map[int64]*Migration)
+	for _, entry := range entries {
+		match := fileName.FindStringSubmatch(entry.Name())
+		if match == nil {
+			continue
+		}
+
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("migrate - Load - %s: %w", entry.Name(), ErrUnknownVersion)
+		}
+
+		script, err := fs.ReadFile(source, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("migrate - Load - fs.ReadFile: %w", err)
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = m
+		}
+		if m.Name != match[2] {
+			return nil, fmt.Errorf("migrate - Load - version %d has two names: %s and %s", version, m.Name, match[2])
+		}
+
+		if match[3] == "up" {
+			m.Up = string(script)
+		} else {
+			m.Down = string(script)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" {
+			return nil, fmt.Errorf("migrate - Load - version %d has no up script", m.Version)
+		}
+		migrations = append(migrations, *m)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+// Latest returns the version of the newest known migration.
+func (m *Migrator) Latest() int64 {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+// Up applies every pending migration and returns how many were applied.
+func (m *Migrator) Up(ctx context.Context) (int, error) {
+	var applied int
+	err := m.withLock(ctx, func(c conn) error {
+		var err error
+		applied, err = m.up(ctx, c)
+		return err
+	})
+	return applied, err
+}
+
+// Down reverts the last steps applied migrations.
+func (m *Migrator) Down(ctx context.Context, steps int) (int, error) {
+	var reverted int
+	err := m.withLock(ctx, func(c conn) error {
+		var err error
+		reverted, err = m.down(ctx, c, steps)
+		return err
+	})
+	return reverted, err
+}
+
+// Force records version as the current one and clears the dirty flag without
+// running any migration. Use it after repairing a failed migration by hand.
+func (m *Migrator) Force(ctx context.Context, version int64) error {
+	if version != 0 && m.index(version) < 0 {
+		return fmt.Errorf("migrate - Force - %d: %w", version, ErrUnknownVersion)
+	}
+
+	return m.withLock(ctx, func(c conn) error {
+		return setVersion(ctx, c, version, false)
+	})
+}
+
+// Status reports the current version and the migrations not yet applied.
+func (m *Migrator) Status(ctx context.Context) (Status, error) {
+	var status Status
+	err := m.withLock(ctx, func(c conn) error {
+		var err error
+		status, err = m.status(ctx, c)
+		return err
+	})
+	return status, err
+}
+
+// Check returns an error unless the database is clean and at the latest version.
+func (m *Migrator) Check(ctx context.Context) error {
+	status, err := m.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	if status.Dirty {
+		return fmt.Errorf("migrate - Check - version %d: %w", status.Version, ErrDirty)
+	}
+	if len(status.Pending) > 0 {
+		return fmt.Errorf("migrate - Check - version %d, latest %d: %w", status.Version, status.Latest, ErrOutdated)
+	}
+	return nil
+}
+
+func (m *Migrator) withLock(ctx context.Context, fn func(conn) error) error {
+	c, err := m.db.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("migrate - Acquire: %w", err)
+	}
+	defer c.Release()
+
+	if _, err := c.Exec(ctx, `SELECT pg_advisory_lock($1)`, _lockID); err != nil {
+		return fmt.Errorf("migrate - pg_advisory_lock: %w", err)
+	}
+	defer func() {
+		_, _ = c.Exec(context.Background(), `SELECT pg_advisory_unlock($1)`, _lockID)
+	}()
+
+	if _, err := c.Exec(ctx, createTable); err != nil {
+		return fmt.Errorf("migrate - create schema_migrations: %w", err)
+	}
+
+	return fn(c)
+}
+
+func (m *Migrator) status(ctx context.Context, c conn) (Status, error) {
+	version, dirty, err := currentVersion(ctx, c)
+	if err != nil {
+		return Status{}, err
+	}
+
+	status := Status{Version: version, Dirty: dirty, Latest: m.Latest()}
+	for _, migration := range m.migrations {
+		if migration.Version > version {
+			status.Pending = append(status.Pending, migration)
+		}
+	}
+	return status, nil
+}
+
+func (m *Migrator) up(ctx context.Context, c conn) (int, error) {
+	status, err := m.status(ctx, c)
+	if err != nil {
+		return 0, err
+	}
+	if status.Dirty {
+		return 0, fmt.Errorf("migrate - up - version %d: %w", status.Version, ErrDirty)
+	}
+
+	for i, migration := range status.Pending {
+		if err := apply(ctx, c, migration.Version, migration.Version, migration.Up); err != nil {
+			return i, fmt.Errorf("migrate - up %d_%s: %w", migration.Version, migration.Name, err)
+		}
+	}
+	return len(status.Pending), nil
+}
+
+func (m *Migrator) down(ctx context.Context, c conn, steps int) (int, error) {
+	version, dirty, err := currentVersion(ctx, c)
+	if err != nil {
+		return 0, err
+	}
+	if dirty {
+		return 0, fmt.Errorf("migrate - down - version %d: %w", version, ErrDirty)
+	}
+
+	i := m.index(version)
+	if version != 0 && i < 0 {
+		return 0, fmt.Errorf("migrate - down - %d: %w", version, ErrUnknownVersion)
+	}
+
+	reverted := 0
+	for ; i >= 0 && reverted < steps; i-- {
+		migration := m.migrations[i]
+		if migration.Down == "" {
+			return reverted, fmt.Errorf("migrate - down %d_%s: %w", migration.Version, migration.Name, ErrNoDown)
+		}
+
+		var previous int64
+		if i > 0 {
+			previous = m.migrations[i-1].Version
+		}
+
+		if err := apply(ctx, c, migration.Version, previous, migration.Down); err != nil {
+			return reverted, fmt.Errorf("migrate - down %d_%s: %w", migration.Version, migration.Name, err)
+		}
+		reverted++
+	}
+	return reverted, nil
+}
+
+func (m *Migrator) index(version int64) int {
+	for i, migration := range m.migrations {
+		if migration.Version == version {
+			return i
+		}
+	}
+	return -1
+}
+
+// apply marks the database dirty at version, then runs script and records
+// target in one transaction. A failed script leaves the dirty mark behind.
+func apply(ctx context.Context, c conn, version, target int64, script string) error {
+	if err := setVersion(ctx, c, version, true); err != nil {
+		return err
+	}
+
+	tx, err := c.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	if _, err := tx.Exec(ctx, script); err != nil {
+		return err
+	}
+	if err := setVersion(ctx, tx, target, false); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func currentVersion(ctx context.Context, c conn) (int64, bool, error) {
+	var version int64
+	var dirty bool
+
+	err := c.QueryRow(ctx, readVersion).Scan(&version, &dirty)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("migrate - read version: %w", err)
+	}
+	return version, dirty, nil
+}
+
+type execer interface {
+	Exec(ctx context.Context, sql string, arguments ...interface{}) (pgconn.CommandTag, error)
+}
+
+func setVersion(ctx context.Context, c execer, version int64, dirty bool) error {
+	if _, err := c.Exec(ctx, `DELETE FROM schema_migrations`); err != nil {
+		return fmt.Errorf("migrate - set version: %w", err)
+	}
+	if version == 0 {
+		return nil
+	}
+
+	if _, err := c.Exec(ctx, `INSERT INTO schema_migrations (version, dirty) VALUES ($1, $2)`, version, dirty); err != nil {
+		return fmt.Errorf("migrate - set version: %w", err)
+	}
+	return nil
+}
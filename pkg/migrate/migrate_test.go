@@ -0,0 +1,101 @@
+package migrate
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"testing/fstest"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/pashagolub/pgxmock"
+	"github.com/stretchr/testify/assert"
+)
+
+var source = fstest.MapFS{
+	"0001_init.up.sql":     {Data: []byte("CREATE TABLE film ();")},
+	"0001_init.down.sql":   {Data: []byte("DROP TABLE film;")},
+	"0002_search.up.sql":   {Data: []byte("ALTER TABLE film ADD COLUMN search_vector tsvector;")},
+	"0002_search.down.sql": {Data: []byte("ALTER TABLE film DROP COLUMN search_vector;")},
+	"README.md":            {Data: []byte("ignored")},
+}
+
+func TestLoad(t *testing.T) {
+	migrations, err := Load(source)
+	assert.NoError(t, err)
+	assert.Equal(t, []Migration{
+		{Version: 1, Name: "init", Up: "CREATE TABLE film ();", Down: "DROP TABLE film;"},
+		{Version: 2, Name: "search", Up: "ALTER TABLE film ADD COLUMN search_vector tsvector;", Down: "ALTER TABLE film DROP COLUMN search_vector;"},
+	}, migrations)
+
+	_, err = Load(fstest.MapFS{"0003_x.down.sql": {Data: []byte("")}})
+	assert.Error(t, err)
+}
+
+func TestUp(t *testing.T) {
+	mock, err := pgxmock.NewConn()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mock.Close(context.Background())
+
+	migrations, err := Load(source)
+	assert.NoError(t, err)
+	m := &Migrator{migrations: migrations}
+
+	mock.ExpectQuery(regexp.QuoteMeta(readVersion)).
+		WillReturnRows(pgxmock.NewRows([]string{"version", "dirty"}).AddRow(int64(1), false))
+	mock.ExpectExec(`DELETE FROM schema_migrations`).WillReturnResult(pgxmock.NewResult("DELETE", 1))
+	mock.ExpectExec(`INSERT INTO schema_migrations`).WithArgs(int64(2), true).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectBegin()
+	mock.ExpectExec(`ALTER TABLE film ADD COLUMN search_vector`).WillReturnResult(pgxmock.NewResult("ALTER", 0))
+	mock.ExpectExec(`DELETE FROM schema_migrations`).WillReturnResult(pgxmock.NewResult("DELETE", 1))
+	mock.ExpectExec(`INSERT INTO schema_migrations`).WithArgs(int64(2), false).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectCommit()
+
+	applied, err := m.up(context.Background(), mock)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, applied)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestUpRefusesDirty(t *testing.T) {
+	mock, err := pgxmock.NewConn()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mock.Close(context.Background())
+
+	migrations, err := Load(source)
+	assert.NoError(t, err)
+	m := &Migrator{migrations: migrations}
+
+	mock.ExpectQuery(regexp.QuoteMeta(readVersion)).
+		WillReturnRows(pgxmock.NewRows([]string{"version", "dirty"}).AddRow(int64(1), true))
+
+	applied, err := m.up(context.Background(), mock)
+	assert.ErrorIs(t, err, ErrDirty)
+	assert.Equal(t, 0, applied)
+}
+
+func TestStatusOfEmptyDatabase(t *testing.T) {
+	mock, err := pgxmock.NewConn()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mock.Close(context.Background())
+
+	migrations, err := Load(source)
+	assert.NoError(t, err)
+	m := &Migrator{migrations: migrations}
+
+	mock.ExpectQuery(regexp.QuoteMeta(readVersion)).WillReturnError(pgx.ErrNoRows)
+
+	status, err := m.status(context.Background(), mock)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), status.Version)
+	assert.Equal(t, int64(2), status.Latest)
+	assert.Len(t, status.Pending, 2)
+}
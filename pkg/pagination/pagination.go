@@ -0,0 +1,261 @@
+// Package pagination implements limit/offset and keyset cursor pagination.
+package pagination
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/mailru/easyjson"
+)
+
+const (
+	DefaultLimit = 20
+	MaxLimit     = 100
+)
+
+var (
+	ErrInvalidLimit  = errors.New("pagination: invalid limit")
+	ErrInvalidOffset = errors.New("pagination: invalid offset")
+	ErrInvalidCursor = errors.New("pagination: invalid cursor")
+	ErrOffsetCursor  = errors.New("pagination: offset and cursor are mutually exclusive")
+)
+
+// Cursor points at the boundary row of a page. Value holds the sort column of
+// that row as text, ID its primary key. A Backward cursor pages towards the
+// beginning of the listing. Listings without a stable key, such as ranked
+// search results, use Offset instead. Sort records the sort parameters the
+// cursor was issued for.
+//
+//easyjson:json
+type Cursor struct {
+	Value    string `json:"v,omitempty"`
+	ID       uint64 `json:"id"`
+	Backward bool   `json:"b,omitempty"`
+	Offset   int    `json:"o,omitempty"`
+	Sort     string `json:"s,omitempty"`
+}
+
+// Page describes the neighbours of a returned page.
+//
+//easyjson:json
+type Page struct {
+	Next  string `json:"next_cursor,omitempty"`
+	Prev  string `json:"prev_cursor,omitempty"`
+	Total *int64 `json:"total,omitempty"`
+}
+
+// Params -.
+type Params struct {
+	Limit     int
+	Offset    int
+	Cursor    *Cursor
+	WithTotal bool
+	Sort      string
+}
+
+// Order is the order of a listing paged by cursor: by Column, if set, and
+// then by the unique Key, both descending when Desc is set. Cursor values
+// are cast to Cast before they are compared to Column, so Column must not be
+// NULL; wrap nullable columns in COALESCE.
+type Order struct {
+	Column string
+	Cast   string
+	Key    string
+	Desc   bool
+}
+
+// FetchLimit returns the number of rows a repository should read: one more
+// than requested, so that Paginate can tell whether another page follows.
+// Zero means no limit.
+func (p Params) FetchLimit() int {
+	if p.Limit <= 0 {
+		return 0
+	}
+	return p.Limit + 1
+}
+
+// Backward reports whether rows are requested in reverse order.
+func (p Params) Backward() bool {
+	return p.Cursor != nil && p.Cursor.Backward
+}
+
+// Position returns the number of rows to skip in listings paged by position.
+func (p Params) Position() int {
+	if p.Cursor != nil {
+		return p.Cursor.Offset
+	}
+	return p.Offset
+}
+
+// Keyset renders the condition selecting the rows after the cursor, empty
+// without one, and the ORDER BY, LIMIT and OFFSET clauses of a page,
+// appending their arguments to args.
+func (p Params) Keyset(o Order, args *[]interface{}) (condition, clauses string) {
+	cmp, order := ">", "ASC"
+	if o.Desc != p.Backward() {
+		cmp, order = "<", "DESC"
+	}
+
+	if p.Cursor != nil {
+		if o.Column != "" {
+			*args = append(*args, p.Cursor.Value, p.Cursor.ID)
+			condition = fmt.Sprintf("(%s, %s) %s ($%d::%s, $%d)", o.Column, o.Key, cmp, len(*args)-1, o.Cast, len(*args))
+		} else {
+			*args = append(*args, p.Cursor.ID)
+			condition = fmt.Sprintf("%s %s $%d", o.Key, cmp, len(*args))
+		}
+	}
+
+	if o.Column != "" {
+		clauses = fmt.Sprintf(" ORDER BY %s %s, %s %s", o.Column, order, o.Key, order)
+	} else {
+		clauses = fmt.Sprintf(" ORDER BY %s %s", o.Key, order)
+	}
+	if limit := p.FetchLimit(); limit > 0 {
+		*args = append(*args, limit)
+		clauses += fmt.Sprintf(" LIMIT $%d", len(*args))
+	}
+	if p.Cursor == nil && p.Offset > 0 {
+		*args = append(*args, p.Offset)
+		clauses += fmt.Sprintf(" OFFSET $%d", len(*args))
+	}
+	return condition, clauses
+}
+
+// ParseQuery reads limit, offset, cursor and total from URL query parameters.
+// A cursor issued for other sort_by and sort_order parameters is invalid.
+func ParseQuery(q url.Values) (Params, error) {
+	p := Params{Limit: DefaultLimit, Sort: sortParams(q)}
+
+	if v := q.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil || limit <= 0 {
+			return Params{}, ErrInvalidLimit
+		}
+		p.Limit = min(limit, MaxLimit)
+	}
+
+	if v := q.Get("offset"); v != "" {
+		offset, err := strconv.Atoi(v)
+		if err != nil || offset < 0 {
+			return Params{}, ErrInvalidOffset
+		}
+		p.Offset = offset
+	}
+
+	if v := q.Get("cursor"); v != "" {
+		if p.Offset > 0 {
+			return Params{}, ErrOffsetCursor
+		}
+		cursor, err := Decode(v)
+		if err != nil {
+			return Params{}, err
+		}
+		if cursor.Sort != p.Sort {
+			return Params{}, ErrInvalidCursor
+		}
+		p.Cursor = &cursor
+	}
+
+	if v := q.Get("total"); v != "" {
+		withTotal, err := strconv.ParseBool(v)
+		if err != nil {
+			return Params{}, err
+		}
+		p.WithTotal = withTotal
+	}
+
+	return p, nil
+}
+
+// sortParams identifies the sort_by and sort_order parameters of a listing.
+func sortParams(q url.Values) string {
+	by, order := q.Get("sort_by"), q.Get("sort_order")
+	if by == "" && order == "" {
+		return ""
+	}
+	return by + " " + order
+}
+
+// Encode turns a cursor into an opaque URL-safe token.
+func Encode(c Cursor) string {
+	data, err := easyjson.Marshal(c)
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// Decode parses a token produced by Encode.
+func Decode(token string) (Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	var c Cursor
+	if err := easyjson.Unmarshal(data, &c); err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+	return c, nil
+}
+
+// Paginate drops the look-ahead row read because of FetchLimit and builds
+// the cursors of the neighbouring pages. rows must be in listing order.
+func Paginate[T any](rows []T, p Params, key func(T) Cursor) ([]T, Page) {
+	backward := p.Backward()
+
+	hasMore := p.Limit > 0 && len(rows) > p.Limit
+	if hasMore {
+		if backward {
+			rows = rows[len(rows)-p.Limit:]
+		} else {
+			rows = rows[:p.Limit]
+		}
+	}
+
+	var page Page
+	if len(rows) == 0 {
+		return rows, page
+	}
+
+	first, last := key(rows[0]), key(rows[len(rows)-1])
+	first.Backward = true
+	first.Sort, last.Sort = p.Sort, p.Sort
+
+	if backward {
+		page.Next = Encode(last)
+		if hasMore {
+			page.Prev = Encode(first)
+		}
+		return rows, page
+	}
+
+	if hasMore {
+		page.Next = Encode(last)
+	}
+	if p.Cursor != nil || p.Offset > 0 {
+		page.Prev = Encode(first)
+	}
+	return rows, page
+}
+
+// PaginateOffset is Paginate for listings paged by position. rows must have
+// been read with FetchLimit starting at Position.
+func PaginateOffset[T any](rows []T, p Params) ([]T, Page) {
+	var page Page
+
+	hasMore := p.Limit > 0 && len(rows) > p.Limit
+	if hasMore {
+		rows = rows[:p.Limit]
+		page.Next = Encode(Cursor{Offset: p.Position() + p.Limit, Sort: p.Sort})
+	}
+
+	if position := p.Position(); position > 0 {
+		page.Prev = Encode(Cursor{Offset: max(position-p.Limit, 0), Sort: p.Sort})
+	}
+	return rows, page
+}
@@ -0,0 +1,411 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package pagination
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson7a0b6064DecodeFilmsLibraryPkgPagination(in *jlexer.Lexer, out *Params) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Limit":
+			out.Limit = int(in.Int())
+		case "Offset":
+			out.Offset = int(in.Int())
+		case "Cursor":
+			if in.IsNull() {
+				in.Skip()
+				out.Cursor = nil
+			} else {
+				if out.Cursor == nil {
+					out.Cursor = new(Cursor)
+				}
+				(*out.Cursor).UnmarshalEasyJSON(in)
+			}
+		case "WithTotal":
+			out.WithTotal = bool(in.Bool())
+		case "Sort":
+			out.Sort = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson7a0b6064EncodeFilmsLibraryPkgPagination(out *jwriter.Writer, in Params) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Limit\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Limit))
+	}
+	{
+		const prefix string = ",\"Offset\":"
+		out.RawString(prefix)
+		out.Int(int(in.Offset))
+	}
+	{
+		const prefix string = ",\"Cursor\":"
+		out.RawString(prefix)
+		if in.Cursor == nil {
+			out.RawString("null")
+		} else {
+			(*in.Cursor).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"WithTotal\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.WithTotal))
+	}
+	{
+		const prefix string = ",\"Sort\":"
+		out.RawString(prefix)
+		out.String(string(in.Sort))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Params) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson7a0b6064EncodeFilmsLibraryPkgPagination(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Params) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson7a0b6064EncodeFilmsLibraryPkgPagination(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Params) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson7a0b6064DecodeFilmsLibraryPkgPagination(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Params) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson7a0b6064DecodeFilmsLibraryPkgPagination(l, v)
+}
+func easyjson7a0b6064DecodeFilmsLibraryPkgPagination1(in *jlexer.Lexer, out *Page) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "next_cursor":
+			out.Next = string(in.String())
+		case "prev_cursor":
+			out.Prev = string(in.String())
+		case "total":
+			if in.IsNull() {
+				in.Skip()
+				out.Total = nil
+			} else {
+				if out.Total == nil {
+					out.Total = new(int64)
+				}
+				*out.Total = int64(in.Int64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson7a0b6064EncodeFilmsLibraryPkgPagination1(out *jwriter.Writer, in Page) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Next != "" {
+		const prefix string = ",\"next_cursor\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.Next))
+	}
+	if in.Prev != "" {
+		const prefix string = ",\"prev_cursor\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Prev))
+	}
+	if in.Total != nil {
+		const prefix string = ",\"total\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(*in.Total))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Page) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson7a0b6064EncodeFilmsLibraryPkgPagination1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Page) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson7a0b6064EncodeFilmsLibraryPkgPagination1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Page) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson7a0b6064DecodeFilmsLibraryPkgPagination1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Page) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson7a0b6064DecodeFilmsLibraryPkgPagination1(l, v)
+}
+func easyjson7a0b6064DecodeFilmsLibraryPkgPagination2(in *jlexer.Lexer, out *Order) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Column":
+			out.Column = string(in.String())
+		case "Cast":
+			out.Cast = string(in.String())
+		case "Key":
+			out.Key = string(in.String())
+		case "Desc":
+			out.Desc = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson7a0b6064EncodeFilmsLibraryPkgPagination2(out *jwriter.Writer, in Order) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Column\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Column))
+	}
+	{
+		const prefix string = ",\"Cast\":"
+		out.RawString(prefix)
+		out.String(string(in.Cast))
+	}
+	{
+		const prefix string = ",\"Key\":"
+		out.RawString(prefix)
+		out.String(string(in.Key))
+	}
+	{
+		const prefix string = ",\"Desc\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Desc))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Order) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson7a0b6064EncodeFilmsLibraryPkgPagination2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Order) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson7a0b6064EncodeFilmsLibraryPkgPagination2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Order) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson7a0b6064DecodeFilmsLibraryPkgPagination2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Order) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson7a0b6064DecodeFilmsLibraryPkgPagination2(l, v)
+}
+func easyjson7a0b6064DecodeFilmsLibraryPkgPagination3(in *jlexer.Lexer, out *Cursor) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "v":
+			out.Value = string(in.String())
+		case "id":
+			out.ID = uint64(in.Uint64())
+		case "b":
+			out.Backward = bool(in.Bool())
+		case "o":
+			out.Offset = int(in.Int())
+		case "s":
+			out.Sort = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson7a0b6064EncodeFilmsLibraryPkgPagination3(out *jwriter.Writer, in Cursor) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Value != "" {
+		const prefix string = ",\"v\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.Value))
+	}
+	{
+		const prefix string = ",\"id\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Uint64(uint64(in.ID))
+	}
+	if in.Backward {
+		const prefix string = ",\"b\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Backward))
+	}
+	if in.Offset != 0 {
+		const prefix string = ",\"o\":"
+		out.RawString(prefix)
+		out.Int(int(in.Offset))
+	}
+	if in.Sort != "" {
+		const prefix string = ",\"s\":"
+		out.RawString(prefix)
+		out.String(string(in.Sort))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Cursor) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson7a0b6064EncodeFilmsLibraryPkgPagination3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Cursor) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson7a0b6064EncodeFilmsLibraryPkgPagination3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Cursor) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson7a0b6064DecodeFilmsLibraryPkgPagination3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Cursor) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson7a0b6064DecodeFilmsLibraryPkgPagination3(l, v)
+}
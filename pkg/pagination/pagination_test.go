@@ -0,0 +1,130 @@
+package pagination
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseQuery(t *testing.T) {
+	cursor := Cursor{Value: "7", ID: 12}
+	sorted := Cursor{Value: "7", ID: 12, Sort: "rating desc"}
+
+	tests := []struct {
+		name     string
+		query    url.Values
+		expected Params
+		err      error
+	}{
+		{
+			name:     "Defaults",
+			query:    url.Values{},
+			expected: Params{Limit: DefaultLimit},
+		},
+		{
+			name:     "Limit is capped",
+			query:    url.Values{"limit": {"1000"}, "offset": {"40"}, "total": {"true"}},
+			expected: Params{Limit: MaxLimit, Offset: 40, WithTotal: true},
+		},
+		{
+			name:     "Cursor",
+			query:    url.Values{"cursor": {Encode(cursor)}},
+			expected: Params{Limit: DefaultLimit, Cursor: &cursor},
+		},
+		{
+			name:  "Invalid limit",
+			query: url.Values{"limit": {"0"}},
+			err:   ErrInvalidLimit,
+		},
+		{
+			name:  "Invalid cursor",
+			query: url.Values{"cursor": {"%%%"}},
+			err:   ErrInvalidCursor,
+		},
+		{
+			name:     "Cursor of the same order",
+			query:    url.Values{"sort_by": {"rating"}, "sort_order": {"desc"}, "cursor": {Encode(sorted)}},
+			expected: Params{Limit: DefaultLimit, Cursor: &sorted, Sort: "rating desc"},
+		},
+		{
+			name:  "Cursor of another order",
+			query: url.Values{"sort_by": {"title"}, "cursor": {Encode(sorted)}},
+			err:   ErrInvalidCursor,
+		},
+		{
+			name:  "Offset with cursor",
+			query: url.Values{"offset": {"5"}, "cursor": {Encode(cursor)}},
+			err:   ErrOffsetCursor,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			params, err := ParseQuery(test.query)
+			assert.Equal(t, test.err, err)
+			assert.Equal(t, test.expected, params)
+		})
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	key := func(id int) Cursor { return Cursor{ID: uint64(id)} }
+
+	t.Run("First page", func(t *testing.T) {
+		rows, page := Paginate([]int{1, 2, 3}, Params{Limit: 2}, key)
+		assert.Equal(t, []int{1, 2}, rows)
+		assert.Equal(t, Encode(Cursor{ID: 2}), page.Next)
+		assert.Empty(t, page.Prev)
+	})
+
+	t.Run("Last page after cursor", func(t *testing.T) {
+		rows, page := Paginate([]int{3}, Params{Limit: 2, Cursor: &Cursor{ID: 2}}, key)
+		assert.Equal(t, []int{3}, rows)
+		assert.Empty(t, page.Next)
+		assert.Equal(t, Encode(Cursor{ID: 3, Backward: true}), page.Prev)
+	})
+
+	t.Run("Backward page", func(t *testing.T) {
+		rows, page := Paginate([]int{1, 2, 3}, Params{Limit: 2, Cursor: &Cursor{ID: 4, Backward: true}}, key)
+		assert.Equal(t, []int{2, 3}, rows)
+		assert.Equal(t, Encode(Cursor{ID: 3}), page.Next)
+		assert.Equal(t, Encode(Cursor{ID: 2, Backward: true}), page.Prev)
+	})
+
+	t.Run("Sorted page", func(t *testing.T) {
+		_, page := Paginate([]int{1, 2, 3}, Params{Limit: 2, Offset: 2, Sort: "title asc"}, key)
+		assert.Equal(t, Encode(Cursor{ID: 2, Sort: "title asc"}), page.Next)
+		assert.Equal(t, Encode(Cursor{ID: 1, Backward: true, Sort: "title asc"}), page.Prev)
+	})
+}
+
+func TestKeyset(t *testing.T) {
+	t.Run("First page", func(t *testing.T) {
+		args := []interface{}{7}
+		condition, clauses := Params{Limit: 2, Offset: 4}.Keyset(Order{Key: "l.loan_id", Desc: true}, &args)
+		assert.Empty(t, condition)
+		assert.Equal(t, " ORDER BY l.loan_id DESC LIMIT $2 OFFSET $3", clauses)
+		assert.Equal(t, []interface{}{7, 3, 4}, args)
+	})
+
+	t.Run("Backward page by column", func(t *testing.T) {
+		var args []interface{}
+		p := Params{Limit: 2, Cursor: &Cursor{Value: "8", ID: 5, Backward: true}}
+		condition, clauses := p.Keyset(Order{Column: "COALESCE(f.rating, -1)", Cast: "int", Key: "f.film_id", Desc: true}, &args)
+		assert.Equal(t, "(COALESCE(f.rating, -1), f.film_id) > ($1::int, $2)", condition)
+		assert.Equal(t, " ORDER BY COALESCE(f.rating, -1) ASC, f.film_id ASC LIMIT $3", clauses)
+		assert.Equal(t, []interface{}{"8", uint64(5), 3}, args)
+	})
+}
+
+func TestPaginateOffset(t *testing.T) {
+	rows, page := PaginateOffset([]int{1, 2, 3}, Params{Limit: 2, Cursor: &Cursor{Offset: 2}})
+	assert.Equal(t, []int{1, 2}, rows)
+	assert.Equal(t, Encode(Cursor{Offset: 4}), page.Next)
+	assert.Equal(t, Encode(Cursor{Offset: 0}), page.Prev)
+
+	rows, page = PaginateOffset([]int{1}, Params{Limit: 2})
+	assert.Equal(t, []int{1}, rows)
+	assert.Equal(t, Page{}, page)
+}
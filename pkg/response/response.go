@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"films_library/pkg/logger"
+	"films_library/pkg/pagination"
 
 	"github.com/mailru/easyjson"
 )
@@ -18,8 +19,10 @@ const (
 
 //easyjson:json
 type Response struct {
-	Status int         `json:"status"`
-	Body   interface{} `json:"body"`
+	Status int              `json:"status"`
+	Body   interface{}      `json:"body"`
+	Page   *pagination.Page `json:"page,omitempty"`
+	Facets interface{}      `json:"facets,omitempty"`
 }
 
 //easyjson:json
@@ -62,10 +65,33 @@ func ErrorResponse(w http.ResponseWriter, code int, message string, log logger.I
 }
 
 func SuccessResponse[T any](w http.ResponseWriter, status int, response T) {
+	writeResponse(w, Response{Status: status, Body: response})
+}
+
+// PageResponse writes a page of a listing together with its cursors.
+func PageResponse[T any](w http.ResponseWriter, status int, response T, page pagination.Page) {
 	date := Response{Status: status, Body: response}
+	if page != (pagination.Page{}) {
+		date.Page = &page
+	}
+
+	writeResponse(w, date)
+}
+
+// FacetedPageResponse writes a page of a listing together with its cursors
+// and the facet counts of the whole listing.
+func FacetedPageResponse[T any](w http.ResponseWriter, status int, response T, page pagination.Page, facets interface{}) {
+	date := Response{Status: status, Body: response, Facets: facets}
+	if page != (pagination.Page{}) {
+		date.Page = &page
+	}
+
+	writeResponse(w, date)
+}
 
+func writeResponse(w http.ResponseWriter, date Response) {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
-	w.WriteHeader(status)
+	w.WriteHeader(date.Status)
 
 	// Marshal response using easyjson
 	_, _, err := easyjson.MarshalToHTTPResponseWriter(date, w)
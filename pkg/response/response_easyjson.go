@@ -4,6 +4,7 @@ package response
 
 import (
 	json "encoding/json"
+	pagination "films_library/pkg/pagination"
 	easyjson "github.com/mailru/easyjson"
 	jlexer "github.com/mailru/easyjson/jlexer"
 	jwriter "github.com/mailru/easyjson/jwriter"
@@ -119,6 +120,24 @@ func easyjson6ff3ac1dDecodeFilmsLibraryPkgResponse1(in *jlexer.Lexer, out *Respo
 			} else {
 				out.Body = in.Interface()
 			}
+		case "page":
+			if in.IsNull() {
+				in.Skip()
+				out.Page = nil
+			} else {
+				if out.Page == nil {
+					out.Page = new(pagination.Page)
+				}
+				(*out.Page).UnmarshalEasyJSON(in)
+			}
+		case "facets":
+			if m, ok := out.Facets.(easyjson.Unmarshaler); ok {
+				m.UnmarshalEasyJSON(in)
+			} else if m, ok := out.Facets.(json.Unmarshaler); ok {
+				_ = m.UnmarshalJSON(in.Raw())
+			} else {
+				out.Facets = in.Interface()
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -149,6 +168,22 @@ func easyjson6ff3ac1dEncodeFilmsLibraryPkgResponse1(out *jwriter.Writer, in Resp
 			out.Raw(json.Marshal(in.Body))
 		}
 	}
+	if in.Page != nil {
+		const prefix string = ",\"page\":"
+		out.RawString(prefix)
+		(*in.Page).MarshalEasyJSON(out)
+	}
+	if in.Facets != nil {
+		const prefix string = ",\"facets\":"
+		out.RawString(prefix)
+		if m, ok := in.Facets.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := in.Facets.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(in.Facets))
+		}
+	}
 	out.RawByte('}')
 }
 
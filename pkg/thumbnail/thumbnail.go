@@ -0,0 +1,60 @@
+// Package thumbnail scales images down in pure Go, averaging the pixels each
+// thumbnail pixel covers.
+package thumbnail
+
+import (
+	"image"
+	"image/draw"
+)
+
+// Resize returns img scaled to width, keeping its aspect ratio. Images no
+// wider than width are returned as they are.
+func Resize(img image.Image, width int) image.Image {
+	b := img.Bounds()
+	sw, sh := b.Dx(), b.Dy()
+	if width <= 0 || sw <= width {
+		return img
+	}
+	height := max((sh*width+sw/2)/sw, 1)
+
+	src, ok := img.(*image.RGBA)
+	if !ok || src.Rect.Min != (image.Point{}) {
+		src = image.NewRGBA(image.Rect(0, 0, sw, sh))
+		draw.Draw(src, src.Rect, img, b.Min, draw.Src)
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	var sum [4]uint64
+	for y := 0; y < height; y++ {
+		y0, y1 := span(y, height, sh)
+		for x := 0; x < width; x++ {
+			x0, x1 := span(x, width, sw)
+
+			sum = [4]uint64{}
+			for sy := y0; sy < y1; sy++ {
+				row := src.Pix[sy*src.Stride+x0*4 : sy*src.Stride+x1*4]
+				for i := 0; i < len(row); i += 4 {
+					sum[0] += uint64(row[i])
+					sum[1] += uint64(row[i+1])
+					sum[2] += uint64(row[i+2])
+					sum[3] += uint64(row[i+3])
+				}
+			}
+
+			n := uint64((y1 - y0) * (x1 - x0))
+			i := y*dst.Stride + x*4
+			for c := range sum {
+				dst.Pix[i+c] = uint8((sum[c] + n/2) / n)
+			}
+		}
+	}
+	return dst
+}
+
+// span returns the source pixels [from, to) that pixel i of n covers in a
+// line of size pixels, at least one.
+func span(i, n, size int) (int, int) {
+	from := i * size / n
+	to := max((i+1)*size/n, from+1)
+	return from, min(to, size)
+}
@@ -0,0 +1,33 @@
+package thumbnail
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResizeAverages(t *testing.T) {
+	img := image.NewGray(image.Rect(0, 0, 4, 2))
+	for y := 0; y < 2; y++ {
+		img.SetGray(0, y, color.Gray{Y: 0})
+		img.SetGray(1, y, color.Gray{Y: 100})
+		img.SetGray(2, y, color.Gray{Y: 200})
+		img.SetGray(3, y, color.Gray{Y: 255})
+	}
+
+	thumb := Resize(img, 2)
+	assert.Equal(t, image.Rect(0, 0, 2, 1), thumb.Bounds())
+	assert.Equal(t, color.RGBA{50, 50, 50, 255}, thumb.At(0, 0))
+	assert.Equal(t, color.RGBA{228, 228, 228, 255}, thumb.At(1, 0))
+}
+
+func TestResizeKeepsAspectRatio(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(10, 10, 1010, 1510))
+
+	thumb := Resize(img, 185)
+	assert.Equal(t, image.Rect(0, 0, 185, 278), thumb.Bounds())
+
+	assert.Same(t, img, Resize(img, 1000), "images no wider than the width are kept")
+}
@@ -0,0 +1,22 @@
+package worker
+
+import "time"
+
+// Option -.
+type Option func(*Worker)
+
+// Interval -.
+func Interval(interval time.Duration) Option {
+	return func(w *Worker) {
+		if interval > 0 {
+			w.interval = interval
+		}
+	}
+}
+
+// Name -.
+func Name(name string) Option {
+	return func(w *Worker) {
+		w.name = name
+	}
+}
@@ -0,0 +1,81 @@
+// Package worker runs a task in the background at a fixed interval until it
+// is shut down.
+package worker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"films_library/pkg/logger"
+)
+
+const (
+	_defaultInterval = time.Minute
+	_defaultName     = "worker"
+)
+
+// Task is one run of the job. ctx is cancelled on shutdown.
+type Task func(ctx context.Context) error
+
+// Worker -.
+type Worker struct {
+	task     Task
+	name     string
+	interval time.Duration
+	logger   logger.Interface
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// New starts running task right away and then every interval. Errors of a
+// run are logged and the next run goes ahead.
+func New(task Task, l logger.Interface, opts ...Option) *Worker {
+	w := &Worker{
+		task:     task,
+		name:     _defaultName,
+		interval: _defaultInterval,
+		logger:   l,
+		done:     make(chan struct{}),
+	}
+
+	// Custom options
+	for _, opt := range opts {
+		opt(w)
+	}
+
+	w.start()
+
+	return w
+}
+
+func (w *Worker) start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	w.cancel = cancel
+
+	go func() {
+		defer close(w.done)
+
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for {
+			if err := w.task(ctx); err != nil && ctx.Err() == nil {
+				w.logger.Error(fmt.Errorf("%s - run: %w", w.name, err))
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Shutdown cancels the running task, if any, and waits for it to return.
+func (w *Worker) Shutdown() {
+	w.cancel()
+	<-w.done
+}
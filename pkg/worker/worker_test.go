@@ -0,0 +1,56 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"films_library/pkg/logger"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkerRunsUntilShutdown(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var runs atomic.Int32
+	task := func(ctx context.Context) error {
+		runs.Add(1)
+		return nil
+	}
+
+	w := New(task, logger.NewMockInterface(ctrl), Interval(time.Millisecond))
+	assert.Eventually(t, func() bool { return runs.Load() >= 3 }, time.Second, time.Millisecond)
+
+	w.Shutdown()
+	stopped := runs.Load()
+	time.Sleep(5 * time.Millisecond)
+	assert.Equal(t, stopped, runs.Load())
+}
+
+func TestWorkerLogsErrors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	l := logger.NewMockInterface(ctrl)
+	logged := make(chan struct{})
+	l.EXPECT().Error(gomock.Any()).Do(func(message interface{}, args ...interface{}) {
+		close(logged)
+	})
+
+	task := func(ctx context.Context) error {
+		return errors.New("boom")
+	}
+
+	w := New(task, l, Interval(time.Hour), Name("test"))
+	select {
+	case <-logged:
+	case <-time.After(time.Second):
+		t.Fatal("error was not logged")
+	}
+	w.Shutdown()
+}